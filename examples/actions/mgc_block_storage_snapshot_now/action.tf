@@ -0,0 +1,18 @@
+action "mgc_block_storage_snapshot_now" "data" {
+  config {
+    volume_id   = mgc_block_storage_volumes.data.id
+    description = "Taken before the release"
+  }
+}
+
+# Take a snapshot whenever the instance using the volume is replaced.
+resource "terraform_data" "release" {
+  input = mgc_virtual_machine_instances.web.id
+
+  lifecycle {
+    action_trigger {
+      events  = [before_update]
+      actions = [action.mgc_block_storage_snapshot_now.data]
+    }
+  }
+}
@@ -0,0 +1,8 @@
+action "mgc_dbaas_instance_backup_now" "main" {
+  config {
+    instance_id = mgc_dbaas_instances.main.id
+    name        = "before-migration"
+  }
+}
+
+# terraform apply -invoke=action.mgc_dbaas_instance_backup_now.main
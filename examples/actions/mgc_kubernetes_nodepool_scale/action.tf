@@ -0,0 +1,14 @@
+variable "workers" {
+  type    = number
+  default = 3
+}
+
+action "mgc_kubernetes_nodepool_scale" "workers" {
+  config {
+    cluster_id   = mgc_kubernetes_cluster.cluster.id
+    node_pool_id = mgc_kubernetes_nodepool.workers.id
+    replicas     = var.workers
+  }
+}
+
+# terraform apply -invoke=action.mgc_kubernetes_nodepool_scale.workers -var workers=5
@@ -0,0 +1,13 @@
+variable "web_state" {
+  type    = string
+  default = "stopped"
+}
+
+action "mgc_virtual_machine_power" "web" {
+  config {
+    instance_id = mgc_virtual_machine_instances.web.id
+    state       = var.web_state
+  }
+}
+
+# terraform apply -invoke=action.mgc_virtual_machine_power.web -var web_state=running
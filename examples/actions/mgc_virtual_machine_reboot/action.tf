@@ -0,0 +1,7 @@
+action "mgc_virtual_machine_reboot" "web" {
+  config {
+    instance_id = mgc_virtual_machine_instances.web.id
+  }
+}
+
+# terraform apply -invoke=action.mgc_virtual_machine_reboot.web
@@ -0,0 +1,29 @@
+variable "registry_address" {
+  type        = string
+  description = "Host name of the container registry of the region."
+}
+
+ephemeral "mgc_container_credentials" "creds" {
+}
+
+# Write-only attributes take ephemeral values and are never stored in the state.
+resource "kubernetes_secret_v1" "registry" {
+  metadata {
+    name = "mgc-registry"
+  }
+
+  type = "kubernetes.io/dockerconfigjson"
+
+  data_wo = {
+    ".dockerconfigjson" = jsonencode({
+      auths = {
+        (var.registry_address) = {
+          username = ephemeral.mgc_container_credentials.creds.username
+          password = ephemeral.mgc_container_credentials.creds.password
+          auth     = base64encode("${ephemeral.mgc_container_credentials.creds.username}:${ephemeral.mgc_container_credentials.creds.password}")
+        }
+      }
+    })
+  }
+  data_wo_revision = 1
+}
@@ -0,0 +1,19 @@
+ephemeral "mgc_kubernetes_cluster_kubeconfig" "cluster" {
+  cluster_id = mgc_kubernetes_cluster.my_cluster.id
+}
+
+provider "kubernetes" {
+  host                   = ephemeral.mgc_kubernetes_cluster_kubeconfig.cluster.host
+  cluster_ca_certificate = ephemeral.mgc_kubernetes_cluster_kubeconfig.cluster.cluster_ca_certificate
+  client_certificate     = ephemeral.mgc_kubernetes_cluster_kubeconfig.cluster.client_certificate
+  client_key             = ephemeral.mgc_kubernetes_cluster_kubeconfig.cluster.client_key
+}
+
+provider "helm" {
+  kubernetes = {
+    host                   = ephemeral.mgc_kubernetes_cluster_kubeconfig.cluster.host
+    cluster_ca_certificate = ephemeral.mgc_kubernetes_cluster_kubeconfig.cluster.cluster_ca_certificate
+    client_certificate     = ephemeral.mgc_kubernetes_cluster_kubeconfig.cluster.client_certificate
+    client_key             = ephemeral.mgc_kubernetes_cluster_kubeconfig.cluster.client_key
+  }
+}
@@ -0,0 +1,13 @@
+variable "availability_zone" {
+  type    = string
+  default = "br-se1-a"
+}
+
+resource "mgc_virtual_machine_instances" "example" {
+  # "example-vm-a" for "br-se1-a"
+  name              = "example-vm-${provider::mgc::az_to_zone(var.availability_zone)}"
+  machine_type      = "BV1-1-40"
+  image             = "cloud-ubuntu-24.04 LTS"
+  ssh_key_name      = "my-ssh-key"
+  availability_zone = var.availability_zone
+}
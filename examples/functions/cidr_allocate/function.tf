@@ -0,0 +1,18 @@
+locals {
+  # ["172.26.1.0/24", "172.26.2.0/24", "172.26.3.0/26"]
+  subnet_cidrs = provider::mgc::cidr_allocate(
+    mgc_network_subnetpools.example.cidr,
+    ["172.26.0.0/24"], # reserved for the subnets managed elsewhere
+    [24, 24, 26],
+  )
+}
+
+resource "mgc_network_vpcs_subnets" "example" {
+  count           = length(local.subnet_cidrs)
+  name            = "example-subnet-${count.index}"
+  vpc_id          = mgc_network_vpcs.example.id
+  subnetpool_id   = mgc_network_subnetpools.example.id
+  cidr_block      = local.subnet_cidrs[count.index]
+  ip_version      = "IPv4"
+  dns_nameservers = ["8.8.8.8"]
+}
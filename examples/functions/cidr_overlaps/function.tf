@@ -0,0 +1,16 @@
+variable "peer_cidrs" {
+  type = list(string)
+}
+
+resource "mgc_network_subnetpools" "example" {
+  name        = "example-subnetpool"
+  description = "Example subnetpool"
+  cidr        = "172.26.0.0/16"
+
+  lifecycle {
+    precondition {
+      condition     = alltrue([for cidr in var.peer_cidrs : !provider::mgc::cidr_overlaps("172.26.0.0/16", cidr)])
+      error_message = "The subnetpool overlaps a peered network."
+    }
+  }
+}
@@ -0,0 +1,4 @@
+output "api_endpoint" {
+  # "https://api.magalu.cloud/br-ne1"
+  value = provider::mgc::region_endpoint("br-ne1")
+}
@@ -0,0 +1,4 @@
+locals {
+  # ["br-se1-a", "br-se1-b"]
+  availability_zones = [for zone in ["a", "b"] : provider::mgc::zone_to_az("br-se1", zone)]
+}
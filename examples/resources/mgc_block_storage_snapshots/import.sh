@@ -0,0 +1 @@
+terraform import mgc_block_storage_snapshots.example_snapshot snapshot_id
\ No newline at end of file
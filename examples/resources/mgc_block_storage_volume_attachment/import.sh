@@ -0,0 +1 @@
+terraform import mgc_block_storage_volume_attachment.example_attachment volume_id,virtual_machine_id
\ No newline at end of file
@@ -0,0 +1 @@
+terraform import mgc_virtual_machine_interface_attach.example_attach instance_id,interface_id
\ No newline at end of file
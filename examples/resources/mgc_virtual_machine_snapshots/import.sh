@@ -0,0 +1 @@
+terraform import mgc_virtual_machine_snapshots.example_snapshot snapshot_id
\ No newline at end of file
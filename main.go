@@ -22,7 +22,18 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), mgc.New(Version), opts)
+	ctx := context.Background()
+
+	shutdownTracing, err := mgc.SetupTracing(ctx, Version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	err = providerserver.Serve(ctx, mgc.New(Version), opts)
+
+	if shutdownErr := shutdownTracing(ctx); shutdownErr != nil {
+		log.Printf("failed to flush traces: %s", shutdownErr)
+	}
 
 	if err != nil {
 		log.Fatal(err.Error())
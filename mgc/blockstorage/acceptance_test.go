@@ -0,0 +1,123 @@
+package blockstorage_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/acctest"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+)
+
+func testAccVolumeConfig(srv *fakeapi.Server, name string, size int, volumeType string) string {
+	return acctest.Config(srv, fmt.Sprintf(`
+resource "mgc_block_storage_volumes" "test" {
+  name = %q
+  size = %d
+  type = %q
+}
+`, name, size, volumeType))
+}
+
+func TestAccBlockStorageVolume(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_block_storage_volumes"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVolumeConfig(srv, "acc-volume", 10, fakeapi.VolumeType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mgc_block_storage_volumes.test", "id"),
+					resource.TestCheckResourceAttr("mgc_block_storage_volumes.test", "size", "10"),
+					resource.TestCheckResourceAttr("mgc_block_storage_volumes.test", "availability_zone", fakeapi.AvailabilityZone),
+					resource.TestCheckResourceAttr("mgc_block_storage_volumes.test", "encrypted", "false"),
+				),
+			},
+			{
+				ResourceName:            "mgc_block_storage_volumes.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccVolumeConfig(srv, "acc-volume-renamed", 20, fakeapi.VolumeTypeFast),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mgc_block_storage_volumes.test", "name", "acc-volume-renamed"),
+					resource.TestCheckResourceAttr("mgc_block_storage_volumes.test", "size", "20"),
+					resource.TestCheckResourceAttr("mgc_block_storage_volumes.test", "type", fakeapi.VolumeTypeFast),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageVolume_ProvisioningFailure(t *testing.T) {
+	srv := acctest.NewServer(t)
+	srv.FailProvisioning("acc-volume-failed")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_block_storage_volumes"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVolumeConfig(srv, "acc-volume-failed", 10, fakeapi.VolumeType),
+				ExpectError: regexp.MustCompile(`in error state "error"`),
+			},
+			{
+				Config: testAccVolumeConfig(srv, "acc-volume", 10, fakeapi.VolumeType),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mgc_block_storage_volumes.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("mgc_block_storage_volumes.test", "name", "acc-volume"),
+			},
+		},
+	})
+}
+
+func TestAccBlockStorageSnapshotNowAction(t *testing.T) {
+	t.Parallel()
+	srv := acctest.NewServer(t)
+	client := storageSDK.New(acctest.Client(srv))
+	volumeType := fakeapi.VolumeType
+	volumeID, err := client.Volumes().Create(context.Background(), storageSDK.CreateVolumeRequest{
+		Name: "acc-volume",
+		Size: 10,
+		Type: storageSDK.IDOrName{Name: &volumeType},
+	})
+	require.NoError(t, err)
+
+	progress, err := acctest.InvokeAction(t, srv, "mgc_block_storage_snapshot_now", map[string]any{
+		"volume_id": volumeID,
+		"name":      "acc-snapshot",
+	})
+	require.NoError(t, err)
+	require.Len(t, progress, 2)
+	assert.Equal(t, fmt.Sprintf("Creating snapshot acc-snapshot of volume %s", volumeID), progress[0])
+
+	completed := regexp.MustCompile(`^Snapshot acc-snapshot \((.+)\) completed$`).FindStringSubmatch(progress[1])
+	require.NotNil(t, completed, progress[1])
+	snapshot, err := client.Snapshots().Get(context.Background(), completed[1], nil)
+	require.NoError(t, err)
+	assert.Equal(t, "acc-snapshot", snapshot.Name)
+	assert.Equal(t, "instant", snapshot.Type)
+	assert.EqualValues(t, "completed", snapshot.Status)
+
+	_, err = acctest.InvokeAction(t, srv, "mgc_block_storage_snapshot_now", map[string]any{
+		"volume_id": volumeID,
+		"name":      "-acc-snapshot",
+	})
+	assert.ErrorContains(t, err, "The name must contain only lowercase letters")
+}
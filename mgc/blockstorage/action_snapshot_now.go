@@ -0,0 +1,142 @@
+package blockstorage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const blockStorageSnapshotNowTypeName = "mgc_block_storage_snapshot_now"
+
+var _ action.ActionWithConfigure = &bsSnapshotNowAction{}
+
+func NewBlockStorageSnapshotNowAction() action.Action {
+	return &bsSnapshotNowAction{}
+}
+
+// bsSnapshotNowAction takes a snapshot of a volume outside of any schedule.
+// The snapshot is not managed by terraform afterwards.
+type bsSnapshotNowAction struct {
+	regional    utils.RegionalService
+	bsSnapshots storageSDK.SnapshotService
+}
+
+type bsSnapshotNowActionModel struct {
+	Region      types.String `tfsdk:"region"`
+	VolumeID    types.String `tfsdk:"volume_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+}
+
+func (a *bsSnapshotNowAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = blockStorageSnapshotNowTypeName
+}
+
+func (a *bsSnapshotNowAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	a.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		a.bsSnapshots = storageSDK.New(&cfg.CoreConfig).Snapshots()
+	})
+}
+
+func (a *bsSnapshotNowAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Takes a snapshot of a block storage volume and waits until it is completed. " +
+			"The snapshot is not tracked in the state; manage its lifecycle with a snapshot schedule or delete it outside terraform.",
+		Attributes: map[string]schema.Attribute{
+			"region": utils.ActionRegionAttribute(),
+			"volume_id": schema.StringAttribute{
+				Description: "ID of the volume to snapshot.",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the snapshot. Defaults to `snapshot-` followed by the UTC time of the invocation, such as `snapshot-20250102-150405`.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+					stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[a-z0-9]+(?:[-_][a-z0-9]+)*$`),
+						"The name must contain only lowercase letters, numbers, underlines and hyphens. Hyphens and underlines cannot be located at the edges either.",
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the snapshot.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"type": schema.StringAttribute{
+				Description: "Type of the snapshot, instant or object. Defaults to instant.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("instant", "object"),
+				},
+			},
+		},
+	}
+}
+
+func (a *bsSnapshotNowAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tracing.Action(&ctx, blockStorageSnapshotNowTypeName, &resp.Diagnostics)()
+
+	var data bsSnapshotNowActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(a.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if name == "" {
+		name = "snapshot-" + time.Now().UTC().Format("20060102-150405")
+	}
+	snapshotType := data.Type.ValueString()
+	if snapshotType == "" {
+		snapshotType = "instant"
+	}
+
+	volumeID := data.VolumeID.ValueString()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Creating snapshot %s of volume %s", name, volumeID)})
+	snapshotID, err := a.bsSnapshots.Create(ctx, storageSDK.CreateSnapshotRequest{
+		Name:        name,
+		Description: data.Description.ValueStringPointer(),
+		Volume:      &storageSDK.IDOrName{ID: &volumeID},
+		Type:        &snapshotType,
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+
+	if _, err := waitUntilSnapshotStatusMatches(ctx, a.bsSnapshots, snapshotID, SnapshotCompleted, volumeSnapshotStatusTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Snapshot %s (%s) completed", name, snapshotID)})
+}
@@ -1,7 +1,9 @@
 package blockstorage
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -26,3 +28,16 @@ func GetResources() []func() resource.Resource {
 		NewBlockStorageScheduleAttachResource,
 	}
 }
+
+func GetListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewBlockStorageSnapshotsList,
+		NewBlockStorageVolumesList,
+	}
+}
+
+func GetActions() []func() action.Action {
+	return []func() action.Action{
+		NewBlockStorageSnapshotNowAction,
+	}
+}
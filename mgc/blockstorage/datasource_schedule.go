@@ -8,12 +8,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
 var _ datasource.DataSource = &DataSourceBsSchedule{}
 
 type DataSourceBsSchedule struct {
+	regional    utils.RegionalService
 	bsScheduler storageSDK.SchedulerService
 }
 
@@ -21,8 +23,8 @@ func NewDataSourceBSSchedule() datasource.DataSource {
 	return &DataSourceBsSchedule{}
 }
 
-func (r *DataSourceBsSchedule) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_schedule"
+func (r *DataSourceBsSchedule) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = blockStorageScheduleTypeName
 }
 
 type bsScheduleDataSourceModel struct {
@@ -38,6 +40,11 @@ type bsScheduleDataSourceModel struct {
 	UpdatedAt             types.String `tfsdk:"updated_at"`
 }
 
+type bsScheduleRegionalDataSourceModel struct {
+	bsScheduleDataSourceModel
+	Region types.String `tfsdk:"region"`
+}
+
 func (r *DataSourceBsSchedule) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -49,8 +56,10 @@ func (r *DataSourceBsSchedule) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client := storageSDK.New(&dataConfig.CoreConfig)
-	r.bsScheduler = client.Schedulers()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := storageSDK.New(&cfg.CoreConfig)
+		r.bsScheduler = client.Schedulers()
+	})
 }
 
 func GetBsScheduleAttributes(idRequired bool) map[string]schema.Attribute {
@@ -102,23 +111,32 @@ func GetBsScheduleAttributes(idRequired bool) map[string]schema.Attribute {
 
 func (r *DataSourceBsSchedule) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	description := "Block storage snapshot schedule"
+	attributes := GetBsScheduleAttributes(true)
+	attributes["region"] = utils.DataSourceRegionAttribute()
 	resp.Schema = schema.Schema{
 		Description:         description,
 		MarkdownDescription: description,
-		Attributes:          GetBsScheduleAttributes(true),
+		Attributes:          attributes,
 	}
 }
 
 func (r *DataSourceBsSchedule) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data bsScheduleDataSourceModel
+	defer tracing.DataSource(&ctx, blockStorageScheduleTypeName, &resp.State, &resp.Diagnostics)()
+
+	var data bsScheduleRegionalDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	get, err := r.bsScheduler.Get(ctx, data.ID.ValueString(), []storageSDK.ExpandSchedulers{storageSDK.ExpandSchedulersVolume})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -3,16 +3,22 @@ package blockstorage
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const blockStorageSchedulesTypeName = "mgc_block_storage_schedules"
+
 var _ datasource.DataSource = &DataSourceBsSchedules{}
 
 type DataSourceBsSchedules struct {
+	regional    utils.RegionalService
 	bsScheduler storageSDK.SchedulerService
 }
 
@@ -20,12 +26,13 @@ func NewDataSourceBSSchedules() datasource.DataSource {
 	return &DataSourceBsSchedules{}
 }
 
-func (r *DataSourceBsSchedules) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_schedules"
+func (r *DataSourceBsSchedules) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = blockStorageSchedulesTypeName
 }
 
 type bsSchedulesDataSourceModel struct {
 	Schedules []bsScheduleDataSourceModel `tfsdk:"schedules"`
+	Region    types.String                `tfsdk:"region"`
 }
 
 func (r *DataSourceBsSchedules) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -39,8 +46,10 @@ func (r *DataSourceBsSchedules) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	client := storageSDK.New(&dataConfig.CoreConfig)
-	r.bsScheduler = client.Schedulers()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := storageSDK.New(&cfg.CoreConfig)
+		r.bsScheduler = client.Schedulers()
+	})
 }
 
 func (r *DataSourceBsSchedules) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -49,6 +58,7 @@ func (r *DataSourceBsSchedules) Schema(_ context.Context, req datasource.SchemaR
 		Description:         description,
 		MarkdownDescription: description,
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"schedules": schema.ListNestedAttribute{
 				Description: "List of snapshot schedules.",
 				Computed:    true,
@@ -61,17 +71,24 @@ func (r *DataSourceBsSchedules) Schema(_ context.Context, req datasource.SchemaR
 }
 
 func (r *DataSourceBsSchedules) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, blockStorageSchedulesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data bsSchedulesDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	schedulesResponse, err := r.bsScheduler.ListAll(ctx, storageSDK.SchedulerFilterOptions{
 		Expand: []storageSDK.ExpandSchedulers{storageSDK.ExpandSchedulersVolume},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -8,13 +8,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	bsSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const blockStorageSnapshotTypeName = "mgc_block_storage_snapshot"
+
 var _ datasource.DataSource = &DataSourceBsSnapshotDatasource{}
 
 type DataSourceBsSnapshotDatasource struct {
+	regional          utils.RegionalService
 	bsSnapshotService bsSDK.SnapshotService
 }
 
@@ -22,8 +26,8 @@ func NewDataSourceBSSnapshotDatasource() datasource.DataSource {
 	return &DataSourceBsSnapshotDatasource{}
 }
 
-func (r *DataSourceBsSnapshotDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_snapshot"
+func (r *DataSourceBsSnapshotDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = blockStorageSnapshotTypeName
 }
 
 type bsSnapshotDataSourceModel struct {
@@ -38,6 +42,7 @@ type bsSnapshotDataSourceModel struct {
 	Size              types.Int64  `tfsdk:"size"`
 	Type              types.String `tfsdk:"type"`
 	AvailabilityZones types.List   `tfsdk:"availability_zones"`
+	Region            types.String `tfsdk:"region"`
 }
 
 func (r *DataSourceBsSnapshotDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -52,7 +57,9 @@ func (r *DataSourceBsSnapshotDatasource) Configure(ctx context.Context, req data
 		return
 	}
 
-	r.bsSnapshotService = bsSDK.New(&dataConfig.CoreConfig).Snapshots()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.bsSnapshotService = bsSDK.New(&cfg.CoreConfig).Snapshots()
+	})
 }
 
 func GetBsSnapshotAttributes(idRequired bool) map[string]schema.Attribute {
@@ -108,23 +115,32 @@ func GetBsSnapshotAttributes(idRequired bool) map[string]schema.Attribute {
 
 func (r *DataSourceBsSnapshotDatasource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	description := "Block storage snapshots"
+	attributes := GetBsSnapshotAttributes(true)
+	attributes["region"] = utils.DataSourceRegionAttribute()
 	resp.Schema = schema.Schema{
 		Description:         description,
 		MarkdownDescription: description,
-		Attributes:          GetBsSnapshotAttributes(true),
+		Attributes:          attributes,
 	}
 }
 
 func (r *DataSourceBsSnapshotDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, blockStorageSnapshotTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data bsSnapshotDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkOutput, err := r.bsSnapshotService.Get(ctx, data.ID.ValueString(), []string{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	bsSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -15,6 +16,7 @@ import (
 var _ datasource.DataSource = &DataSourceBsSnapshots{}
 
 type DataSourceBsSnapshots struct {
+	regional          utils.RegionalService
 	bsSnapshotService bsSDK.SnapshotService
 }
 
@@ -22,12 +24,13 @@ func NewDataSourceBSSnapshots() datasource.DataSource {
 	return &DataSourceBsSnapshots{}
 }
 
-func (r *DataSourceBsSnapshots) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_snapshots"
+func (r *DataSourceBsSnapshots) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = blockStorageSnapshotsTypeName
 }
 
 type bsSnapshotsListDataSourceModel struct {
 	Snapshots []bsSnapshotsDataSourceItemModel `tfsdk:"snapshots"`
+	Region    types.String                     `tfsdk:"region"`
 }
 
 type bsSnapshotsDataSourceItemModel struct {
@@ -56,12 +59,15 @@ func (r *DataSourceBsSnapshots) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	r.bsSnapshotService = bsSDK.New(&dataConfig.CoreConfig).Snapshots()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.bsSnapshotService = bsSDK.New(&cfg.CoreConfig).Snapshots()
+	})
 }
 
 func (r *DataSourceBsSnapshots) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"snapshots": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of available Block Storage Snapshots.",
@@ -74,6 +80,8 @@ func (r *DataSourceBsSnapshots) Schema(_ context.Context, req datasource.SchemaR
 }
 
 func (r *DataSourceBsSnapshots) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, blockStorageSnapshotsTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data bsSnapshotsListDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -81,9 +89,14 @@ func (r *DataSourceBsSnapshots) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkOutputList, err := r.bsSnapshotService.ListAll(ctx, bsSDK.SnapshotFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -8,13 +8,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	bsSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const blockStorageVolumeTypeName = "mgc_block_storage_volume"
+
 var _ datasource.DataSource = &DataSourceBsVolume{}
 
 type DataSourceBsVolume struct {
+	regional utils.RegionalService
 	bsVolume bsSDK.VolumeService
 }
 
@@ -22,8 +26,8 @@ func NewDataSourceBsVolume() datasource.DataSource {
 	return &DataSourceBsVolume{}
 }
 
-func (r *DataSourceBsVolume) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_volume"
+func (r *DataSourceBsVolume) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = blockStorageVolumeTypeName
 }
 
 type bsVolumeResourceModel struct {
@@ -44,6 +48,7 @@ type bsVolumeResourceModel struct {
 	AttachedInstanceId   types.String `tfsdk:"attached_instance_id"`
 	AttachedInstanceName types.String `tfsdk:"attached_instance_name"`
 	Encrypted            types.Bool   `tfsdk:"encrypted"`
+	Region               types.String `tfsdk:"region"`
 }
 
 func (r *DataSourceBsVolume) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -57,13 +62,16 @@ func (r *DataSourceBsVolume) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	r.bsVolume = bsSDK.New(&dataConfig.CoreConfig).Volumes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.bsVolume = bsSDK.New(&cfg.CoreConfig).Volumes()
+	})
 }
 
 func (r *DataSourceBsVolume) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Block storage volume",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the volume snapshot.",
 				Required:    true,
@@ -137,15 +145,22 @@ func (r *DataSourceBsVolume) Schema(_ context.Context, req datasource.SchemaRequ
 }
 
 func (r *DataSourceBsVolume) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, blockStorageVolumeTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data bsVolumeResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkOutput, err := r.bsVolume.Get(ctx, data.ID.ValueString(), []string{"volume_type", "attachment"})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
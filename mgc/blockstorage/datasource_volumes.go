@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	bsSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -15,6 +16,7 @@ import (
 var _ datasource.DataSource = &DataSourceBsVolumes{}
 
 type DataSourceBsVolumes struct {
+	regional  utils.RegionalService
 	bsVolumes bsSDK.VolumeService
 }
 
@@ -35,12 +37,13 @@ func NewDataSourceBsVolumes() datasource.DataSource {
 	return &DataSourceBsVolumes{}
 }
 
-func (r *DataSourceBsVolumes) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_volumes"
+func (r *DataSourceBsVolumes) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = blockStorageVolumesTypeName
 }
 
 type bsVolumesDataSourceModel struct {
 	Volumes []bsVolumesDataSourceItemModel `tfsdk:"volumes"`
+	Region  types.String                   `tfsdk:"region"`
 }
 
 func (r *DataSourceBsVolumes) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -54,12 +57,15 @@ func (r *DataSourceBsVolumes) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	r.bsVolumes = bsSDK.New(&dataConfig.CoreConfig).Volumes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.bsVolumes = bsSDK.New(&cfg.CoreConfig).Volumes()
+	})
 }
 
 func (r *DataSourceBsVolumes) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"volumes": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of available Block Storage Volumes.",
@@ -113,6 +119,8 @@ func (r *DataSourceBsVolumes) Schema(_ context.Context, req datasource.SchemaReq
 }
 
 func (r *DataSourceBsVolumes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, blockStorageVolumesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data bsVolumesDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -120,9 +128,14 @@ func (r *DataSourceBsVolumes) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkOutputList, err := r.bsVolumes.ListAll(ctx, bsSDK.VolumeFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -7,13 +7,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	bsSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const blockStorageVolumeTypesTypeName = "mgc_block_storage_volume_types"
+
 var _ datasource.DataSource = &DataSourceBsVolumeTypes{}
 
 type DataSourceBsVolumeTypes struct {
+	regional      utils.RegionalService
 	bsVolumeTypes bsSDK.VolumeTypeService
 }
 
@@ -21,12 +25,13 @@ func NewDataSourceBsVolumeTypes() datasource.DataSource {
 	return &DataSourceBsVolumeTypes{}
 }
 
-func (r *DataSourceBsVolumeTypes) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_volume_types"
+func (r *DataSourceBsVolumeTypes) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = blockStorageVolumeTypesTypeName
 }
 
 type volumeTypes struct {
 	VolumeTypes []volumeType `tfsdk:"volume_types"`
+	Region      types.String `tfsdk:"region"`
 }
 
 type volumeType struct {
@@ -50,13 +55,16 @@ func (r *DataSourceBsVolumeTypes) Configure(ctx context.Context, req datasource.
 		return
 	}
 
-	r.bsVolumeTypes = bsSDK.New(&dataConfig.CoreConfig).VolumeTypes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.bsVolumeTypes = bsSDK.New(&cfg.CoreConfig).VolumeTypes()
+	})
 }
 
 func (r *DataSourceBsVolumeTypes) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Block-storage Volume Types",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"volume_types": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of available Block-storage Volume Types.",
@@ -95,6 +103,8 @@ func (r *DataSourceBsVolumeTypes) Schema(_ context.Context, req datasource.Schem
 }
 
 func (r *DataSourceBsVolumeTypes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, blockStorageVolumeTypesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data volumeTypes
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -102,9 +112,14 @@ func (r *DataSourceBsVolumeTypes) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkOutput, err := r.bsVolumeTypes.ListAll(ctx, bsSDK.VolumeTypeFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
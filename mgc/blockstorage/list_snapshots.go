@@ -0,0 +1,97 @@
+package blockstorage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+var (
+	_ list.ListResource              = &bsSnapshotsList{}
+	_ list.ListResourceWithConfigure = &bsSnapshotsList{}
+)
+
+type bsSnapshotsList struct {
+	regional    utils.RegionalService
+	bsSnapshots storageSDK.SnapshotService
+}
+
+type bsSnapshotsListModel struct {
+	utils.ListConfig
+	VolumeID types.String `tfsdk:"volume_id"`
+}
+
+func NewBlockStorageSnapshotsList() list.ListResource {
+	return &bsSnapshotsList{}
+}
+
+func (r *bsSnapshotsList) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = blockStorageSnapshotsTypeName
+}
+
+func (r *bsSnapshotsList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.bsSnapshots = storageSDK.New(&cfg.CoreConfig).Snapshots()
+	})
+}
+
+func (r *bsSnapshotsList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists block storage snapshots.",
+		Attributes: map[string]listschema.Attribute{
+			"region":     utils.ListRegionAttribute(),
+			"name_regex": utils.ListNameRegexAttribute(),
+			"volume_id": listschema.StringAttribute{
+				Description: "Only list snapshots of this volume.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *bsSnapshotsList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	utils.List(ctx, req, stream, utils.Lister[bsSnapshotsListModel, storageSDK.Snapshot]{
+		TypeName: blockStorageSnapshotsTypeName,
+		Identity: snapshotIdentity,
+		Use:      r.regional.Use,
+		Fetch: func(ctx context.Context, _ bsSnapshotsListModel) ([]storageSDK.Snapshot, error) {
+			return r.bsSnapshots.ListAll(ctx, storageSDK.SnapshotFilterOptions{})
+		},
+		Describe: func(config bsSnapshotsListModel, snapshot storageSDK.Snapshot) (string, map[string]string, bool) {
+			var volumeID string
+			if snapshot.Volume != nil && snapshot.Volume.ID != nil {
+				volumeID = *snapshot.Volume.ID
+			}
+			if !config.VolumeID.IsNull() && volumeID != config.VolumeID.ValueString() {
+				return "", nil, false
+			}
+			return snapshot.Name, map[string]string{"id": snapshot.ID}, true
+		},
+		Resource: func(_ context.Context, config bsSnapshotsListModel, snapshot storageSDK.Snapshot) (any, diag.Diagnostics) {
+			if snapshot.Volume == nil {
+				snapshot.Volume = &storageSDK.IDOrName{}
+			}
+			data := (&bsSnapshots{}).toTerraformModel(snapshot, nil)
+			data.Timeouts = utils.NullTimeouts(bsSnapshotTimeoutsOpts)
+			data.Region = config.Region
+			return data, nil
+		},
+	})
+}
@@ -0,0 +1,96 @@
+package blockstorage
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+var (
+	_ list.ListResource              = &bsVolumesList{}
+	_ list.ListResourceWithConfigure = &bsVolumesList{}
+)
+
+type bsVolumesList struct {
+	regional  utils.RegionalService
+	bsVolumes storageSDK.VolumeService
+}
+
+type bsVolumesListModel struct {
+	utils.ListConfig
+	Status           types.String `tfsdk:"status"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+}
+
+func NewBlockStorageVolumesList() list.ListResource {
+	return &bsVolumesList{}
+}
+
+func (r *bsVolumesList) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = blockStorageVolumesTypeName
+}
+
+func (r *bsVolumesList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.bsVolumes = storageSDK.New(&cfg.CoreConfig).Volumes()
+	})
+}
+
+func (r *bsVolumesList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists block storage volumes.",
+		Attributes: map[string]listschema.Attribute{
+			"region":     utils.ListRegionAttribute(),
+			"name_regex": utils.ListNameRegexAttribute(),
+			"status": listschema.StringAttribute{
+				Description: "Only list volumes with this status, e.g. `completed`.",
+				Optional:    true,
+			},
+			"availability_zone": listschema.StringAttribute{
+				Description: "Only list volumes in this availability zone, e.g. `br-se1-a`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *bsVolumesList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	utils.List(ctx, req, stream, utils.Lister[bsVolumesListModel, storageSDK.Volume]{
+		TypeName: blockStorageVolumesTypeName,
+		Identity: volumeIdentity,
+		Use:      r.regional.Use,
+		Fetch: func(ctx context.Context, _ bsVolumesListModel) ([]storageSDK.Volume, error) {
+			return r.bsVolumes.ListAll(ctx, storageSDK.VolumeFilterOptions{Expand: []storageSDK.VolumeExpand{storageSDK.VolumeTypeExpand}})
+		},
+		Describe: func(config bsVolumesListModel, volume storageSDK.Volume) (string, map[string]string, bool) {
+			if (!config.Status.IsNull() && volume.Status != config.Status.ValueString()) ||
+				(!config.AvailabilityZone.IsNull() && volume.AvailabilityZone != config.AvailabilityZone.ValueString()) {
+				return "", nil, false
+			}
+			return volume.Name, map[string]string{"id": volume.ID}, true
+		},
+		Resource: func(_ context.Context, config bsVolumesListModel, volume storageSDK.Volume) (any, diag.Diagnostics) {
+			data := (&bsVolumes{}).toTerraformModel(volume, nil)
+			data.Timeouts = utils.NullTimeouts(bsVolumeTimeoutsOpts)
+			data.Region = config.Region
+			return data, nil
+		},
+	})
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
@@ -17,19 +16,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const blockStorageScheduleTypeName = "mgc_block_storage_schedule"
+
+var scheduleIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the schedule."),
+)
+
 func NewBlockStorageScheduleResource() resource.Resource {
 	return &bsSchedule{}
 }
 
 type bsSchedule struct {
+	regional    utils.RegionalService
 	bsScheduler storageSDK.SchedulerService
 }
 
-func (r *bsSchedule) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_schedule"
+func (r *bsSchedule) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = blockStorageScheduleTypeName
 }
 
 func (r *bsSchedule) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -42,8 +49,10 @@ func (r *bsSchedule) Configure(ctx context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client := storageSDK.New(&dataConfig.CoreConfig)
-	r.bsScheduler = client.Schedulers()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := storageSDK.New(&cfg.CoreConfig)
+		r.bsScheduler = client.Schedulers()
+	})
 }
 
 type bsScheduleResourceModel struct {
@@ -57,12 +66,14 @@ type bsScheduleResourceModel struct {
 	State                 types.String `tfsdk:"state"`
 	CreatedAt             types.String `tfsdk:"created_at"`
 	UpdatedAt             types.String `tfsdk:"updated_at"`
+	Region                types.String `tfsdk:"region"`
 }
 
 func (r *bsSchedule) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The block storage schedule resource allows you to manage automatic snapshot schedules in the Magalu Cloud.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the snapshot schedule.",
 				PlanModifiers: []planmodifier.String{
@@ -152,27 +163,47 @@ func (r *bsSchedule) Schema(_ context.Context, _ resource.SchemaRequest, resp *r
 }
 
 func (r *bsSchedule) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, blockStorageScheduleTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer scheduleIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := &bsScheduleResourceModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	get, err := r.bsScheduler.Get(ctx, data.ID.ValueString(), []storageSDK.ExpandSchedulers{storageSDK.ExpandSchedulersVolume})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, SchedulerResponseToModel(get))...)
 }
 
 func (r *bsSchedule) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, blockStorageScheduleTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer scheduleIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	plan := &bsScheduleResourceModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	created, err := r.bsScheduler.Create(ctx, storageSDK.SchedulerPayload{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueStringPointer(),
@@ -189,13 +220,14 @@ func (r *bsSchedule) Create(ctx context.Context, req resource.CreateRequest, res
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	get, err := r.bsScheduler.Get(ctx, created, []storageSDK.ExpandSchedulers{storageSDK.ExpandSchedulersVolume})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		scheduleIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": plan.Region.ValueString(), "id": created}, &resp.Diagnostics)
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, SchedulerResponseToModel(get))...)
@@ -217,22 +249,36 @@ func SchedulerResponseToModel(get *storageSDK.SchedulerResponse) *bsScheduleReso
 }
 
 func (r *bsSchedule) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, blockStorageScheduleTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer scheduleIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("This resource does not support updates", "To modify a schedule, you must delete and recreate it with the desired changes.")
 }
 
 func (r *bsSchedule) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, blockStorageScheduleTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data bsScheduleResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.bsScheduler.Delete(ctx, data.ID.ValueString()); err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+func (r *bsSchedule) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = scheduleIdentity.Schema()
+}
+
 func (r *bsSchedule) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	scheduleIdentity.ImportState(ctx, req, resp)
 }
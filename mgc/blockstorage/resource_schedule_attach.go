@@ -2,9 +2,7 @@ package blockstorage
 
 import (
 	"context"
-	"fmt"
 	"slices"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,24 +11,34 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const blockStorageScheduleAttachTypeName = "mgc_block_storage_schedule_attach"
+
 type bsScheduleAttach struct {
+	regional    utils.RegionalService
 	bsScheduler storageSDK.SchedulerService
 }
 
 type bsScheduleAttachResourceModel struct {
 	ScheduleID types.String `tfsdk:"schedule_id"`
 	VolumeID   types.String `tfsdk:"volume_id"`
+	Region     types.String `tfsdk:"region"`
 }
 
+var scheduleAttachIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "schedule_id", Description: "ID of the schedule."},
+	utils.IdentityAttribute{Name: "volume_id", Description: "ID of the volume."},
+)
+
 func NewBlockStorageScheduleAttachResource() resource.Resource {
 	return &bsScheduleAttach{}
 }
 
-func (r *bsScheduleAttach) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_schedule_attach"
+func (r *bsScheduleAttach) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = blockStorageScheduleAttachTypeName
 }
 
 func (r *bsScheduleAttach) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -43,14 +51,17 @@ func (r *bsScheduleAttach) Configure(ctx context.Context, req resource.Configure
 		return
 	}
 
-	client := storageSDK.New(&dataConfig.CoreConfig)
-	r.bsScheduler = client.Schedulers()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := storageSDK.New(&cfg.CoreConfig)
+		r.bsScheduler = client.Schedulers()
+	})
 }
 
 func (r *bsScheduleAttach) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Attaches a block storage volume to a snapshot schedule. This creates a relationship between a volume and a schedule, allowing the schedule to create snapshots of the volume.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"schedule_id": schema.StringAttribute{
 				Description: "The ID of the snapshot schedule to attach the volume to.",
 				Required:    true,
@@ -70,23 +81,32 @@ func (r *bsScheduleAttach) Schema(_ context.Context, _ resource.SchemaRequest, r
 }
 
 func (r *bsScheduleAttach) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, blockStorageScheduleAttachTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer scheduleAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := &bsScheduleAttachResourceModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	get, err := r.bsScheduler.Get(ctx, data.ScheduleID.ValueString(), []storageSDK.ExpandSchedulers{storageSDK.ExpandSchedulersVolume})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	if !slices.Contains(get.Volumes, data.VolumeID.ValueString()) {
-		resp.Diagnostics.AddError(
-			"Volume not attached to schedule",
-			fmt.Sprintf("Volume %s is not attached to schedule %s", data.VolumeID.ValueString(), data.ScheduleID.ValueString()),
-		)
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -94,19 +114,27 @@ func (r *bsScheduleAttach) Read(ctx context.Context, req resource.ReadRequest, r
 }
 
 func (r *bsScheduleAttach) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, blockStorageScheduleAttachTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer scheduleAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	plan := &bsScheduleAttachResourceModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.bsScheduler.AttachVolume(ctx, plan.ScheduleID.ValueString(), storageSDK.SchedulerVolumeIdentifierPayload{
 		Volume: storageSDK.IDOrName{
 			ID: plan.VolumeID.ValueStringPointer(),
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -114,36 +142,41 @@ func (r *bsScheduleAttach) Create(ctx context.Context, req resource.CreateReques
 }
 
 func (r *bsScheduleAttach) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, blockStorageScheduleAttachTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer scheduleAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("Update not supported", "This resource does not support updates. To modify the attachment, you must delete and recreate it.")
 }
 
 func (r *bsScheduleAttach) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, blockStorageScheduleAttachTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data bsScheduleAttachResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.bsScheduler.DetachVolume(ctx, data.ScheduleID.ValueString(), storageSDK.SchedulerVolumeIdentifierPayload{
 		Volume: storageSDK.IDOrName{
 			ID: data.VolumeID.ValueStringPointer(),
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
-func (r *bsScheduleAttach) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	input := strings.Split(req.ID, ",")
-	if len(input) != 2 {
-		resp.Diagnostics.AddError("Invalid ID", "ID must be in the format schedule_id,volume_id")
-		return
-	}
+func (r *bsScheduleAttach) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = scheduleAttachIdentity.Schema()
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &bsScheduleAttachResourceModel{
-		ScheduleID: types.StringValue(input[0]),
-		VolumeID:   types.StringValue(input[1]),
-	})...)
+func (r *bsScheduleAttach) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scheduleAttachIdentity.ImportState(ctx, req, resp)
 }
@@ -17,10 +17,14 @@ import (
 
 	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const blockStorageSnapshotsTypeName = "mgc_block_storage_snapshots"
+
 const volumeSnapshotStatusTimeout = 70 * time.Minute
 
 type SnapshotStatus string
@@ -49,16 +53,21 @@ func (s SnapshotStatus) IsError() bool {
 	return strings.HasSuffix(s.String(), "error")
 }
 
+var snapshotIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the snapshot."),
+)
+
 func NewBlockStorageSnapshotsResource() resource.Resource {
 	return &bsSnapshots{}
 }
 
 type bsSnapshots struct {
+	regional    utils.RegionalService
 	bsSnapshots storageSDK.SnapshotService
 }
 
-func (r *bsSnapshots) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_snapshots"
+func (r *bsSnapshots) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = blockStorageSnapshotsTypeName
 }
 
 func (r *bsSnapshots) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -71,23 +80,30 @@ func (r *bsSnapshots) Configure(ctx context.Context, req resource.ConfigureReque
 		return
 	}
 
-	r.bsSnapshots = storageSDK.New(&dataConfig.CoreConfig).Snapshots()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.bsSnapshots = storageSDK.New(&cfg.CoreConfig).Snapshots()
+	})
 }
 
 type bsSnapshotsResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	VolumeId         types.String `tfsdk:"volume_id"`
-	SnapshotSourceID types.String `tfsdk:"snapshot_source_id"`
-	Type             types.String `tfsdk:"type"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	Description      types.String   `tfsdk:"description"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	VolumeId         types.String   `tfsdk:"volume_id"`
+	SnapshotSourceID types.String   `tfsdk:"snapshot_source_id"`
+	Type             types.String   `tfsdk:"type"`
+	Region           types.String   `tfsdk:"region"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
-func (r *bsSnapshots) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+var bsSnapshotTimeoutsOpts = timeouts.Opts{Create: true, Update: true}
+
+func (r *bsSnapshots) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "The block storage snapshots resource allows you to manage block storage snapshots in the Magalu Cloud.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the volume snapshot.",
 				PlanModifiers: []planmodifier.String{
@@ -151,30 +167,64 @@ func (r *bsSnapshots) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Default:  stringdefault.StaticString("instant"),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, bsSnapshotTimeoutsOpts),
+		},
 	}
 }
 
 func (r *bsSnapshots) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, blockStorageSnapshotsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer snapshotIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := &bsSnapshotsResourceModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	result, err := r.bsSnapshots.Get(ctx, data.ID.ValueString(), []string{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	convertedResult := r.toTerraformModel(*result, data.SnapshotSourceID.ValueStringPointer())
+	convertedResult.Timeouts = data.Timeouts
+	convertedResult.Region = data.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &convertedResult)...)
 }
 
 func (r *bsSnapshots) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, blockStorageSnapshotsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer snapshotIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	plan := &bsSnapshotsResourceModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, volumeSnapshotStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createRequest := storageSDK.CreateSnapshotRequest{
 		Name:        plan.Name.ValueString(),
 		Description: plan.Description.ValueStringPointer(),
@@ -192,56 +242,93 @@ func (r *bsSnapshots) Create(ctx context.Context, req resource.CreateRequest, re
 
 	createID, err := r.bsSnapshots.Create(ctx, createRequest)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
-	plan.ID = types.StringValue(createID)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
-
-	getResult, err := r.waitUntilSnapshotStatusMatches(ctx, createID, SnapshotCompleted)
+	getResult, err := waitUntilSnapshotStatusMatches(ctx, r.bsSnapshots, createID, SnapshotCompleted, createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		snapshotIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": plan.Region.ValueString(), "id": createID}, &resp.Diagnostics)
 		return
 	}
 
 	convertedGet := r.toTerraformModel(*getResult, plan.SnapshotSourceID.ValueStringPointer())
+	convertedGet.Timeouts = plan.Timeouts
+	convertedGet.Region = plan.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &convertedGet)...)
 }
 
 func (r *bsSnapshots) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, blockStorageSnapshotsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer snapshotIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	state := &bsSnapshotsResourceModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	plan := &bsSnapshotsResourceModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, volumeSnapshotStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if state.Name != plan.Name {
 		err := r.bsSnapshots.Rename(ctx, state.ID.ValueString(), plan.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
-		_, err = r.waitUntilSnapshotStatusMatches(ctx, state.ID.ValueString(), SnapshotCompleted)
+		_, err = waitUntilSnapshotStatusMatches(ctx, r.bsSnapshots, state.ID.ValueString(), SnapshotCompleted, updateTimeout)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		}
 	}
 
+	plan.Region = state.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (r *bsSnapshots) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, blockStorageSnapshotsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data bsSnapshotsResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	err := r.bsSnapshots.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 	}
 }
 
+func (r *bsSnapshots) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = snapshotIdentity.Schema()
+}
+
+func (r *bsSnapshots) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	snapshotIdentity.ImportState(ctx, req, resp)
+}
+
 func (r *bsSnapshots) toTerraformModel(snapshot storageSDK.Snapshot, sourceSnapshotId *string) bsSnapshotsResourceModel {
 	return bsSnapshotsResourceModel{
 		ID:               types.StringValue(snapshot.ID),
@@ -254,26 +341,20 @@ func (r *bsSnapshots) toTerraformModel(snapshot storageSDK.Snapshot, sourceSnaps
 	}
 }
 
-func (r *bsSnapshots) waitUntilSnapshotStatusMatches(ctx context.Context, snapshotID string, status SnapshotStatus) (*storageSDK.Snapshot, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, volumeSnapshotStatusTimeout)
-	defer cancel()
-
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for snapshot %s to reach status %s", snapshotID, status)
-		case <-time.After(10 * time.Second):
-			snapshot, err := r.bsSnapshots.Get(ctx, snapshotID, []string{})
+func waitUntilSnapshotStatusMatches(ctx context.Context, snapshots storageSDK.SnapshotService, snapshotID string, status SnapshotStatus, timeout time.Duration) (*storageSDK.Snapshot, error) {
+	return utils.StateWaiter[*storageSDK.Snapshot]{
+		Description: fmt.Sprintf("snapshot %s", snapshotID),
+		Target:      []string{status.String()},
+		IsError:     func(state string) bool { return SnapshotStatus(state).IsError() },
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		MinInterval: 10 * time.Second,
+		Refresh: func(ctx context.Context) (*storageSDK.Snapshot, string, error) {
+			snapshot, err := snapshots.Get(ctx, snapshotID, []string{})
 			if err != nil {
-				return nil, err
-			}
-			currentStatus := SnapshotStatus(snapshot.Status)
-			if currentStatus == status {
-				return snapshot, nil
-			}
-			if currentStatus.IsError() {
-				return nil, fmt.Errorf("snapshot %s is in error state: %s", snapshotID, currentStatus)
+				return nil, "", err
 			}
-		}
-	}
+			return snapshot, string(snapshot.Status), nil
+		},
+	}.Wait(ctx)
 }
@@ -2,8 +2,10 @@ package blockstorage
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -12,29 +14,40 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const blockStorageVolumeAttachmentTypeName = "mgc_block_storage_volume_attachment"
+
 const (
 	AttachVolumeTimeout         = 5 * time.Minute
 	AttachVolumeCompletedStatus = "completed"
 )
 
 type VolumeAttach struct {
+	regional            utils.RegionalService
 	blockStorageVolumes storageSDK.VolumeService
 }
 
 type VolumeAttachResourceModel struct {
-	BlockStorageID   types.String `tfsdk:"block_storage_id"`
-	VirtualMachineID types.String `tfsdk:"virtual_machine_id"`
+	BlockStorageID   types.String   `tfsdk:"block_storage_id"`
+	VirtualMachineID types.String   `tfsdk:"virtual_machine_id"`
+	Region           types.String   `tfsdk:"region"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
+var volumeAttachIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "block_storage_id", Description: "ID of the volume."},
+	utils.IdentityAttribute{Name: "virtual_machine_id", Description: "ID of the virtual machine."},
+)
+
 func NewVolumeAttachResource() resource.Resource {
 	return &VolumeAttach{}
 }
 
-func (r *VolumeAttach) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_volume_attachment"
+func (r *VolumeAttach) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = blockStorageVolumeAttachmentTypeName
 }
 
 func (r *VolumeAttach) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -47,13 +60,16 @@ func (r *VolumeAttach) Configure(ctx context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	r.blockStorageVolumes = storageSDK.New(&dataConfig.CoreConfig).Volumes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.blockStorageVolumes = storageSDK.New(&cfg.CoreConfig).Volumes()
+	})
 }
 
 func (r *VolumeAttach) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A block storage volume attachment.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"block_storage_id": schema.StringAttribute{
 				Description: "The ID of the block storage volume to attach.",
 				Required:    true,
@@ -69,25 +85,47 @@ func (r *VolumeAttach) Schema(ctx context.Context, req resource.SchemaRequest, r
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Delete: true}),
+		},
 	}
 }
 
 func (r *VolumeAttach) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, blockStorageVolumeAttachmentTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer volumeAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var model VolumeAttachResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := model.Timeouts.Create(ctx, AttachVolumeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.blockStorageVolumes.Attach(ctx, model.BlockStorageID.ValueString(), model.VirtualMachineID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	err = r.waitForVolumeAvailability(ctx, model.BlockStorageID.ValueString(), AttachVolumeCompletedStatus)
+	err = r.waitForVolumeAvailability(ctx, model.BlockStorageID.ValueString(), AttachVolumeCompletedStatus, createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		volumeAttachIdentity.SetCreated(ctx, &resp.State, map[string]string{
+			"region":             model.Region.ValueString(),
+			"block_storage_id":   model.BlockStorageID.ValueString(),
+			"virtual_machine_id": model.VirtualMachineID.ValueString(),
+		}, &resp.Diagnostics)
 		return
 	}
 
@@ -95,63 +133,116 @@ func (r *VolumeAttach) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *VolumeAttach) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, blockStorageVolumeAttachmentTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer volumeAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var model VolumeAttachResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := r.blockStorageVolumes.Get(ctx, model.BlockStorageID.ValueString(), []string{storageSDK.VolumeAttachExpand})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	if result.Attachment == nil {
-		resp.Diagnostics.AddWarning("Volume is not attached to any virtual machine", "")
-		model.VirtualMachineID = types.StringValue("")
-	} else {
-		model.VirtualMachineID = types.StringPointerValue(result.Attachment.Instance.ID)
+	// A volume detached, or attached to another virtual machine, no longer
+	// has this attachment.
+	if result.Attachment == nil || result.Attachment.Instance.ID == nil || *result.Attachment.Instance.ID != model.VirtualMachineID.ValueString() {
+		resp.State.RemoveResource(ctx)
+		return
 	}
 	model.BlockStorageID = types.StringValue(result.ID)
 
 	resp.State.Set(ctx, &model)
 }
 
+// Update only persists the timeouts block, every other attribute forces replacement.
 func (r *VolumeAttach) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update not suported", "Update not suported for this resource")
+	defer tracing.Resource(&ctx, blockStorageVolumeAttachmentTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer volumeAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var model VolumeAttachResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
 }
 
 func (r *VolumeAttach) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, blockStorageVolumeAttachmentTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var model VolumeAttachResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := model.Timeouts.Delete(ctx, AttachVolumeTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.blockStorageVolumes.Detach(ctx, model.BlockStorageID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	err = r.waitForVolumeAvailability(ctx, model.BlockStorageID.ValueString(), AttachVolumeCompletedStatus)
+	err = r.waitForVolumeAvailability(ctx, model.BlockStorageID.ValueString(), AttachVolumeCompletedStatus, deleteTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
-func (r *VolumeAttach) waitForVolumeAvailability(ctx context.Context, volumeID string, expetedStatus string) (err error) {
-	for startTime := time.Now(); time.Since(startTime) < AttachVolumeTimeout; {
-		time.Sleep(10 * time.Second)
-		getResult, err := r.blockStorageVolumes.Get(ctx, volumeID, []string{})
-		if err != nil {
-			return err
-		}
-		if getResult.Status == expetedStatus {
-			break
-		}
-	}
-	return nil
+func (r *VolumeAttach) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = volumeAttachIdentity.Schema()
+}
+
+func (r *VolumeAttach) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volumeAttachIdentity.ImportState(ctx, req, resp)
+}
+
+func (r *VolumeAttach) waitForVolumeAvailability(ctx context.Context, volumeID string, expetedStatus string, timeout time.Duration) error {
+	_, err := utils.StateWaiter[*storageSDK.Volume]{
+		Description: fmt.Sprintf("volume %s", volumeID),
+		Target:      []string{expetedStatus},
+		IsError:     func(state string) bool { return VolumeStatus(state).isError() },
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		MinInterval: 10 * time.Second,
+		Refresh: func(ctx context.Context) (*storageSDK.Volume, string, error) {
+			volume, err := r.blockStorageVolumes.Get(ctx, volumeID, []string{})
+			if err != nil {
+				return nil, "", err
+			}
+			return volume, volume.Status, nil
+		},
+	}.Wait(ctx)
+	return err
 }
@@ -14,10 +14,14 @@ import (
 
 	storageSDK "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const blockStorageVolumesTypeName = "mgc_block_storage_volumes"
+
 const (
 	completedBsSttus      = "completed"
 	BsVolumeStatusTimeout = 60 * time.Minute
@@ -59,16 +63,21 @@ func (s VolumeStatus) isError() bool {
 	return strings.Contains(s.String(), "error")
 }
 
+var volumeIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the volume."),
+)
+
 func NewBlockStorageVolumesResource() resource.Resource {
 	return &bsVolumes{}
 }
 
 type bsVolumes struct {
+	regional  utils.RegionalService
 	bsVolumes storageSDK.VolumeService
 }
 
-func (r *bsVolumes) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_block_storage_volumes"
+func (r *bsVolumes) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = blockStorageVolumesTypeName
 }
 
 func (r *bsVolumes) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -81,24 +90,31 @@ func (r *bsVolumes) Configure(ctx context.Context, req resource.ConfigureRequest
 		return
 	}
 
-	r.bsVolumes = storageSDK.New(&dataConfig.CoreConfig).Volumes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.bsVolumes = storageSDK.New(&cfg.CoreConfig).Volumes()
+	})
 }
 
 type bsVolumesResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	SnapshotID       types.String `tfsdk:"snapshot_id"`
-	AvailabilityZone types.String `tfsdk:"availability_zone"`
-	CreatedAt        types.String `tfsdk:"created_at"`
-	Size             types.Int64  `tfsdk:"size"`
-	Type             types.String `tfsdk:"type"`
-	Encrypted        types.Bool   `tfsdk:"encrypted"`
+	ID               types.String   `tfsdk:"id"`
+	Name             types.String   `tfsdk:"name"`
+	SnapshotID       types.String   `tfsdk:"snapshot_id"`
+	AvailabilityZone types.String   `tfsdk:"availability_zone"`
+	CreatedAt        types.String   `tfsdk:"created_at"`
+	Size             types.Int64    `tfsdk:"size"`
+	Type             types.String   `tfsdk:"type"`
+	Encrypted        types.Bool     `tfsdk:"encrypted"`
+	Region           types.String   `tfsdk:"region"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
 }
 
-func (r *bsVolumes) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+var bsVolumeTimeoutsOpts = timeouts.Opts{Create: true, Update: true}
+
+func (r *bsVolumes) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Block storage volumes are storage devices that can be attached to virtual machines. They are used to store data and can be detached and attached to other virtual machines.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the volume.",
 				PlanModifiers: []planmodifier.String{
@@ -153,31 +169,65 @@ func (r *bsVolumes) Schema(_ context.Context, _ resource.SchemaRequest, resp *re
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, bsVolumeTimeoutsOpts),
+		},
 	}
 
 }
 
 func (r *bsVolumes) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, blockStorageVolumesTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer volumeIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	plan := &bsVolumesResourceModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	getResult, err := r.bsVolumes.Get(ctx, plan.ID.ValueString(), []string{storageSDK.VolumeTypeExpand})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	convertedResult := r.toTerraformModel(*getResult, plan.SnapshotID.ValueStringPointer())
+	convertedResult.Timeouts = plan.Timeouts
+	convertedResult.Region = plan.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &convertedResult)...)
 }
 
 func (r *bsVolumes) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, blockStorageVolumesTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer volumeIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	state := &bsVolumesResourceModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := state.Timeouts.Create(ctx, BsVolumeStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createParam := storageSDK.CreateVolumeRequest{
 		Name:      state.Name.ValueString(),
 		Size:      int(state.Size.ValueInt64()),
@@ -197,37 +247,58 @@ func (r *bsVolumes) Create(ctx context.Context, req resource.CreateRequest, resp
 
 	createResult, err := r.bsVolumes.Create(ctx, createParam)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
-	getResult, err := r.waitUntilVolumeStatusMatches(ctx, createResult, Completed)
+	getResult, err := r.waitUntilVolumeStatusMatches(ctx, createResult, Completed, createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		volumeIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": state.Region.ValueString(), "id": createResult}, &resp.Diagnostics)
 		return
 	}
 	convertedResult := r.toTerraformModel(*getResult, state.SnapshotID.ValueStringPointer())
+	convertedResult.Timeouts = state.Timeouts
+	convertedResult.Region = state.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &convertedResult)...)
 }
 
 func (r *bsVolumes) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, blockStorageVolumesTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer volumeIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	planData := &bsVolumesResourceModel{}
 	state := &bsVolumesResourceModel{}
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&planData.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := planData.Timeouts.Update(ctx, BsVolumeStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if planData.Name.ValueString() != state.Name.ValueString() {
 		err := r.bsVolumes.Rename(ctx, planData.ID.ValueString(), planData.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
-		_, err = r.waitUntilVolumeStatusMatches(ctx, state.ID.ValueString(), Completed)
+		_, err = r.waitUntilVolumeStatusMatches(ctx, state.ID.ValueString(), Completed, updateTimeout)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
@@ -239,12 +310,12 @@ func (r *bsVolumes) Update(ctx context.Context, req resource.UpdateRequest, resp
 			},
 		})
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
-		_, err = r.waitUntilVolumeStatusMatches(ctx, state.ID.ValueString(), Completed)
+		_, err = r.waitUntilVolumeStatusMatches(ctx, state.ID.ValueString(), Completed, updateTimeout)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
@@ -254,12 +325,12 @@ func (r *bsVolumes) Update(ctx context.Context, req resource.UpdateRequest, resp
 			Size: int(planData.Size.ValueInt64()),
 		})
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
-		_, err = r.waitUntilVolumeStatusMatches(ctx, state.ID.ValueString(), Completed)
+		_, err = r.waitUntilVolumeStatusMatches(ctx, state.ID.ValueString(), Completed, updateTimeout)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
@@ -268,21 +339,37 @@ func (r *bsVolumes) Update(ctx context.Context, req resource.UpdateRequest, resp
 }
 
 func (r *bsVolumes) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, blockStorageVolumesTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data bsVolumesResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.bsVolumes.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 	}
 }
 
+func (r *bsVolumes) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = volumeIdentity.Schema()
+}
+
 func (r *bsVolumes) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	data := &bsVolumesResourceModel{ID: types.StringValue(req.ID)}
+	data := &bsVolumesResourceModel{Timeouts: utils.NullTimeouts(bsVolumeTimeoutsOpts)}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	volumeIdentity.ImportState(ctx, req, resp)
 }
 
 func (r *bsVolumes) toTerraformModel(volume storageSDK.Volume, snapshotId *string) bsVolumesResourceModel {
@@ -298,26 +385,20 @@ func (r *bsVolumes) toTerraformModel(volume storageSDK.Volume, snapshotId *strin
 	}
 }
 
-func (r *bsVolumes) waitUntilVolumeStatusMatches(ctx context.Context, volumeID string, status VolumeStatus) (*storageSDK.Volume, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, BsVolumeStatusTimeout)
-	defer cancel()
-
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for volume %s to reach status %s", volumeID, status)
-		case <-time.After(10 * time.Second):
+func (r *bsVolumes) waitUntilVolumeStatusMatches(ctx context.Context, volumeID string, status VolumeStatus, timeout time.Duration) (*storageSDK.Volume, error) {
+	return utils.StateWaiter[*storageSDK.Volume]{
+		Description: fmt.Sprintf("volume %s", volumeID),
+		Target:      []string{status.String()},
+		IsError:     func(state string) bool { return VolumeStatus(state).isError() },
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		MinInterval: 10 * time.Second,
+		Refresh: func(ctx context.Context) (*storageSDK.Volume, string, error) {
 			volume, err := r.bsVolumes.Get(ctx, volumeID, []string{storageSDK.VolumeTypeExpand})
 			if err != nil {
-				return nil, err
-			}
-			currentStatus := VolumeStatus(volume.Status)
-			if currentStatus == status {
-				return volume, nil
-			}
-			if currentStatus.isError() {
-				return nil, fmt.Errorf("volume %s is in error state: %s", volumeID, currentStatus)
+				return nil, "", err
 			}
-		}
-	}
+			return volume, volume.Status, nil
+		},
+	}.Wait(ctx)
 }
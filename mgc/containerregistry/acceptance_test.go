@@ -0,0 +1,58 @@
+package containerregistry_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/acctest"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+)
+
+func TestAccContainerCredentials(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Config(srv, `
+data "mgc_container_credentials" "test" {}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.mgc_container_credentials.test", "username", "fake-tenant"),
+					resource.TestCheckResourceAttr("data.mgc_container_credentials.test", "password", "fake-password"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContainerCredentials_Ephemeral(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.EchoProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Config(srv, `
+ephemeral "mgc_container_credentials" "test" {}
+
+provider "echo" {
+  data = ephemeral.mgc_container_credentials.test
+}
+
+resource "echo" "credentials" {}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("echo.credentials", "data.region", fakeapi.Region),
+					resource.TestCheckResourceAttr("echo.credentials", "data.username", "fake-tenant"),
+					resource.TestCheckResourceAttr("echo.credentials", "data.password", "fake-password"),
+					resource.TestCheckResourceAttr("echo.credentials", "data.email", "fake-tenant@example.com"),
+				),
+			},
+		},
+	})
+}
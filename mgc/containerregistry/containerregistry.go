@@ -2,6 +2,7 @@ package containerregistry
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -22,3 +23,9 @@ func GetResources() []func() resource.Resource {
 		NewContainerRegistryProxyCacheResource,
 	}
 }
+
+func GetEphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewEphemeralCRCredentials,
+	}
+}
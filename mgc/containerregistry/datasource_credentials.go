@@ -7,13 +7,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	crSDK "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const containerCredentialsTypeName = "mgc_container_credentials"
+
 var _ datasource.DataSource = &DataSourceCRCredentials{}
 
 type DataSourceCRCredentials struct {
+	regional      utils.RegionalService
 	crCredentials crSDK.CredentialsService
 }
 
@@ -21,14 +25,15 @@ func NewDataSourceCRCredentials() datasource.DataSource {
 	return &DataSourceCRCredentials{}
 }
 
-func (r *DataSourceCRCredentials) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_container_credentials"
+func (r *DataSourceCRCredentials) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = containerCredentialsTypeName
 }
 
 type crCredentials struct {
 	Email    types.String `tfsdk:"email"`
 	Password types.String `tfsdk:"password"`
 	Username types.String `tfsdk:"username"`
+	Region   types.String `tfsdk:"region"`
 }
 
 func (r *DataSourceCRCredentials) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -42,13 +47,16 @@ func (r *DataSourceCRCredentials) Configure(ctx context.Context, req datasource.
 		return
 	}
 
-	r.crCredentials = crSDK.New(&dataConfig.CoreConfig).Credentials()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.crCredentials = crSDK.New(&cfg.CoreConfig).Credentials()
+	})
 }
 
 func (r *DataSourceCRCredentials) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Credentials for Container Registry authentication",
+		Description: "Credentials for Container Registry authentication. The password is stored in the state, use the ephemeral resource of the same name to keep it out.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"email": schema.StringAttribute{
 				Description: "Email address for the credentials",
 				Computed:    true,
@@ -69,6 +77,8 @@ func (r *DataSourceCRCredentials) Schema(_ context.Context, req datasource.Schem
 }
 
 func (r *DataSourceCRCredentials) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, containerCredentialsTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data crCredentials
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -76,9 +86,14 @@ func (r *DataSourceCRCredentials) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkOutput, err := r.crCredentials.Get(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	crSDK "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -11,9 +12,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const containerImagesTypeName = "mgc_container_images"
+
 var _ datasource.DataSource = &DataSourceCRImages{}
 
 type DataSourceCRImages struct {
+	regional utils.RegionalService
 	crImages crSDK.ImagesService
 }
 
@@ -29,14 +33,15 @@ type crImagesList struct {
 	RegistryID     types.String `tfsdk:"registry_id"`
 	RepositoryName types.String `tfsdk:"repository_name"`
 	Images         []crImage    `tfsdk:"images"`
+	Region         types.String `tfsdk:"region"`
 }
 
 func NewDataSourceCRImages() datasource.DataSource {
 	return &DataSourceCRImages{}
 }
 
-func (r *DataSourceCRImages) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_container_images"
+func (r *DataSourceCRImages) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = containerImagesTypeName
 }
 
 func (r *DataSourceCRImages) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -50,13 +55,16 @@ func (r *DataSourceCRImages) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	r.crImages = crSDK.New(&dataConfig.CoreConfig).Images()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.crImages = crSDK.New(&cfg.CoreConfig).Images()
+	})
 }
 
 func (r *DataSourceCRImages) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Data source for Container Registry Images",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"repository_name": schema.StringAttribute{
 				Description: "Name of the repository",
 				Required:    true,
@@ -99,6 +107,8 @@ func (r *DataSourceCRImages) Schema(_ context.Context, req datasource.SchemaRequ
 }
 
 func (r *DataSourceCRImages) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, containerImagesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data crImagesList
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -106,9 +116,14 @@ func (r *DataSourceCRImages) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkOutputList, err := r.crImages.ListAll(ctx, data.RegistryID.ValueString(), data.RepositoryName.ValueString(), crSDK.ImageFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
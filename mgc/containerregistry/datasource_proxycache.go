@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	crSDK "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
@@ -19,9 +20,11 @@ type proxyCacheDataSourceModel struct {
 	URL          types.String `tfsdk:"url"`
 	CreatedAt    types.String `tfsdk:"created_at"`
 	UpdatedAt    types.String `tfsdk:"updated_at"`
+	Region       types.String `tfsdk:"region"`
 }
 
 type ProxyCacheDataSource struct {
+	regional          utils.RegionalService
 	proxyCacheService crSDK.ProxyCachesService
 }
 
@@ -29,8 +32,8 @@ func NewProxyCacheDataSource() datasource.DataSource {
 	return &ProxyCacheDataSource{}
 }
 
-func (pc *ProxyCacheDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_container_registry_proxy_cache"
+func (pc *ProxyCacheDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = containerRegistryProxyCacheTypeName
 }
 
 func (pc *ProxyCacheDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -43,13 +46,16 @@ func (pc *ProxyCacheDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	pc.proxyCacheService = crSDK.New(&dataConfig.CoreConfig).ProxyCaches()
+	pc.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		pc.proxyCacheService = crSDK.New(&cfg.CoreConfig).ProxyCaches()
+	})
 }
 
 func (pc *ProxyCacheDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Retrieves information about a specific proxy cache.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the proxy cache",
 				Required:    true,
@@ -83,15 +89,22 @@ func (pc *ProxyCacheDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 func (pc *ProxyCacheDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, containerRegistryProxyCacheTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data proxyCacheDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(pc.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	proxyCache, err := pc.proxyCacheService.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -8,9 +8,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	crSDK "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const containerRegistryProxyCachesTypeName = "mgc_container_registry_proxy_caches"
+
 type proxyCache struct {
 	ID           types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`
@@ -22,9 +25,11 @@ type proxyCache struct {
 
 type proxyCacheListDataSourceModel struct {
 	ProxyCaches []proxyCache `tfsdk:"proxy_caches"`
+	Region      types.String `tfsdk:"region"`
 }
 
 type ProxyCacheListDataSource struct {
+	regional          utils.RegionalService
 	proxyCacheService crSDK.ProxyCachesService
 }
 
@@ -32,8 +37,8 @@ func NewProxyCacheListDataSource() datasource.DataSource {
 	return &ProxyCacheListDataSource{}
 }
 
-func (pc *ProxyCacheListDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_container_registry_proxy_caches"
+func (pc *ProxyCacheListDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = containerRegistryProxyCachesTypeName
 }
 
 func (pc *ProxyCacheListDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -46,13 +51,16 @@ func (pc *ProxyCacheListDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	pc.proxyCacheService = crSDK.New(&dataConfig.CoreConfig).ProxyCaches()
+	pc.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		pc.proxyCacheService = crSDK.New(&cfg.CoreConfig).ProxyCaches()
+	})
 }
 
 func (pc *ProxyCacheListDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "List all proxy caches",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"proxy_caches": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -89,14 +97,25 @@ func (pc *ProxyCacheListDataSource) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (pc *ProxyCacheListDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, containerRegistryProxyCachesTypeName, &resp.State, &resp.Diagnostics)()
+
+	var result proxyCacheListDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &result)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(pc.regional.Use(&result.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	proxyCaches, err := pc.proxyCacheService.ListAll(ctx, crSDK.ProxyCacheListAllOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	var result proxyCacheListDataSourceModel
-
 	for _, pc := range proxyCaches {
 		result.ProxyCaches = append(result.ProxyCaches, proxyCache{
 			ID:           types.StringValue(pc.ID),
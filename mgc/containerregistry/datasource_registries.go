@@ -4,6 +4,7 @@ import (
 	"context"
 
 	crSDK "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -13,6 +14,7 @@ import (
 var _ datasource.DataSource = &DataSourceCRRegistries{}
 
 type DataSourceCRRegistries struct {
+	regional     utils.RegionalService
 	crRegistries crSDK.RegistriesService
 }
 
@@ -20,8 +22,8 @@ func NewDataSourceCRRegistries() datasource.DataSource {
 	return &DataSourceCRRegistries{}
 }
 
-func (r *DataSourceCRRegistries) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_container_registries"
+func (r *DataSourceCRRegistries) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = containerRegistriesTypeName
 }
 
 type crRegistries struct {
@@ -35,6 +37,7 @@ type crRegistries struct {
 
 type crRegistriesList struct {
 	Registries []crRegistries `tfsdk:"registries"`
+	Region     types.String   `tfsdk:"region"`
 }
 
 func (r *DataSourceCRRegistries) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -48,13 +51,16 @@ func (r *DataSourceCRRegistries) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	r.crRegistries = crSDK.New(&dataConfig.CoreConfig).Registries()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.crRegistries = crSDK.New(&cfg.CoreConfig).Registries()
+	})
 }
 
 func (r *DataSourceCRRegistries) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Data source for Container Registry lists",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"registries": schema.ListNestedAttribute{
 				Description: "List of container registries",
 				Computed:    true,
@@ -92,6 +98,8 @@ func (r *DataSourceCRRegistries) Schema(_ context.Context, req datasource.Schema
 }
 
 func (r *DataSourceCRRegistries) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, containerRegistriesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data crRegistriesList
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -99,9 +107,14 @@ func (r *DataSourceCRRegistries) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	registries, err := r.crRegistries.ListAll(ctx, crSDK.RegistryFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -7,13 +7,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	crSDK "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const containerRepositoriesTypeName = "mgc_container_repositories"
+
 var _ datasource.DataSource = &DataSourceCRRepositories{}
 
 type DataSourceCRRepositories struct {
+	regional       utils.RegionalService
 	crRepositories crSDK.RepositoriesService
 }
 
@@ -21,8 +25,8 @@ func NewDataSourceCRRepositories() datasource.DataSource {
 	return &DataSourceCRRepositories{}
 }
 
-func (r *DataSourceCRRepositories) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_container_repositories"
+func (r *DataSourceCRRepositories) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = containerRepositoriesTypeName
 }
 
 type crRepository struct {
@@ -36,6 +40,7 @@ type crRepository struct {
 type crRepositoriesList struct {
 	RegistryID   types.String   `tfsdk:"registry_id"`
 	Repositories []crRepository `tfsdk:"repositories"`
+	Region       types.String   `tfsdk:"region"`
 }
 
 func (r *DataSourceCRRepositories) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -49,12 +54,15 @@ func (r *DataSourceCRRepositories) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	r.crRepositories = crSDK.New(&dataConfig.CoreConfig).Repositories()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.crRepositories = crSDK.New(&cfg.CoreConfig).Repositories()
+	})
 }
 func (r *DataSourceCRRepositories) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Data source for Container Registry Repositories",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"registry_id": schema.StringAttribute{
 				Description: "ID of the registry",
 				Required:    true,
@@ -92,6 +100,8 @@ func (r *DataSourceCRRepositories) Schema(_ context.Context, req datasource.Sche
 }
 
 func (r *DataSourceCRRepositories) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, containerRepositoriesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data crRepositoriesList
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -99,9 +109,14 @@ func (r *DataSourceCRRepositories) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkOutputList, err := r.crRepositories.ListAll(ctx, data.RegistryID.ValueString(), crSDK.RepositoryFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -0,0 +1,97 @@
+package containerregistry
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+
+	crSDK "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &EphemeralCRCredentials{}
+
+// EphemeralCRCredentials is the mgc_container_credentials data source
+// without state: the credentials are fetched on each run and only passed to
+// the configurations that reference them.
+type EphemeralCRCredentials struct {
+	regional      utils.RegionalService
+	crCredentials crSDK.CredentialsService
+}
+
+func NewEphemeralCRCredentials() ephemeral.EphemeralResource {
+	return &EphemeralCRCredentials{}
+}
+
+func (r *EphemeralCRCredentials) Metadata(_ context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = containerCredentialsTypeName
+}
+
+func (r *EphemeralCRCredentials) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure ephemeral resource", "Invalid provider data")
+		return
+	}
+
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.crCredentials = crSDK.New(&cfg.CoreConfig).Credentials()
+	})
+}
+
+func (r *EphemeralCRCredentials) Schema(_ context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Credentials for Container Registry authentication, without storing them in the state or plan",
+		Attributes: map[string]schema.Attribute{
+			"region": utils.EphemeralRegionAttribute(),
+			"email": schema.StringAttribute{
+				Description: "Email address for the credentials",
+				Computed:    true,
+			},
+			"password": schema.StringAttribute{
+				Description: "Password for authentication",
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"username": schema.StringAttribute{
+				Description: "Username for authentication",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *EphemeralCRCredentials) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer tracing.Ephemeral(&ctx, containerCredentialsTypeName, &resp.Diagnostics)()
+
+	var data crCredentials
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sdkOutput, err := r.crCredentials.Get(ctx)
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+
+	data.Email = types.StringValue(sdkOutput.Email)
+	data.Password = types.StringValue(sdkOutput.Password)
+	data.Username = types.StringValue(sdkOutput.Username)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 
 	crSDK "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const containerRegistryProxyCacheTypeName = "mgc_container_registry_proxy_cache"
+
 type ProxyCacheModel struct {
 	ID           types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`
@@ -26,18 +28,24 @@ type ProxyCacheModel struct {
 	AccessSecret types.String `tfsdk:"access_secret"`
 	CreatedAt    types.String `tfsdk:"created_at"`
 	UpdatedAt    types.String `tfsdk:"updated_at"`
+	Region       types.String `tfsdk:"region"`
 }
 
 type ProxyCacheResource struct {
+	regional          utils.RegionalService
 	proxyCacheService crSDK.ProxyCachesService
 }
 
+var proxyCacheIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the proxy cache."),
+)
+
 func NewContainerRegistryProxyCacheResource() resource.Resource {
 	return &ProxyCacheResource{}
 }
 
-func (pc *ProxyCacheResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_container_registry_proxy_cache"
+func (pc *ProxyCacheResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = containerRegistryProxyCacheTypeName
 }
 
 func (pc *ProxyCacheResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,13 +58,16 @@ func (pc *ProxyCacheResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	pc.proxyCacheService = crSDK.New(&dataConfig.CoreConfig).ProxyCaches()
+	pc.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		pc.proxyCacheService = crSDK.New(&cfg.CoreConfig).ProxyCaches()
+	})
 }
 
 func (pc *ProxyCacheResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages the proxy cache",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the proxy cache",
 				Computed:    true,
@@ -121,12 +132,20 @@ func (pc *ProxyCacheResource) Schema(_ context.Context, _ resource.SchemaRequest
 }
 
 func (pc *ProxyCacheResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, containerRegistryProxyCacheTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer proxyCacheIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data ProxyCacheModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(pc.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	created, err := pc.proxyCacheService.Create(ctx, crSDK.CreateProxyCacheRequest{
 		Name:         data.Name.ValueString(),
 		Provider:     data.ProviderName.ValueString(),
@@ -136,7 +155,7 @@ func (pc *ProxyCacheResource) Create(ctx context.Context, req resource.CreateReq
 		AccessSecret: data.AccessSecret.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -145,7 +164,8 @@ func (pc *ProxyCacheResource) Create(ctx context.Context, req resource.CreateReq
 
 	proxyCache, err := pc.proxyCacheService.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		proxyCacheIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": data.Region.ValueString(), "id": created.ID}, &resp.Diagnostics)
 		return
 	}
 
@@ -156,15 +176,27 @@ func (pc *ProxyCacheResource) Create(ctx context.Context, req resource.CreateReq
 }
 
 func (pc *ProxyCacheResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, containerRegistryProxyCacheTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer proxyCacheIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data ProxyCacheModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(pc.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	proxyCache, err := pc.proxyCacheService.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -180,6 +212,9 @@ func (pc *ProxyCacheResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (pc *ProxyCacheResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, containerRegistryProxyCacheTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer proxyCacheIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var plan ProxyCacheModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -192,6 +227,11 @@ func (pc *ProxyCacheResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	resp.Diagnostics.Append(pc.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateReq := crSDK.UpdateProxyCacheRequest{}
 	changed := false
 
@@ -226,7 +266,7 @@ func (pc *ProxyCacheResource) Update(ctx context.Context, req resource.UpdateReq
 	if changed {
 		updatedProxy, err := pc.proxyCacheService.Update(ctx, proxyID, updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 
@@ -237,21 +277,30 @@ func (pc *ProxyCacheResource) Update(ctx context.Context, req resource.UpdateReq
 }
 
 func (pc *ProxyCacheResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, containerRegistryProxyCacheTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data ProxyCacheModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(pc.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := pc.proxyCacheService.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+func (pc *ProxyCacheResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = proxyCacheIdentity.Schema()
+}
+
 func (pc *ProxyCacheResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...,
-	)
+	proxyCacheIdentity.ImportState(ctx, req, resp)
 }
@@ -5,6 +5,7 @@ import (
 
 	crSDK "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,22 +14,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const containerRegistriesTypeName = "mgc_container_registries"
+
 type ContainerRegistryModel struct {
 	Id           types.String `tfsdk:"id"`
 	Name         types.String `tfsdk:"name"`
 	ProxyCacheID types.String `tfsdk:"proxy_cache_id"`
+	Region       types.String `tfsdk:"region"`
 }
 
 type ContainerRegistryResource struct {
+	regional        utils.RegionalService
 	registryService crSDK.RegistriesService
 }
 
+var registryIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the container registry."),
+)
+
 func NewContainerRegistryRegistriesResource() resource.Resource {
 	return &ContainerRegistryResource{}
 }
 
-func (r *ContainerRegistryResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_container_registries"
+func (r *ContainerRegistryResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = containerRegistriesTypeName
 }
 
 func (r *ContainerRegistryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -41,13 +50,16 @@ func (r *ContainerRegistryResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	r.registryService = crSDK.New(&dataConfig.CoreConfig).Registries()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.registryService = crSDK.New(&cfg.CoreConfig).Registries()
+	})
 }
 
 func (r *ContainerRegistryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a Container Registry",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the registry",
 				Computed:    true,
@@ -71,18 +83,26 @@ func (r *ContainerRegistryResource) Schema(_ context.Context, _ resource.SchemaR
 }
 
 func (r *ContainerRegistryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, containerRegistriesTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer registryIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data ContainerRegistryModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	created, err := r.registryService.Create(ctx, &crSDK.RegistryRequest{
 		Name:         data.Name.ValueString(),
 		ProxyCacheID: data.ProxyCacheID.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -93,15 +113,27 @@ func (r *ContainerRegistryResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *ContainerRegistryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, containerRegistriesTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer registryIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data ContainerRegistryModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	registry, err := r.registryService.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -111,6 +143,9 @@ func (r *ContainerRegistryResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, containerRegistriesTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer registryIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError(
 		"Update not supported",
 		"Container Registry cannot be updated after creation",
@@ -118,20 +153,30 @@ func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *ContainerRegistryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, containerRegistriesTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data ContainerRegistryModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.registryService.Delete(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+func (r *ContainerRegistryResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = registryIdentity.Schema()
+}
+
 func (r *ContainerRegistryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	data := &ContainerRegistryModel{Id: types.StringValue(req.ID)}
-	resp.Diagnostics.Append(resp.State.Set(ctx, data)...)
+	registryIdentity.ImportState(ctx, req, resp)
 }
@@ -0,0 +1,123 @@
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/acctest"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+)
+
+func testAccDBaaSInstanceConfig(srv *fakeapi.Server, instanceType string, volumeSize, retentionDays int) string {
+	return acctest.Config(srv, fmt.Sprintf(`
+resource "mgc_dbaas_instances" "test" {
+  name                  = "acc-db"
+  user                  = "admin"
+  password              = "acc-password"
+  engine_name           = %q
+  engine_version        = %q
+  instance_type         = %q
+  volume_size           = %d
+  backup_retention_days = %d
+}
+`, fakeapi.DBaaSEngine, fakeapi.DBaaSEngineVersion, instanceType, volumeSize, retentionDays))
+}
+
+func TestAccDBaaSInstance(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_dbaas_instances"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDBaaSInstanceConfig(srv, fakeapi.DBaaSInstanceType, 20, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mgc_dbaas_instances.test", "id"),
+					resource.TestCheckResourceAttrSet("mgc_dbaas_instances.test", "engine_id"),
+					resource.TestCheckResourceAttr("mgc_dbaas_instances.test", "status", "ACTIVE"),
+					resource.TestCheckNoResourceAttr("mgc_dbaas_instances.test", "password"),
+				),
+			},
+			{
+				ResourceName:      "mgc_dbaas_instances.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Create keeps the configured values, these are only filled by Read.
+				ImportStateVerifyIgnore: []string{"timeouts", "availability_zone", "backup_start_at", "parameter_group", "volume_type"},
+			},
+			{
+				Config: testAccDBaaSInstanceConfig(srv, fakeapi.DBaaSInstanceTypeLarge, 30, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mgc_dbaas_instances.test", "instance_type", fakeapi.DBaaSInstanceTypeLarge),
+					resource.TestCheckResourceAttr("mgc_dbaas_instances.test", "volume_size", "30"),
+					resource.TestCheckResourceAttr("mgc_dbaas_instances.test", "backup_retention_days", "14"),
+					// Not configured, so the update keeps the schedule of the API.
+					resource.TestCheckResourceAttrSet("mgc_dbaas_instances.test", "backup_start_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDBaaSInstanceBackupNowAction(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	srv := acctest.NewServer(t)
+	client := dbSDK.New(acctest.Client(srv))
+
+	engines, err := client.Engines().ListAll(ctx, dbSDK.EngineFilterOptions{})
+	require.NoError(t, err)
+	instanceTypes, err := client.InstanceTypes().ListAll(ctx, dbSDK.InstanceTypeFilterOptions{})
+	require.NoError(t, err)
+	var engineID, instanceTypeID string
+	for _, e := range engines {
+		if e.Name == fakeapi.DBaaSEngine && e.Version == fakeapi.DBaaSEngineVersion {
+			engineID = e.ID
+		}
+	}
+	for _, it := range instanceTypes {
+		if it.Label == fakeapi.DBaaSInstanceType {
+			instanceTypeID = it.ID
+		}
+	}
+	instance, err := client.Instances().Create(ctx, dbSDK.InstanceCreateRequest{
+		Name:           "acc-db",
+		User:           "admin",
+		Password:       "acc-password",
+		EngineID:       &engineID,
+		InstanceTypeID: &instanceTypeID,
+		Volume:         dbSDK.InstanceVolumeRequest{Size: 20},
+	})
+	require.NoError(t, err)
+
+	progress, err := acctest.InvokeAction(t, srv, "mgc_dbaas_instance_backup_now", map[string]any{
+		"instance_id": instance.ID,
+		"name":        "acc-backup",
+		"description": "before the migration",
+	})
+	require.NoError(t, err)
+	require.Len(t, progress, 2)
+	assert.Equal(t, fmt.Sprintf("Creating snapshot acc-backup of instance %s", instance.ID), progress[0])
+
+	available := regexp.MustCompile(`^Snapshot acc-backup \((.+)\) available$`).FindStringSubmatch(progress[1])
+	require.NotNil(t, available, progress[1])
+	snapshot, err := client.Instances().GetSnapshot(ctx, instance.ID, available[1])
+	require.NoError(t, err)
+	assert.Equal(t, "acc-backup", snapshot.Name)
+	assert.Equal(t, "before the migration", snapshot.Description)
+	assert.Equal(t, dbSDK.SnapshotStatusAvailable, snapshot.Status)
+
+	_, err = acctest.InvokeAction(t, srv, "mgc_dbaas_instance_backup_now", map[string]any{
+		"instance_id": "00000000-0000-4000-8000-999999999999",
+	})
+	assert.ErrorContains(t, err, "instance 00000000-0000-4000-8000-999999999999 not found")
+}
@@ -0,0 +1,116 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const dbaasInstanceBackupNowTypeName = "mgc_dbaas_instance_backup_now"
+
+var _ action.ActionWithConfigure = &DBaaSInstanceBackupNowAction{}
+
+func NewDBaaSInstanceBackupNowAction() action.Action {
+	return &DBaaSInstanceBackupNowAction{}
+}
+
+// DBaaSInstanceBackupNowAction takes an on-demand snapshot of an instance,
+// besides the daily automatic backups. The snapshot is not managed by
+// terraform afterwards.
+type DBaaSInstanceBackupNowAction struct {
+	regional       utils.RegionalService
+	dbaasInstances dbSDK.InstanceService
+}
+
+type DBaaSInstanceBackupNowModel struct {
+	Region      types.String `tfsdk:"region"`
+	InstanceID  types.String `tfsdk:"instance_id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (a *DBaaSInstanceBackupNowAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = dbaasInstanceBackupNowTypeName
+}
+
+func (a *DBaaSInstanceBackupNowAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	a.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		a.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
+	})
+}
+
+func (a *DBaaSInstanceBackupNowAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Takes an on-demand snapshot of a DBaaS instance and waits until it is available, " +
+			"such as before a risky migration. The snapshot is not tracked in the state.",
+		Attributes: map[string]schema.Attribute{
+			"region": utils.ActionRegionAttribute(),
+			"instance_id": schema.StringAttribute{
+				Description: "ID of the DBaaS instance to snapshot",
+				Required:    true,
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the snapshot. Defaults to `backup-` followed by the UTC time of the invocation, such as `backup-20250102-150405`.",
+				Optional:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the snapshot",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (a *DBaaSInstanceBackupNowAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tracing.Action(&ctx, dbaasInstanceBackupNowTypeName, &resp.Diagnostics)()
+
+	var data DBaaSInstanceBackupNowModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(a.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	if name == "" {
+		name = "backup-" + time.Now().UTC().Format("20060102-150405")
+	}
+
+	instanceID := data.InstanceID.ValueString()
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Creating snapshot %s of instance %s", name, instanceID)})
+	created, err := a.dbaasInstances.CreateSnapshot(ctx, instanceID, dbSDK.SnapshotCreateRequest{
+		Name:        name,
+		Description: data.Description.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+
+	err = waitUntilSnapshotStatusMatches(ctx, a.dbaasInstances, instanceID, created.ID, DBaaSInstanceSnapshotStatusAvailable, snapshotStatusTimeout)
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Snapshot %s (%s) available", name, created.ID)})
+}
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"context"
+
+	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+// catalogEngines reads engines through the provider catalog cache, so that
+// every instance, cluster and parameter group of a plan shares one listing.
+type catalogEngines struct {
+	dbSDK.EngineService
+	catalog utils.Catalog
+}
+
+func (s catalogEngines) ListAll(ctx context.Context, filterOpts dbSDK.EngineFilterOptions) ([]dbSDK.EngineDetail, error) {
+	return utils.FromCatalog(ctx, s.catalog, utils.CatalogKey("dbaas/engines", filterOpts), func(ctx context.Context) ([]dbSDK.EngineDetail, error) {
+		return s.EngineService.ListAll(ctx, filterOpts)
+	})
+}
+
+func (s catalogEngines) Get(ctx context.Context, id string) (*dbSDK.EngineDetail, error) {
+	return utils.FromCatalog(ctx, s.catalog, "dbaas/engines/"+id, func(ctx context.Context) (*dbSDK.EngineDetail, error) {
+		return s.EngineService.Get(ctx, id)
+	})
+}
+
+// catalogInstanceTypes reads instance types through the provider catalog cache.
+type catalogInstanceTypes struct {
+	dbSDK.InstanceTypeService
+	catalog utils.Catalog
+}
+
+func (s catalogInstanceTypes) ListAll(ctx context.Context, filterOpts dbSDK.InstanceTypeFilterOptions) ([]dbSDK.InstanceType, error) {
+	return utils.FromCatalog(ctx, s.catalog, utils.CatalogKey("dbaas/instance-types", filterOpts), func(ctx context.Context) ([]dbSDK.InstanceType, error) {
+		return s.InstanceTypeService.ListAll(ctx, filterOpts)
+	})
+}
+
+func (s catalogInstanceTypes) Get(ctx context.Context, id string) (*dbSDK.InstanceType, error) {
+	return utils.FromCatalog(ctx, s.catalog, "dbaas/instance-types/"+id, func(ctx context.Context) (*dbSDK.InstanceType, error) {
+		return s.InstanceTypeService.Get(ctx, id)
+	})
+}
@@ -0,0 +1,63 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+type countingEngines struct {
+	dbSDK.EngineService
+	calls int
+}
+
+func (s *countingEngines) ListAll(context.Context, dbSDK.EngineFilterOptions) ([]dbSDK.EngineDetail, error) {
+	s.calls++
+	return []dbSDK.EngineDetail{{ID: "eng-1", Name: "mysql", Version: "8.0"}}, nil
+}
+
+type countingInstanceTypes struct {
+	dbSDK.InstanceTypeService
+	calls int
+}
+
+func (s *countingInstanceTypes) ListAll(_ context.Context, filter dbSDK.InstanceTypeFilterOptions) ([]dbSDK.InstanceType, error) {
+	s.calls++
+	return []dbSDK.InstanceType{{ID: "it-" + *filter.EngineID, Label: "DP2-8-40", CompatibleProduct: dbaasInstanceProductFamily}}, nil
+}
+
+func TestCatalogServices(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	catalog := utils.DataConfig{Region: "br-se1", CatalogCache: utils.NewCatalogCache(time.Minute)}.Catalog()
+
+	engines := &countingEngines{}
+	instanceTypes := &countingInstanceTypes{}
+	cachedEngines := catalogEngines{engines, catalog}
+	cachedInstanceTypes := catalogInstanceTypes{instanceTypes, catalog}
+
+	for range 50 {
+		engineID, err := ValidateAndGetEngineID(ctx, cachedEngines.ListAll, "mysql", "8.0")
+		if err != nil || engineID != "eng-1" {
+			t.Fatalf("engine: got %q, %v", engineID, err)
+		}
+		instanceTypeID, err := ValidateAndGetInstanceTypeID(ctx, cachedInstanceTypes.ListAll, "DP2-8-40", engineID, dbaasInstanceProductFamily)
+		if err != nil || instanceTypeID != "it-eng-1" {
+			t.Fatalf("instance type: got %q, %v", instanceTypeID, err)
+		}
+	}
+	if engines.calls != 1 || instanceTypes.calls != 1 {
+		t.Errorf("expected one listing of each catalog, got %d engines and %d instance types calls", engines.calls, instanceTypes.calls)
+	}
+
+	// Instance types are filtered by engine, so each engine has its own entry.
+	if _, err := ValidateAndGetInstanceTypeID(ctx, cachedInstanceTypes.ListAll, "DP2-8-40", "eng-2", dbaasInstanceProductFamily); err != nil {
+		t.Fatal(err)
+	}
+	if instanceTypes.calls != 2 {
+		t.Errorf("expected a listing for the other engine, got %d calls", instanceTypes.calls)
+	}
+}
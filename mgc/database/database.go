@@ -1,7 +1,9 @@
 package database
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -33,3 +35,15 @@ func GetResources() []func() resource.Resource {
 		NewDBaaSReplicaResource,
 	}
 }
+
+func GetListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewDBaaSInstancesList,
+	}
+}
+
+func GetActions() []func() action.Action {
+	return []func() action.Action{
+		NewDBaaSInstanceBackupNowAction,
+	}
+}
@@ -6,12 +6,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const dbaasClusterTypeName = "mgc_dbaas_cluster"
+
 type dbaasClusterDataModel struct {
 	ID                     types.String                   `tfsdk:"id"`
 	Name                   types.String                   `tfsdk:"name"`
@@ -171,6 +175,7 @@ func dbaasClusterAttributes() map[string]schema.Attribute {
 }
 
 type DBaaSClusterDataSource struct {
+	regional      utils.RegionalService
 	dbaasClusters dbSDK.ClusterService
 }
 
@@ -192,14 +197,15 @@ type dbaasClusterDataSourceModel struct {
 	StartedAt              types.String                   `tfsdk:"started_at"`
 	FinishedAt             types.String                   `tfsdk:"finished_at"`
 	DeletionProtected      types.Bool                     `tfsdk:"deletion_protected"`
+	Region                 types.String                   `tfsdk:"region"`
 }
 
 func NewDBaaSClusterDataSource() datasource.DataSource {
 	return &DBaaSClusterDataSource{}
 }
 
-func (ds *DBaaSClusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_cluster"
+func (ds *DBaaSClusterDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasClusterTypeName
 }
 
 func (ds *DBaaSClusterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -211,7 +217,9 @@ func (ds *DBaaSClusterDataSource) Configure(ctx context.Context, req datasource.
 		resp.Diagnostics.AddError("Failed to get provider data", "Provider data has unexpected type")
 		return
 	}
-	ds.dbaasClusters = dbSDK.New(&dataConfig.CoreConfig).Clusters()
+	ds.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		ds.dbaasClusters = dbSDK.New(&cfg.CoreConfig).Clusters()
+	})
 }
 
 func (ds *DBaaSClusterDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -220,6 +228,7 @@ func (ds *DBaaSClusterDataSource) Schema(_ context.Context, _ datasource.SchemaR
 		Description: "The ID of the DBaaS cluster to retrieve.",
 		Required:    true,
 	}
+	attrs["region"] = utils.DataSourceRegionAttribute()
 	resp.Schema = schema.Schema{
 		Description: "Retrieves information about a specific DBaaS cluster.",
 		Attributes:  attrs,
@@ -227,18 +236,26 @@ func (ds *DBaaSClusterDataSource) Schema(_ context.Context, _ datasource.SchemaR
 }
 
 func (ds *DBaaSClusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasClusterTypeName, &resp.State, &resp.Diagnostics)()
+
 	var config dbaasClusterDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(ds.regional.Use(&config.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkCluster, err := ds.dbaasClusters.Get(ctx, config.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	state := convertSDKClusterToDataModel(*sdkCluster)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), config.Region)...)
 }
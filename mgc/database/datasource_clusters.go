@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -14,6 +15,7 @@ import (
 )
 
 type DBaaSClustersDataSource struct {
+	regional      utils.RegionalService
 	dbaasClusters dbSDK.ClusterService
 }
 
@@ -22,14 +24,15 @@ type dbaasClustersDataSourceModel struct {
 	Status           types.String            `tfsdk:"status_filter"`
 	EngineID         types.String            `tfsdk:"engine_id_filter"`
 	ParameterGroupID types.String            `tfsdk:"parameter_group_id_filter"`
+	Region           types.String            `tfsdk:"region"`
 }
 
 func NewDBaaSClustersDataSource() datasource.DataSource {
 	return &DBaaSClustersDataSource{}
 }
 
-func (ds *DBaaSClustersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_clusters"
+func (ds *DBaaSClustersDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasClustersTypeName
 }
 
 func (ds *DBaaSClustersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -41,13 +44,16 @@ func (ds *DBaaSClustersDataSource) Configure(ctx context.Context, req datasource
 		resp.Diagnostics.AddError("Failed to get provider data", "Provider data has unexpected type")
 		return
 	}
-	ds.dbaasClusters = dbSDK.New(&dataConfig.CoreConfig).Clusters()
+	ds.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		ds.dbaasClusters = dbSDK.New(&cfg.CoreConfig).Clusters()
+	})
 }
 
 func (ds *DBaaSClustersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Retrieves a list of DBaaS clusters, optionally filtered.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"clusters": schema.ListNestedAttribute{
 				Description: "A list of DBaaS clusters.",
 				Computed:    true,
@@ -80,12 +86,19 @@ func (ds *DBaaSClustersDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 func (ds *DBaaSClustersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasClustersTypeName, &resp.State, &resp.Diagnostics)()
+
 	var config dbaasClustersDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(ds.regional.Use(&config.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	listOpts := dbSDK.ClusterFilterOptions{}
 	if !config.Status.IsNull() && !config.Status.IsUnknown() {
 		sdkStatus := dbSDK.ClusterStatus(config.Status.ValueString())
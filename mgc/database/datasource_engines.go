@@ -5,6 +5,7 @@ import (
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -13,15 +14,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasEnginesTypeName = "mgc_dbaas_engines"
+
 var _ datasource.DataSource = &DataSourceDbEngines{}
 
 type DataSourceDbEngines struct {
+	regional     utils.RegionalService
 	dbaasEngines dbSDK.EngineService
 }
 
 type dbEngineModel struct {
 	Status  types.String `tfsdk:"status"`
 	Engines []DbEngines  `tfsdk:"engines"`
+	Region  types.String `tfsdk:"region"`
 }
 
 type DbEngines struct {
@@ -35,8 +40,8 @@ func NewDataSourceDbaasEngines() datasource.DataSource {
 	return &DataSourceDbEngines{}
 }
 
-func (r *DataSourceDbEngines) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_engines"
+func (r *DataSourceDbEngines) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasEnginesTypeName
 }
 
 func (r *DataSourceDbEngines) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -49,13 +54,16 @@ func (r *DataSourceDbEngines) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	r.dbaasEngines = dbSDK.New(&dataConfig.CoreConfig).Engines()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasEngines = catalogEngines{dbSDK.New(&cfg.CoreConfig).Engines(), cfg.Catalog()}
+	})
 }
 
 func (r *DataSourceDbEngines) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A list of available database engines.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"engines": schema.ListNestedAttribute{
 				Description: "List of available database engines",
 				Computed:    true,
@@ -91,12 +99,19 @@ func (r *DataSourceDbEngines) Schema(_ context.Context, _ datasource.SchemaReque
 }
 
 func (r *DataSourceDbEngines) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasEnginesTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := dbEngineModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	engines, err := r.dbaasEngines.ListAll(ctx, dbSDK.EngineFilterOptions{
 		Status: data.Status.ValueStringPointer(),
 	})
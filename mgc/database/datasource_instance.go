@@ -5,15 +5,19 @@ import (
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasInstanceTypeName = "mgc_dbaas_instance"
+
 var _ datasource.DataSource = &DataSourceDbInstance{}
 
 type DataSourceDbInstance struct {
+	regional       utils.RegionalService
 	dbaasInstances dbSDK.InstanceService
 }
 
@@ -31,14 +35,15 @@ type dbInstanceDataSourceModel struct {
 	AvailabilityZone    types.String      `tfsdk:"availability_zone"`
 	ParameterGroup      types.String      `tfsdk:"parameter_group"`
 	DeletionProtected   types.Bool        `tfsdk:"deletion_protected"`
+	Region              types.String      `tfsdk:"region"`
 }
 
 func NewDataSourceDbaasInstance() datasource.DataSource {
 	return &DataSourceDbInstance{}
 }
 
-func (r *DataSourceDbInstance) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_instance"
+func (r *DataSourceDbInstance) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasInstanceTypeName
 }
 
 func (r *DataSourceDbInstance) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -51,13 +56,16 @@ func (r *DataSourceDbInstance) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	r.dbaasInstances = dbSDK.New(&dataConfig.CoreConfig).Instances()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
+	})
 }
 
 func (r *DataSourceDbInstance) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Get a database instance by ID.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "ID of the instance to fetch",
 				Required:    true,
@@ -131,15 +139,22 @@ func (r *DataSourceDbInstance) Schema(_ context.Context, _ datasource.SchemaRequ
 }
 
 func (r *DataSourceDbInstance) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasInstanceTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data dbInstanceDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	instance, err := r.dbaasInstances.Get(ctx, data.ID.ValueString(), dbSDK.GetInstanceOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
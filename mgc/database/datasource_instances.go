@@ -5,6 +5,7 @@ import (
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -16,12 +17,14 @@ import (
 var _ datasource.DataSource = &DataSourceDbInstances{}
 
 type DataSourceDbInstances struct {
+	regional       utils.RegionalService
 	dbaasInstances dbSDK.InstanceService
 }
 
 type dbInstanceModel struct {
 	Instances []dbInstance `tfsdk:"instances"`
 	Status    types.String `tfsdk:"status"`
+	Region    types.String `tfsdk:"region"`
 }
 
 type dbInstance struct {
@@ -50,8 +53,8 @@ func NewDataSourceDbaasInstances() datasource.DataSource {
 	return &DataSourceDbInstances{}
 }
 
-func (r *DataSourceDbInstances) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_instances"
+func (r *DataSourceDbInstances) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasInstancesTypeName
 }
 
 func (r *DataSourceDbInstances) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -64,13 +67,16 @@ func (r *DataSourceDbInstances) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	r.dbaasInstances = dbSDK.New(&dataConfig.CoreConfig).Instances()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
+	})
 }
 
 func (r *DataSourceDbInstances) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A list of database instances.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"instances": schema.ListNestedAttribute{
 				Description: "List of database instances",
 				Computed:    true,
@@ -160,12 +166,19 @@ func (r *DataSourceDbInstances) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 func (r *DataSourceDbInstances) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasInstancesTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := dbInstanceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	params := dbSDK.InstanceFilterOptions{}
 	if data.Status.ValueString() != "" {
 		status := dbSDK.InstanceStatus(data.Status.ValueString())
@@ -174,7 +187,7 @@ func (r *DataSourceDbInstances) Read(ctx context.Context, req datasource.ReadReq
 
 	instances, err := r.dbaasInstances.ListAll(ctx, params)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -5,13 +5,17 @@ import (
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasInstancesSnapshotTypeName = "mgc_dbaas_instances_snapshot"
+
 type DataSourceDbSnapshot struct {
+	regional       utils.RegionalService
 	dbaasInstances dbSDK.InstanceService
 }
 
@@ -25,12 +29,17 @@ type dbSnapshotModel struct {
 	Size        types.Int64  `tfsdk:"size"`
 }
 
+type dbSnapshotRegionalDataSourceModel struct {
+	dbSnapshotModel
+	Region types.String `tfsdk:"region"`
+}
+
 func NewDataSourceDbaasInstancesSnapshot() datasource.DataSource {
 	return &DataSourceDbSnapshot{}
 }
 
-func (r *DataSourceDbSnapshot) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_instances_snapshot"
+func (r *DataSourceDbSnapshot) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasInstancesSnapshotTypeName
 }
 
 func (r *DataSourceDbSnapshot) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -43,13 +52,16 @@ func (r *DataSourceDbSnapshot) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	r.dbaasInstances = dbSDK.New(&dataConfig.CoreConfig).Instances()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
+	})
 }
 
 func (r *DataSourceDbSnapshot) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Get a database snapshot by ID.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "ID of the snapshot",
 				Required:    true,
@@ -83,15 +95,22 @@ func (r *DataSourceDbSnapshot) Schema(_ context.Context, _ datasource.SchemaRequ
 }
 
 func (r *DataSourceDbSnapshot) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data dbSnapshotModel
+	defer tracing.DataSource(&ctx, dbaasInstancesSnapshotTypeName, &resp.State, &resp.Diagnostics)()
+
+	var data dbSnapshotRegionalDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	snapshot, err := r.dbaasInstances.GetSnapshot(ctx, data.InstanceID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
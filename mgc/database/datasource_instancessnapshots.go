@@ -5,6 +5,7 @@ import (
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -12,20 +13,22 @@ import (
 )
 
 type DataSourceDbSnapshots struct {
+	regional       utils.RegionalService
 	dbaasInstances dbSDK.InstanceService
 }
 
 type dbSnapshotsModel struct {
 	InstanceID types.String      `tfsdk:"instance_id"`
 	Snapshots  []dbSnapshotModel `tfsdk:"snapshots"`
+	Region     types.String      `tfsdk:"region"`
 }
 
 func NewDataSourceDbaasInstancesSnapshots() datasource.DataSource {
 	return &DataSourceDbSnapshots{}
 }
 
-func (r *DataSourceDbSnapshots) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_instances_snapshots"
+func (r *DataSourceDbSnapshots) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasInstancesSnapshotsTypeName
 }
 
 func (r *DataSourceDbSnapshots) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -38,13 +41,16 @@ func (r *DataSourceDbSnapshots) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	r.dbaasInstances = dbSDK.New(&dataConfig.CoreConfig).Instances()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
+	})
 }
 
 func (r *DataSourceDbSnapshots) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "List all snapshots for a database instance.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"instance_id": schema.StringAttribute{
 				Description: "ID of the instance",
 				Required:    true,
@@ -90,15 +96,22 @@ func (r *DataSourceDbSnapshots) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 func (r *DataSourceDbSnapshots) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasInstancesSnapshotsTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data dbSnapshotsModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	snapshots, err := r.dbaasInstances.ListAllSnapshots(ctx, data.InstanceID.ValueString(), dbSDK.SnapshotFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -13,15 +14,19 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasInstanceTypesTypeName = "mgc_dbaas_instance_types"
+
 var _ datasource.DataSource = &DataSourceDbInstanceTypes{}
 
 type DataSourceDbInstanceTypes struct {
+	regional           utils.RegionalService
 	dbaasInstanceTypes dbSDK.InstanceTypeService
 }
 
 type dbInstanceTypeModel struct {
 	Status        types.String     `tfsdk:"status"`
 	InstanceTypes []DbInstanceType `tfsdk:"instance_types"`
+	Region        types.String     `tfsdk:"region"`
 }
 
 type DbInstanceType struct {
@@ -36,8 +41,8 @@ func NewDataSourceDbaasInstanceTypes() datasource.DataSource {
 	return &DataSourceDbInstanceTypes{}
 }
 
-func (r *DataSourceDbInstanceTypes) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_instance_types"
+func (r *DataSourceDbInstanceTypes) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasInstanceTypesTypeName
 }
 
 func (r *DataSourceDbInstanceTypes) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -50,13 +55,16 @@ func (r *DataSourceDbInstanceTypes) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	r.dbaasInstanceTypes = dbSDK.New(&dataConfig.CoreConfig).InstanceTypes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasInstanceTypes = catalogInstanceTypes{dbSDK.New(&cfg.CoreConfig).InstanceTypes(), cfg.Catalog()}
+	})
 }
 
 func (r *DataSourceDbInstanceTypes) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "A list of available database instance types.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"instance_types": schema.ListNestedAttribute{
 				Description: "List of available database instance types",
 				Computed:    true,
@@ -96,17 +104,24 @@ func (r *DataSourceDbInstanceTypes) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (r *DataSourceDbInstanceTypes) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasInstanceTypesTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := dbInstanceTypeModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	instanceTypes, err := r.dbaasInstanceTypes.ListAll(ctx, dbSDK.InstanceTypeFilterOptions{
 		Status: data.Status.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
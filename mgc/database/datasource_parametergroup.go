@@ -5,13 +5,22 @@ import (
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasParameterGroupTypeName = "mgc_dbaas_parameter_group"
+
+type parameterGroupDataSourceModel struct {
+	ParameterGroupModel
+	Region types.String `tfsdk:"region"`
+}
+
 type DataSourceDbParameter struct {
+	regional             utils.RegionalService
 	dbaasParameterGroups dbSDK.ParameterGroupService
 }
 
@@ -19,8 +28,8 @@ func NewDataSourceDdbaasParameterGroup() datasource.DataSource {
 	return &DataSourceDbParameter{}
 }
 
-func (r *DataSourceDbParameter) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_parameter_group"
+func (r *DataSourceDbParameter) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasParameterGroupTypeName
 }
 
 func (r *DataSourceDbParameter) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -33,13 +42,16 @@ func (r *DataSourceDbParameter) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	r.dbaasParameterGroups = dbSDK.New(&dataConfig.CoreConfig).ParametersGroup()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasParameterGroups = dbSDK.New(&cfg.CoreConfig).ParametersGroup()
+	})
 }
 
 func (r *DataSourceDbParameter) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Get parameter group details by its ID",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "ID of the parameter group",
 				Required:    true,
@@ -65,15 +77,22 @@ func (r *DataSourceDbParameter) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 func (r *DataSourceDbParameter) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	data := ParameterGroupModel{}
+	defer tracing.DataSource(&ctx, dbaasParameterGroupTypeName, &resp.State, &resp.Diagnostics)()
+
+	data := parameterGroupDataSourceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	parameter, err := r.dbaasParameterGroups.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -13,6 +14,7 @@ import (
 
 type ParameterGroupListModel struct {
 	Parameters []ParameterGroupModel `tfsdk:"parameter_groups"`
+	Region     types.String          `tfsdk:"region"`
 }
 
 type ParameterGroupModel struct {
@@ -24,6 +26,7 @@ type ParameterGroupModel struct {
 }
 
 type DataSourceDbParameterList struct {
+	regional             utils.RegionalService
 	dbaasParameterGroups dbSDK.ParameterGroupService
 }
 
@@ -31,8 +34,8 @@ func NewDataSourceDdbaasParameterGroups() datasource.DataSource {
 	return &DataSourceDbParameterList{}
 }
 
-func (r *DataSourceDbParameterList) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_parameter_groups"
+func (r *DataSourceDbParameterList) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasParameterGroupsTypeName
 }
 
 func (r *DataSourceDbParameterList) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -45,13 +48,16 @@ func (r *DataSourceDbParameterList) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	r.dbaasParameterGroups = dbSDK.New(&dataConfig.CoreConfig).ParametersGroup()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasParameterGroups = dbSDK.New(&cfg.CoreConfig).ParametersGroup()
+	})
 }
 
 func (r *DataSourceDbParameterList) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "List all parameter groups",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"parameter_groups": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -84,15 +90,22 @@ func (r *DataSourceDbParameterList) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (r *DataSourceDbParameterList) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasParameterGroupsTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := ParameterGroupListModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	parameters, err := r.dbaasParameterGroups.ListAll(ctx, dbSDK.ParameterGroupFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
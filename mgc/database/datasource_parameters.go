@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -14,6 +15,7 @@ import (
 type DBaaSParameterDataSourceModel struct {
 	ParameterGroupID types.String       `tfsdk:"parameter_group_id"`
 	Parameters       []ParameterElement `tfsdk:"parameters"`
+	Region           types.String       `tfsdk:"region"`
 }
 
 type ParameterElement struct {
@@ -23,6 +25,7 @@ type ParameterElement struct {
 }
 
 type DataSourceDbParametersList struct {
+	regional        utils.RegionalService
 	dbaasParameters dbSDK.ParameterService
 }
 
@@ -30,8 +33,8 @@ func NewDataSourceDbParametersList() datasource.DataSource {
 	return &DataSourceDbParametersList{}
 }
 
-func (r *DataSourceDbParametersList) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_parameters"
+func (r *DataSourceDbParametersList) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasParametersTypeName
 }
 
 func (r *DataSourceDbParametersList) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -43,13 +46,16 @@ func (r *DataSourceDbParametersList) Configure(ctx context.Context, req datasour
 		resp.Diagnostics.AddError("Failed to get provider data", "Expected utils.DataConfig")
 		return
 	}
-	r.dbaasParameters = dbSDK.New(&cfg.CoreConfig).Parameters()
+	r.regional = utils.NewRegionalService(cfg, func(cfg utils.DataConfig) {
+		r.dbaasParameters = dbSDK.New(&cfg.CoreConfig).Parameters()
+	})
 }
 
 func (r *DataSourceDbParametersList) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "List all parameters in a parameter group",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"parameter_group_id": schema.StringAttribute{
 				Description: "ID of the parameter group",
 				Required:    true,
@@ -78,17 +84,24 @@ func (r *DataSourceDbParametersList) Schema(_ context.Context, _ datasource.Sche
 }
 
 func (r *DataSourceDbParametersList) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasParametersTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data DBaaSParameterDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	list, err := r.dbaasParameters.ListAll(ctx, dbSDK.ParameterFilterOptions{
 		ParameterGroupID: data.ParameterGroupID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -5,12 +5,15 @@ import (
 	"time"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasReplicaTypeName = "mgc_dbaas_replica"
+
 type ReplicaAddressModel struct {
 	Access  types.String `tfsdk:"access"`
 	Type    types.String `tfsdk:"type"`
@@ -35,7 +38,13 @@ type DBaaSReplicaGetModel struct {
 	MaintenanceScheduledAt types.String          `tfsdk:"maintenance_scheduled_at"`
 }
 
+type DBaaSReplicaRegionalDataSourceModel struct {
+	DBaaSReplicaGetModel
+	Region types.String `tfsdk:"region"`
+}
+
 type DataSourceDbReplica struct {
+	regional      utils.RegionalService
 	dbaasReplicas dbSDK.ReplicaService
 }
 
@@ -43,8 +52,8 @@ func NewDataSourceDbReplica() datasource.DataSource {
 	return &DataSourceDbReplica{}
 }
 
-func (r *DataSourceDbReplica) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_replica"
+func (r *DataSourceDbReplica) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasReplicaTypeName
 }
 
 func (r *DataSourceDbReplica) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -56,13 +65,16 @@ func (r *DataSourceDbReplica) Configure(_ context.Context, req datasource.Config
 		resp.Diagnostics.AddError("invalid provider data", "expected utils.DataConfig")
 		return
 	}
-	r.dbaasReplicas = dbSDK.New(&cfg.CoreConfig).Replicas()
+	r.regional = utils.NewRegionalService(cfg, func(cfg utils.DataConfig) {
+		r.dbaasReplicas = dbSDK.New(&cfg.CoreConfig).Replicas()
+	})
 }
 
 func (r *DataSourceDbReplica) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Find DBaaS replica by ID",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of the replica",
@@ -144,14 +156,22 @@ func (r *DataSourceDbReplica) Schema(_ context.Context, _ datasource.SchemaReque
 }
 
 func (r *DataSourceDbReplica) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data DBaaSReplicaGetModel
+	defer tracing.DataSource(&ctx, dbaasReplicaTypeName, &resp.State, &resp.Diagnostics)()
+
+	var data DBaaSReplicaRegionalDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	d, err := r.dbaasReplicas.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	data.SourceID = types.StringValue(d.SourceID)
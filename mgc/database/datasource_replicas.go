@@ -5,6 +5,7 @@ import (
 	"time"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -13,9 +14,11 @@ import (
 
 type DBaaSReplicaListModel struct {
 	Replicas []DBaaSReplicaGetModel `tfsdk:"replicas"`
+	Region   types.String           `tfsdk:"region"`
 }
 
 type DataSourceDbReplicaList struct {
+	regional      utils.RegionalService
 	dbaasReplicas dbSDK.ReplicaService
 }
 
@@ -23,8 +26,8 @@ func NewDataSourceDbReplicaList() datasource.DataSource {
 	return &DataSourceDbReplicaList{}
 }
 
-func (r *DataSourceDbReplicaList) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_replicas"
+func (r *DataSourceDbReplicaList) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = dbaasReplicasTypeName
 }
 
 func (r *DataSourceDbReplicaList) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -36,13 +39,16 @@ func (r *DataSourceDbReplicaList) Configure(_ context.Context, req datasource.Co
 		resp.Diagnostics.AddError("invalid provider data", "expected utils.DataConfig")
 		return
 	}
-	r.dbaasReplicas = dbSDK.New(&cfg.CoreConfig).Replicas()
+	r.regional = utils.NewRegionalService(cfg, func(cfg utils.DataConfig) {
+		r.dbaasReplicas = dbSDK.New(&cfg.CoreConfig).Replicas()
+	})
 }
 
 func (r *DataSourceDbReplicaList) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "List all DBaaS replicas",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"replicas": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of replicas",
@@ -131,15 +137,22 @@ func (r *DataSourceDbReplicaList) Schema(_ context.Context, _ datasource.SchemaR
 }
 
 func (r *DataSourceDbReplicaList) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, dbaasReplicasTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data DBaaSReplicaListModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	list, err := r.dbaasReplicas.ListAll(ctx, dbSDK.ReplicaFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -0,0 +1,107 @@
+package database
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+var (
+	_ list.ListResource              = &dbaasInstancesList{}
+	_ list.ListResourceWithConfigure = &dbaasInstancesList{}
+)
+
+type dbaasInstancesList struct {
+	regional           utils.RegionalService
+	dbaasInstances     dbSDK.InstanceService
+	dbaasEngines       dbSDK.EngineService
+	dbaasInstanceTypes dbSDK.InstanceTypeService
+}
+
+type dbaasInstancesListModel struct {
+	utils.ListConfig
+	Status   types.String `tfsdk:"status"`
+	EngineID types.String `tfsdk:"engine_id"`
+}
+
+func NewDBaaSInstancesList() list.ListResource {
+	return &dbaasInstancesList{}
+}
+
+func (r *dbaasInstancesList) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = dbaasInstancesTypeName
+}
+
+func (r *dbaasInstancesList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
+		r.dbaasEngines = catalogEngines{dbSDK.New(&cfg.CoreConfig).Engines(), cfg.Catalog()}
+		r.dbaasInstanceTypes = catalogInstanceTypes{dbSDK.New(&cfg.CoreConfig).InstanceTypes(), cfg.Catalog()}
+	})
+}
+
+func (r *dbaasInstancesList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists DBaaS instances.",
+		Attributes: map[string]listschema.Attribute{
+			"region":     utils.ListRegionAttribute(),
+			"name_regex": utils.ListNameRegexAttribute(),
+			"status": listschema.StringAttribute{
+				Description: "Only list instances with this status, e.g. `ACTIVE`.",
+				Optional:    true,
+			},
+			"engine_id": listschema.StringAttribute{
+				Description: "Only list instances of this engine.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *dbaasInstancesList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	utils.List(ctx, req, stream, utils.Lister[dbaasInstancesListModel, dbSDK.InstanceDetail]{
+		TypeName: dbaasInstancesTypeName,
+		Identity: dbaasInstanceIdentity,
+		Use:      r.regional.Use,
+		Fetch: func(ctx context.Context, config dbaasInstancesListModel) ([]dbSDK.InstanceDetail, error) {
+			filter := dbSDK.InstanceFilterOptions{EngineID: config.EngineID.ValueStringPointer()}
+			if !config.Status.IsNull() {
+				status := dbSDK.InstanceStatus(config.Status.ValueString())
+				filter.Status = &status
+			}
+			return r.dbaasInstances.ListAll(ctx, filter)
+		},
+		Describe: func(_ dbaasInstancesListModel, instance dbSDK.InstanceDetail) (string, map[string]string, bool) {
+			return instance.Name, map[string]string{"id": instance.ID}, true
+		},
+		Resource: func(ctx context.Context, config dbaasInstancesListModel, instance dbSDK.InstanceDetail) (any, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			data := DBaaSInstanceModel{
+				ID:       types.StringValue(instance.ID),
+				Region:   config.Region,
+				Timeouts: utils.NullTimeouts(dbaasInstanceTimeoutsOpts),
+			}
+			if err := readInstance(ctx, r.dbaasEngines, r.dbaasInstanceTypes, &instance, &data); err != nil {
+				diags.Append(utils.SDKErrorDiagnostic(err))
+			}
+			return data, diags
+		},
+	})
+}
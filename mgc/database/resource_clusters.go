@@ -3,17 +3,16 @@ package database
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -25,6 +24,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasClustersTypeName = "mgc_dbaas_clusters"
+
 const (
 	clusterStatusTimeout      = 90 * time.Minute
 	dbaasClusterProductFamily = "CLUSTER"
@@ -61,9 +62,14 @@ type DBaaSClusterModel struct {
 	InstanceTypeID         types.String               `tfsdk:"instance_type_id"`
 	EngineID               types.String               `tfsdk:"engine_id"`
 	DeletionProtected      types.Bool                 `tfsdk:"deletion_protected"`
+	Region                 types.String               `tfsdk:"region"`
+	Timeouts               timeouts.Value             `tfsdk:"timeouts"`
 }
 
+var dbaasClusterTimeoutsOpts = timeouts.Opts{Create: true, Update: true, Delete: true}
+
 type DBaaSClusterResource struct {
+	regional           utils.RegionalService
 	dbaasClusters      dbSDK.ClusterService
 	dbaasEngines       dbSDK.EngineService
 	dbaasInstanceTypes dbSDK.InstanceTypeService
@@ -83,12 +89,16 @@ func (s DBaaSClusterStatus) IsAnyError() bool {
 	return strings.Contains(string(s), "ERROR")
 }
 
+var dbaasClusterIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the DBaaS cluster."),
+)
+
 func NewDBaaSClusterResource() resource.Resource {
 	return &DBaaSClusterResource{}
 }
 
-func (r *DBaaSClusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_clusters"
+func (r *DBaaSClusterResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = dbaasClustersTypeName
 }
 
 func (r *DBaaSClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -101,16 +111,19 @@ func (r *DBaaSClusterResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	sdkClient := dbSDK.New(&dataConfig.CoreConfig)
-	r.dbaasClusters = sdkClient.Clusters()
-	r.dbaasEngines = sdkClient.Engines()
-	r.dbaasInstanceTypes = sdkClient.InstanceTypes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		sdkClient := dbSDK.New(&cfg.CoreConfig)
+		r.dbaasClusters = sdkClient.Clusters()
+		r.dbaasEngines = catalogEngines{sdkClient.Engines(), cfg.Catalog()}
+		r.dbaasInstanceTypes = catalogInstanceTypes{sdkClient.InstanceTypes(), cfg.Catalog()}
+	})
 }
 
-func (r *DBaaSClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DBaaSClusterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a DBaaS (Database-as-a-Service) Cluster.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the DBaaS cluster. Generated automatically on creation.",
 				Computed:    true,
@@ -321,16 +334,33 @@ func (r *DBaaSClusterResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, dbaasClusterTimeoutsOpts),
+		},
 	}
 }
 
 func (r *DBaaSClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, dbaasClustersTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer dbaasClusterIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var plan DBaaSClusterModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, clusterStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	engineID, err := ValidateAndGetEngineID(ctx, r.dbaasEngines.ListAll, plan.EngineName.ValueString(), plan.EngineVersion.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid Engine", fmt.Sprintf("Failed to validate engine '%s' version '%s': %s", plan.EngineName.ValueString(), plan.EngineVersion.ValueString(), err.Error()))
@@ -364,7 +394,7 @@ func (r *DBaaSClusterResource) Create(ctx context.Context, req resource.CreateRe
 
 	clusterResp, err := r.dbaasClusters.Create(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -372,9 +402,10 @@ func (r *DBaaSClusterResource) Create(ctx context.Context, req resource.CreateRe
 	plan.EngineID = types.StringValue(engineID)
 	plan.InstanceTypeID = types.StringValue(instanceTypeID)
 
-	getCluster, err := r.waitUntilClusterStatusMatches(ctx, clusterResp.ID, dbSDK.ClusterStatusActive)
+	getCluster, err := r.waitUntilClusterStatusMatches(ctx, clusterResp.ID, dbSDK.ClusterStatusActive, createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Cluster Creation Error", fmt.Sprintf("Error waiting for cluster %s to become active: %s", clusterResp.ID, err.Error()))
+		dbaasClusterIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": plan.Region.ValueString(), "id": clusterResp.ID}, &resp.Diagnostics)
 		return
 	}
 
@@ -383,21 +414,33 @@ func (r *DBaaSClusterResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *DBaaSClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, dbaasClustersTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer dbaasClusterIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var state DBaaSClusterModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	detailedCluster, err := r.dbaasClusters.Get(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	engineInfo, err := r.dbaasEngines.Get(ctx, detailedCluster.EngineID)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	state.EngineName = types.StringValue(engineInfo.Name)
@@ -405,7 +448,7 @@ func (r *DBaaSClusterResource) Read(ctx context.Context, req resource.ReadReques
 
 	instanceInfo, err := r.dbaasInstanceTypes.Get(ctx, detailedCluster.InstanceTypeID)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	state.InstanceType = types.StringValue(instanceInfo.Label)
@@ -415,6 +458,9 @@ func (r *DBaaSClusterResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *DBaaSClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, dbaasClustersTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer dbaasClusterIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var plan DBaaSClusterModel
 	hasResizeUpdate := false
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -428,6 +474,18 @@ func (r *DBaaSClusterResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, clusterStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Timeouts = plan.Timeouts
+
 	clusterID := state.ID.ValueString()
 
 	var clusterResizeRequest dbSDK.ClusterResizeRequest
@@ -437,7 +495,7 @@ func (r *DBaaSClusterResource) Update(ctx context.Context, req resource.UpdateRe
 			ctx, r.dbaasInstanceTypes.ListAll, plan.InstanceType.ValueString(), state.EngineID.ValueString(), dbaasClusterProductFamily,
 		)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 		state.InstanceType = plan.InstanceType
@@ -456,11 +514,11 @@ func (r *DBaaSClusterResource) Update(ctx context.Context, req resource.UpdateRe
 	if hasResizeUpdate {
 		_, err := r.dbaasClusters.Resize(ctx, clusterID, clusterResizeRequest)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 
-		if _, err := r.waitUntilClusterStatusMatches(ctx, clusterID, dbSDK.ClusterStatusActive); err != nil {
+		if _, err := r.waitUntilClusterStatusMatches(ctx, clusterID, dbSDK.ClusterStatusActive, updateTimeout); err != nil {
 			resp.Diagnostics.AddError("Error waiting for cluster to be active", err.Error())
 			return
 		}
@@ -493,10 +551,10 @@ func (r *DBaaSClusterResource) Update(ctx context.Context, req resource.UpdateRe
 	if changed {
 		_, err := r.dbaasClusters.Update(ctx, clusterID, updateReq)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
-		_, err = r.waitUntilClusterStatusMatches(ctx, clusterID, dbSDK.ClusterStatusActive)
+		_, err = r.waitUntilClusterStatusMatches(ctx, clusterID, dbSDK.ClusterStatusActive, updateTimeout)
 		if err != nil {
 			resp.Diagnostics.AddError("Cluster Update Error", fmt.Sprintf("Error waiting for cluster %s to become stable after update: %s", clusterID, err.Error()))
 			return
@@ -506,34 +564,55 @@ func (r *DBaaSClusterResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *DBaaSClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, dbaasClustersTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var state DBaaSClusterModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, clusterStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	clusterID := state.ID.ValueString()
 	cluster, err := r.dbaasClusters.Get(ctx, clusterID)
 
-	if err != nil && strings.Contains(err.Error(), strconv.Itoa(http.StatusNotFound)) {
+	if utils.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	if string(cluster.Status) != string(dbSDK.ClusterStatusDeleting) {
 		err := r.dbaasClusters.Delete(ctx, string(clusterID))
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
 
-	if _, err := r.waitUntilClusterIsDeleted(ctx, clusterID); err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+	if _, err := r.waitUntilClusterIsDeleted(ctx, clusterID, deleteTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+func (r *DBaaSClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = dbaasClusterIdentity.Schema()
+}
+
 func (r *DBaaSClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	dbaasClusterIdentity.ImportState(ctx, req, resp)
 }
 
 func (r *DBaaSClusterResource) populateModelFromDetailResponse(detail *dbSDK.ClusterDetailResponse, model *DBaaSClusterModel) {
@@ -576,50 +655,42 @@ func (r *DBaaSClusterResource) populateModelFromDetailResponse(detail *dbSDK.Clu
 	model.DeletionProtected = types.BoolValue(detail.DeletionProtected)
 }
 
-func (r *DBaaSClusterResource) waitUntilClusterStatusMatches(ctx context.Context, clusterID string, targetStatus dbSDK.ClusterStatus) (*dbSDK.ClusterDetailResponse, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, clusterStatusTimeout)
-	defer cancel()
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for cluster %s to reach status %s", clusterID, targetStatus)
-		case <-time.After(15 * time.Second):
+func (r *DBaaSClusterResource) waitUntilClusterStatusMatches(ctx context.Context, clusterID string, targetStatus dbSDK.ClusterStatus, timeout time.Duration) (*dbSDK.ClusterDetailResponse, error) {
+	return utils.StateWaiter[*dbSDK.ClusterDetailResponse]{
+		Description: fmt.Sprintf("cluster %s", clusterID),
+		Target:      []string{string(targetStatus)},
+		IsError:     func(state string) bool { return DBaaSClusterStatus(state).IsAnyError() },
+		Timeout:     timeout,
+		Delay:       15 * time.Second,
+		MinInterval: 15 * time.Second,
+		MaxInterval: time.Minute,
+		Refresh: func(ctx context.Context) (*dbSDK.ClusterDetailResponse, string, error) {
 			cluster, err := r.dbaasClusters.Get(ctx, clusterID)
 			if err != nil {
-				return nil, fmt.Errorf("failed to get cluster %s during status wait: %w", clusterID, err)
-			}
-
-			currentStatus := cluster.Status
-			if currentStatus == targetStatus {
-				return cluster, nil
+				return nil, "", fmt.Errorf("failed to get cluster %s during status wait: %w", clusterID, err)
 			}
-
-			if strings.Contains(strings.ToLower(string(currentStatus)), "error") {
-				return nil, fmt.Errorf("cluster %s entered error state: %s", clusterID, currentStatus)
-			}
-		}
-	}
+			return cluster, string(cluster.Status), nil
+		},
+	}.Wait(ctx)
 }
 
-func (r *DBaaSClusterResource) waitUntilClusterIsDeleted(ctx context.Context, clusterID string) (*dbSDK.ClusterDetailResponse, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, clusterStatusTimeout)
-	defer cancel()
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for cluster %s to be deleted", clusterID)
-		case <-time.After(10 * time.Second):
+func (r *DBaaSClusterResource) waitUntilClusterIsDeleted(ctx context.Context, clusterID string, timeout time.Duration) (*dbSDK.ClusterDetailResponse, error) {
+	return utils.StateWaiter[*dbSDK.ClusterDetailResponse]{
+		Description: fmt.Sprintf("cluster %s", clusterID),
+		Target:      []string{string(dbSDK.ClusterStatusDeleted)},
+		IsError:     func(state string) bool { return DBaaSClusterStatus(state).IsAnyError() },
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		MinInterval: 10 * time.Second,
+		Refresh: func(ctx context.Context) (*dbSDK.ClusterDetailResponse, string, error) {
 			cluster, err := r.dbaasClusters.Get(ctx, clusterID)
-			if err != nil && strings.Contains(err.Error(), strconv.Itoa(http.StatusNotFound)) {
-				return nil, nil
+			if utils.IsNotFound(err) {
+				return nil, string(dbSDK.ClusterStatusDeleted), nil
 			}
 			if err != nil {
-				return nil, err
-			}
-			currentStatus := DBaaSClusterStatus(cluster.Status)
-			if currentStatus.IsAnyError() {
-				return nil, fmt.Errorf("cluster %s is in error status %s", clusterID, string(currentStatus))
+				return nil, "", err
 			}
-		}
-	}
+			return cluster, string(cluster.Status), nil
+		},
+	}.Wait(ctx)
 }
@@ -3,14 +3,14 @@ package database
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"regexp"
-	"strconv"
 	"strings"
 	"time"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,6 +22,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasInstancesTypeName = "mgc_dbaas_instances"
+
 const (
 	instanceStatusTimeout      = 90 * time.Minute
 	dbaasInstanceProductFamily = "SINGLE_INSTANCE"
@@ -65,39 +67,66 @@ func (s DBaaSInstanceStatus) IsAnyError() bool {
 }
 
 type DBaaSInstanceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	User                types.String `tfsdk:"user"`
-	Password            types.String `tfsdk:"password"`
-	EngineName          types.String `tfsdk:"engine_name"`
-	EngineVersion       types.String `tfsdk:"engine_version"`
-	InstanceType        types.String `tfsdk:"instance_type"`
-	VolumeSize          types.Int64  `tfsdk:"volume_size"`
-	VolumeType          types.String `tfsdk:"volume_type"`
-	BackupRetentionDays types.Int64  `tfsdk:"backup_retention_days"`
-	BackupStartAt       types.String `tfsdk:"backup_start_at"`
-	AvailabilityZone    types.String `tfsdk:"availability_zone"`
-	ParameterGroup      types.String `tfsdk:"parameter_group"`
-	Status              types.String `tfsdk:"status"`
-	InstanceTypeId      types.String `tfsdk:"instance_type_id"`
-	EngineID            types.String `tfsdk:"engine_id"`
-	SnapshotID          types.String `tfsdk:"snapshot_id"`
-	SnapshotSourceID    types.String `tfsdk:"snapshot_source_id"`
-	DeletionProtected   types.Bool   `tfsdk:"deletion_protected"`
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	User                types.String   `tfsdk:"user"`
+	Password            types.String   `tfsdk:"password"`
+	EngineName          types.String   `tfsdk:"engine_name"`
+	EngineVersion       types.String   `tfsdk:"engine_version"`
+	InstanceType        types.String   `tfsdk:"instance_type"`
+	VolumeSize          types.Int64    `tfsdk:"volume_size"`
+	VolumeType          types.String   `tfsdk:"volume_type"`
+	BackupRetentionDays types.Int64    `tfsdk:"backup_retention_days"`
+	BackupStartAt       types.String   `tfsdk:"backup_start_at"`
+	AvailabilityZone    types.String   `tfsdk:"availability_zone"`
+	ParameterGroup      types.String   `tfsdk:"parameter_group"`
+	Status              types.String   `tfsdk:"status"`
+	InstanceTypeId      types.String   `tfsdk:"instance_type_id"`
+	EngineID            types.String   `tfsdk:"engine_id"`
+	SnapshotID          types.String   `tfsdk:"snapshot_id"`
+	SnapshotSourceID    types.String   `tfsdk:"snapshot_source_id"`
+	DeletionProtected   types.Bool     `tfsdk:"deletion_protected"`
+	Region              types.String   `tfsdk:"region"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+var dbaasInstanceTimeoutsOpts = timeouts.Opts{Create: true, Update: true, Delete: true}
+
+// dbaasInstanceSchemaVersion 1 replaced the volume object of older releases by
+// volume_size and volume_type, and removed the user and password kept in
+// state before they became write-only.
+const dbaasInstanceSchemaVersion = 1
+
+var dbaasInstanceLegacyMigrations = []utils.StateMigration{
+	utils.LiftAttributes("volume", map[string]string{
+		"size": "volume_size",
+		"type": "volume_type",
+	}),
+}
+
+// dbaasInstanceMoveSources are the type names of DBaaS instances in older
+// provider generations, or under another provider address.
+var dbaasInstanceMoveSources = []string{
+	dbaasInstancesTypeName,
 }
 
 type DBaaSInstanceResource struct {
+	regional           utils.RegionalService
 	dbaasInstances     dbSDK.InstanceService
 	dbaasEngines       dbSDK.EngineService
 	dbaasInstanceTypes dbSDK.InstanceTypeService
 }
 
+var dbaasInstanceIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the DBaaS instance."),
+)
+
 func NewDBaaSInstanceResource() resource.Resource {
 	return &DBaaSInstanceResource{}
 }
 
-func (r *DBaaSInstanceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_instances"
+func (r *DBaaSInstanceResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = dbaasInstancesTypeName
 }
 
 func (r *DBaaSInstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -110,15 +139,19 @@ func (r *DBaaSInstanceResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	r.dbaasInstances = dbSDK.New(&dataConfig.CoreConfig).Instances()
-	r.dbaasEngines = dbSDK.New(&dataConfig.CoreConfig).Engines()
-	r.dbaasInstanceTypes = dbSDK.New(&dataConfig.CoreConfig).InstanceTypes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
+		r.dbaasEngines = catalogEngines{dbSDK.New(&cfg.CoreConfig).Engines(), cfg.Catalog()}
+		r.dbaasInstanceTypes = catalogInstanceTypes{dbSDK.New(&cfg.CoreConfig).InstanceTypes(), cfg.Catalog()}
+	})
 }
 
-func (r *DBaaSInstanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DBaaSInstanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:     dbaasInstanceSchemaVersion,
 		Description: "Manages a DBaaS (Database-as-a-Service) instance",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the DBaaS instance. Generated automatically on creation.",
 				Computed:    true,
@@ -298,16 +331,33 @@ func (r *DBaaSInstanceResource) Schema(_ context.Context, _ resource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, dbaasInstanceTimeoutsOpts),
+		},
 	}
 }
 
 func (r *DBaaSInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, dbaasInstancesTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer dbaasInstanceIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSInstanceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, instanceStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if data.SnapshotID.ValueString() != "" {
 		if data.SnapshotSourceID.IsUnknown() {
 			resp.Diagnostics.AddError("Invalid ID", "For restoring a snapshot the snapshot source ID must be known")
@@ -359,9 +409,10 @@ func (r *DBaaSInstanceResource) Create(ctx context.Context, req resource.CreateR
 		data.Password = types.StringNull()
 		data.User = types.StringNull()
 
-		result, err := r.waitUntilInstanceStatusMatches(ctx, data.ID.ValueString(), DBaaSInstanceStatusActive.String())
+		result, err := r.waitUntilInstanceStatusMatches(ctx, data.ID.ValueString(), DBaaSInstanceStatusActive.String(), createTimeout)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+			dbaasInstanceIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": data.Region.ValueString(), "id": createdInstance.ID}, &resp.Diagnostics)
 			return
 		}
 		data.Status = types.StringValue(string(result.Status))
@@ -404,7 +455,7 @@ func (r *DBaaSInstanceResource) Create(ctx context.Context, req resource.CreateR
 
 	created, err := r.dbaasInstances.Create(ctx, params)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -413,9 +464,10 @@ func (r *DBaaSInstanceResource) Create(ctx context.Context, req resource.CreateR
 	data.ID = types.StringValue(created.ID)
 	data.Password = types.StringNull()
 	data.User = types.StringNull()
-	result, err := r.waitUntilInstanceStatusMatches(ctx, data.ID.ValueString(), DBaaSInstanceStatusActive.String())
+	result, err := r.waitUntilInstanceStatusMatches(ctx, data.ID.ValueString(), DBaaSInstanceStatusActive.String(), createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		dbaasInstanceIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": data.Region.ValueString(), "id": created.ID}, &resp.Diagnostics)
 		return
 	}
 	data.Status = types.StringValue(string(result.Status))
@@ -424,28 +476,48 @@ func (r *DBaaSInstanceResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *DBaaSInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, dbaasInstancesTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer dbaasInstanceIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSInstanceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	instance, err := r.dbaasInstances.Get(ctx, data.ID.ValueString(), dbSDK.GetInstanceOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	engineName, engineVersion, err := GetEngineNameAndVersionByID(ctx, r.dbaasEngines.Get, instance.EngineID)
-	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+	if err := readInstance(ctx, r.dbaasEngines, r.dbaasInstanceTypes, instance, &data); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-	instanceTypeName, err := GetInstanceTypeNameByID(ctx, r.dbaasInstanceTypes.Get, instance.InstanceTypeID)
+// readInstance sets the attributes of data read from instance, looking up the
+// names of its engine and instance type.
+func readInstance(ctx context.Context, engines dbSDK.EngineService, instanceTypes dbSDK.InstanceTypeService, instance *dbSDK.InstanceDetail, data *DBaaSInstanceModel) error {
+	engineName, engineVersion, err := GetEngineNameAndVersionByID(ctx, engines.Get, instance.EngineID)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
-		return
+		return err
+	}
+
+	instanceTypeName, err := GetInstanceTypeNameByID(ctx, instanceTypes.Get, instance.InstanceTypeID)
+	if err != nil {
+		return err
 	}
 
 	data.InstanceTypeId = types.StringValue(instance.InstanceTypeID)
@@ -464,10 +536,13 @@ func (r *DBaaSInstanceResource) Read(ctx context.Context, req resource.ReadReque
 	data.Password = types.StringNull()
 	data.User = types.StringNull()
 	data.DeletionProtected = types.BoolValue(instance.DeletionProtected)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	return nil
 }
 
 func (r *DBaaSInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, dbaasInstancesTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer dbaasInstanceIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var planData DBaaSInstanceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
 	if resp.Diagnostics.HasError() {
@@ -480,6 +555,27 @@ func (r *DBaaSInstanceResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&stateData.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Backup settings left out of the configuration are unknown in the plan,
+	// and the API keeps their current values.
+	if planData.BackupRetentionDays.IsUnknown() {
+		planData.BackupRetentionDays = stateData.BackupRetentionDays
+	}
+	if planData.BackupStartAt.IsUnknown() {
+		planData.BackupStartAt = stateData.BackupStartAt
+	}
+
+	updateTimeout, diags := planData.Timeouts.Update(ctx, instanceStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateData.Timeouts = planData.Timeouts
+
 	var hasResizeUpdate bool
 	var instanceResizeRequest dbSDK.InstanceResizeRequest
 
@@ -488,7 +584,7 @@ func (r *DBaaSInstanceResource) Update(ctx context.Context, req resource.UpdateR
 			ctx, r.dbaasInstanceTypes.ListAll, planData.InstanceType.ValueString(), stateData.EngineID.ValueString(), dbaasInstanceProductFamily,
 		)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 		stateData.InstanceTypeId = types.StringValue(instanceTypeID)
@@ -508,12 +604,12 @@ func (r *DBaaSInstanceResource) Update(ctx context.Context, req resource.UpdateR
 	if hasResizeUpdate {
 		_, err := r.dbaasInstances.Resize(ctx, stateData.ID.ValueString(), instanceResizeRequest)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 
-		if _, err := r.waitUntilInstanceStatusMatches(ctx, planData.ID.ValueString(), DBaaSInstanceStatusActive.String()); err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if _, err := r.waitUntilInstanceStatusMatches(ctx, planData.ID.ValueString(), DBaaSInstanceStatusActive.String(), updateTimeout); err != nil {
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
@@ -541,12 +637,12 @@ func (r *DBaaSInstanceResource) Update(ctx context.Context, req resource.UpdateR
 
 		_, err := r.dbaasInstances.Update(ctx, planData.ID.ValueString(), reqParams)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 
-		if _, err := r.waitUntilInstanceStatusMatches(ctx, planData.ID.ValueString(), DBaaSInstanceStatusActive.String()); err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if _, err := r.waitUntilInstanceStatusMatches(ctx, planData.ID.ValueString(), DBaaSInstanceStatusActive.String(), updateTimeout); err != nil {
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
@@ -555,80 +651,111 @@ func (r *DBaaSInstanceResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *DBaaSInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, dbaasInstancesTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data DBaaSInstanceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, instanceStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	instanceID := data.ID.ValueString()
 	instance, err := r.dbaasInstances.Get(ctx, instanceID, dbSDK.GetInstanceOptions{})
 
-	if err != nil && strings.Contains(err.Error(), strconv.Itoa(http.StatusNotFound)) {
+	if utils.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	if DBaaSInstanceStatus(instance.Status) != DBaaSInstanceStatusDeleting {
 		err := r.dbaasInstances.Delete(ctx, string(instanceID))
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
 
-	if _, err := r.waitUntilInstanceIsDeleted(ctx, instanceID); err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+	if _, err := r.waitUntilInstanceIsDeleted(ctx, instanceID, deleteTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+func (r *DBaaSInstanceResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: utils.UpgradeState(dbaasInstanceLegacyMigrations...),
+	}
+}
+
+func (r *DBaaSInstanceResource) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		utils.MoveState(dbaasInstanceMoveSources, dbaasInstanceLegacyMigrations...),
+	}
+}
+
+func (r *DBaaSInstanceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = dbaasInstanceIdentity.Schema()
+}
+
 func (r *DBaaSInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	data := DBaaSInstanceModel{}
-	data.ID = types.StringValue(req.ID)
+	data.Timeouts = utils.NullTimeouts(dbaasInstanceTimeoutsOpts)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dbaasInstanceIdentity.ImportState(ctx, req, resp)
 }
 
-func (r *DBaaSInstanceResource) waitUntilInstanceStatusMatches(ctx context.Context, instanceID string, status string) (*dbSDK.InstanceDetail, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, instanceStatusTimeout)
-	defer cancel()
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for instance %s to reach status %s", instanceID, status)
-		case <-time.After(instanceStatusPollInterval):
+func (r *DBaaSInstanceResource) waitUntilInstanceStatusMatches(ctx context.Context, instanceID string, status string, timeout time.Duration) (*dbSDK.InstanceDetail, error) {
+	return utils.StateWaiter[*dbSDK.InstanceDetail]{
+		Description: fmt.Sprintf("instance %s", instanceID),
+		Target:      []string{status},
+		IsError:     func(state string) bool { return DBaaSInstanceStatus(state).IsAnyError() },
+		Timeout:     timeout,
+		Delay:       instanceStatusPollInterval,
+		MinInterval: instanceStatusPollInterval,
+		Refresh: func(ctx context.Context) (*dbSDK.InstanceDetail, string, error) {
 			instance, err := r.dbaasInstances.Get(ctx, instanceID, dbSDK.GetInstanceOptions{})
 			if err != nil {
-				return nil, err
+				return nil, "", err
 			}
-			currentStatus := DBaaSInstanceStatus(instance.Status)
-			if currentStatus.String() == status {
-				return instance, nil
-			}
-			if currentStatus.IsAnyError() {
-				return nil, fmt.Errorf("instance %s is in error state", instanceID)
-			}
-		}
-	}
+			return instance, string(instance.Status), nil
+		},
+	}.Wait(ctx)
 }
 
-func (r *DBaaSInstanceResource) waitUntilInstanceIsDeleted(ctx context.Context, instanceID string) (*dbSDK.InstanceDetail, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, instanceStatusTimeout)
-	defer cancel()
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for instance %s to be deleted", instanceID)
-		case <-time.After(instanceStatusPollInterval):
+func (r *DBaaSInstanceResource) waitUntilInstanceIsDeleted(ctx context.Context, instanceID string, timeout time.Duration) (*dbSDK.InstanceDetail, error) {
+	return utils.StateWaiter[*dbSDK.InstanceDetail]{
+		Description: fmt.Sprintf("instance %s", instanceID),
+		Target:      []string{DBaaSInstanceStatusDeleted.String()},
+		IsError:     func(state string) bool { return DBaaSInstanceStatus(state).IsAnyError() },
+		Timeout:     timeout,
+		Delay:       instanceStatusPollInterval,
+		MinInterval: instanceStatusPollInterval,
+		Refresh: func(ctx context.Context) (*dbSDK.InstanceDetail, string, error) {
 			instance, err := r.dbaasInstances.Get(ctx, instanceID, dbSDK.GetInstanceOptions{})
-			if err != nil && strings.Contains(err.Error(), strconv.Itoa(http.StatusNotFound)) {
-				return nil, nil
+			if utils.IsNotFound(err) {
+				return nil, DBaaSInstanceStatusDeleted.String(), nil
 			}
 			if err != nil {
-				return nil, err
+				return nil, "", err
 			}
-			currentStatus := DBaaSInstanceStatus(instance.Status)
-			if currentStatus.IsAnyError() {
-				return nil, fmt.Errorf("instance %s is in error status %s", instanceID, string(currentStatus))
-			}
-		}
-	}
+			return instance, string(instance.Status), nil
+		},
+	}.Wait(ctx)
 }
@@ -0,0 +1,47 @@
+package database
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDBaaSInstanceUpgradeState(t *testing.T) {
+	ctx := context.Background()
+	r := &DBaaSInstanceResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.Equal(t, int64(dbaasInstanceSchemaVersion), schemaResp.Schema.Version)
+
+	prior := `{
+		"id": "instance-id",
+		"name": "db",
+		"user": "admin",
+		"password": "s3cr3t-pass",
+		"engine_name": "mysql",
+		"engine_version": "8.0",
+		"instance_type": "DP2-16-40",
+		"volume": {"size": 20, "type": "CLOUD_NVME15K"},
+		"backup_retention_days": 7
+	}`
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.UpgradeState(ctx)[0].StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(prior)}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	raw, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	require.NoError(t, err)
+	var data DBaaSInstanceModel
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+	require.False(t, state.Get(ctx, &data).HasError())
+
+	assert.Equal(t, "instance-id", data.ID.ValueString())
+	assert.Equal(t, int64(20), data.VolumeSize.ValueInt64())
+	assert.Equal(t, "CLOUD_NVME15K", data.VolumeType.ValueString())
+	assert.True(t, data.User.IsNull(), "write-only user must not be kept in state")
+	assert.True(t, data.Password.IsNull(), "write-only password must not be kept in state")
+}
@@ -3,12 +3,13 @@ package database
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,6 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasInstancesSnapshotsTypeName = "mgc_dbaas_instances_snapshots"
+
 const snapshotStatusTimeout = 70 * time.Minute
 
 type DBaaSInstanceSnapshotStatus string
@@ -35,22 +38,32 @@ func (s DBaaSInstanceSnapshotStatus) String() string {
 }
 
 type DBaaSInstanceSnapshotModel struct {
-	ID          types.String `tfsdk:"id"`
-	InstanceID  types.String `tfsdk:"instance_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID          types.String   `tfsdk:"id"`
+	InstanceID  types.String   `tfsdk:"instance_id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Region      types.String   `tfsdk:"region"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
+var dbaasInstanceSnapshotTimeoutsOpts = timeouts.Opts{Create: true}
+
 type DBaaSInstanceSnapshotResource struct {
+	regional       utils.RegionalService
 	dbaasInstances dbSDK.InstanceService
 }
 
+var dbaasInstanceSnapshotIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "instance_id", Description: "ID of the DBaaS instance."},
+	utils.IdentityAttribute{Name: "snapshot_id", Description: "ID of the snapshot.", StateAttribute: "id"},
+)
+
 func NewDBaaSInstanceSnapshotResource() resource.Resource {
 	return &DBaaSInstanceSnapshotResource{}
 }
 
-func (r *DBaaSInstanceSnapshotResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_instances_snapshots"
+func (r *DBaaSInstanceSnapshotResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = dbaasInstancesSnapshotsTypeName
 }
 
 func (r *DBaaSInstanceSnapshotResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -63,13 +76,16 @@ func (r *DBaaSInstanceSnapshotResource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	r.dbaasInstances = dbSDK.New(&dataConfig.CoreConfig).Instances()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
+	})
 }
 
-func (r *DBaaSInstanceSnapshotResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DBaaSInstanceSnapshotResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a DBaaS instance snapshot",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the snapshot",
 				Computed:    true,
@@ -96,44 +112,79 @@ func (r *DBaaSInstanceSnapshotResource) Schema(_ context.Context, _ resource.Sch
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, dbaasInstanceSnapshotTimeoutsOpts),
+		},
 	}
 }
 
 func (r *DBaaSInstanceSnapshotResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, dbaasInstancesSnapshotsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer dbaasInstanceSnapshotIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSInstanceSnapshotModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, snapshotStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	created, err := r.dbaasInstances.CreateSnapshot(ctx, data.InstanceID.ValueString(), dbSDK.SnapshotCreateRequest{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	data.ID = types.StringValue(created.ID)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
-	err = r.waitUntilSnapshotStatusMatches(ctx, data.InstanceID.ValueString(), created.ID, DBaaSInstanceSnapshotStatusAvailable)
+	err = waitUntilSnapshotStatusMatches(ctx, r.dbaasInstances, data.InstanceID.ValueString(), created.ID, DBaaSInstanceSnapshotStatusAvailable, createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		dbaasInstanceSnapshotIdentity.SetCreated(ctx, &resp.State, map[string]string{
+			"region":      data.Region.ValueString(),
+			"instance_id": data.InstanceID.ValueString(),
+			"snapshot_id": created.ID,
+		}, &resp.Diagnostics)
 		return
 	}
+
+	data.ID = types.StringValue(created.ID)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DBaaSInstanceSnapshotResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, dbaasInstancesSnapshotsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer dbaasInstanceSnapshotIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSInstanceSnapshotModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	snapshot, err := r.dbaasInstances.GetSnapshot(ctx, data.InstanceID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -143,6 +194,9 @@ func (r *DBaaSInstanceSnapshotResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *DBaaSInstanceSnapshotResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, dbaasInstancesSnapshotsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer dbaasInstanceSnapshotIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var planData DBaaSInstanceSnapshotModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
 	if resp.Diagnostics.HasError() {
@@ -155,8 +209,14 @@ func (r *DBaaSInstanceSnapshotResource) Update(ctx context.Context, req resource
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&currentData.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	currentData.Name = planData.Name
 	currentData.Description = planData.Description
+	currentData.Timeouts = planData.Timeouts
 
 	_, err := r.dbaasInstances.UpdateSnapshot(ctx,
 		currentData.InstanceID.ValueString(),
@@ -167,7 +227,7 @@ func (r *DBaaSInstanceSnapshotResource) Update(ctx context.Context, req resource
 		},
 	)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -175,55 +235,55 @@ func (r *DBaaSInstanceSnapshotResource) Update(ctx context.Context, req resource
 }
 
 func (r *DBaaSInstanceSnapshotResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, dbaasInstancesSnapshotsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data DBaaSInstanceSnapshotModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.dbaasInstances.DeleteSnapshot(ctx, data.InstanceID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+func (r *DBaaSInstanceSnapshotResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = dbaasInstanceSnapshotIdentity.Schema()
+}
+
 func (r *DBaaSInstanceSnapshotResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ids := strings.Split(req.ID, ",")
-	if len(ids) != 2 {
-		resp.Diagnostics.AddError(
-			"Invalid import format",
-			"Format should be: instance_id,snapshot_id",
-		)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &DBaaSInstanceSnapshotModel{
+		Timeouts: utils.NullTimeouts(dbaasInstanceSnapshotTimeoutsOpts)})...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &DBaaSInstanceSnapshotModel{
-		InstanceID: types.StringValue(ids[0]),
-		ID:         types.StringValue(ids[1])})...)
+	dbaasInstanceSnapshotIdentity.ImportState(ctx, req, resp)
 }
 
-func (r *DBaaSInstanceSnapshotResource) waitUntilSnapshotStatusMatches(ctx context.Context, instanceID string, snapshotID string, status DBaaSInstanceSnapshotStatus) error {
-	timeoutCtx, cancel := context.WithTimeout(ctx, snapshotStatusTimeout)
-	defer cancel()
-
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return fmt.Errorf("timeout waiting for snapshot %s to reach status %s", snapshotID, status)
-		case <-time.After(10 * time.Second):
-			snapshot, err := r.dbaasInstances.GetSnapshot(ctx, instanceID, snapshotID)
+func waitUntilSnapshotStatusMatches(ctx context.Context, instances dbSDK.InstanceService, instanceID string, snapshotID string, status DBaaSInstanceSnapshotStatus, timeout time.Duration) error {
+	_, err := utils.StateWaiter[*dbSDK.SnapshotDetailResponse]{
+		Description: fmt.Sprintf("snapshot %s", snapshotID),
+		Target:      []string{status.String()},
+		Error:       []string{DBaaSInstanceSnapshotStatusError.String()},
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		MinInterval: 10 * time.Second,
+		Refresh: func(ctx context.Context) (*dbSDK.SnapshotDetailResponse, string, error) {
+			snapshot, err := instances.GetSnapshot(ctx, instanceID, snapshotID)
 			if err != nil {
-				return err
+				return nil, "", err
 			}
-
-			currentStatus := DBaaSInstanceSnapshotStatus(snapshot.Status)
-			if currentStatus == status {
-				return nil
-			}
-			if currentStatus == DBaaSInstanceSnapshotStatusError {
-				return fmt.Errorf("snapshot %s is in error state", snapshotID)
-			}
-		}
-	}
+			return snapshot, string(snapshot.Status), nil
+		},
+	}.Wait(ctx)
+	return err
 }
@@ -5,6 +5,7 @@ import (
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,25 +16,33 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasParameterGroupsTypeName = "mgc_dbaas_parameter_groups"
+
 type DBaaSParametersModel struct {
 	EngineName    types.String `tfsdk:"engine_name"`
 	EngineVersion types.String `tfsdk:"engine_version"`
 	Name          types.String `tfsdk:"name"`
 	Description   types.String `tfsdk:"description"`
 	ID            types.String `tfsdk:"id"`
+	Region        types.String `tfsdk:"region"`
 }
 
 type DBaaSParameterGroupsResource struct {
+	regional             utils.RegionalService
 	dbaasParameterGroups dbSDK.ParameterGroupService
 	dbaasEngines         dbSDK.EngineService
 }
 
+var dbaasParameterGroupIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the parameter group."),
+)
+
 func NewDBaaSParameterGroupsResource() resource.Resource {
 	return &DBaaSParameterGroupsResource{}
 }
 
-func (r *DBaaSParameterGroupsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_parameter_groups"
+func (r *DBaaSParameterGroupsResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = dbaasParameterGroupsTypeName
 }
 
 func (r *DBaaSParameterGroupsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -46,14 +55,17 @@ func (r *DBaaSParameterGroupsResource) Configure(ctx context.Context, req resour
 		return
 	}
 
-	r.dbaasParameterGroups = dbSDK.New(&dataConfig.CoreConfig).ParametersGroup()
-	r.dbaasEngines = dbSDK.New(&dataConfig.CoreConfig).Engines()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.dbaasParameterGroups = dbSDK.New(&cfg.CoreConfig).ParametersGroup()
+		r.dbaasEngines = catalogEngines{dbSDK.New(&cfg.CoreConfig).Engines(), cfg.Catalog()}
+	})
 }
 
 func (r *DBaaSParameterGroupsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a DBaaS parameters groups",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the parameters group",
 				Computed:    true,
@@ -94,12 +106,20 @@ func (r *DBaaSParameterGroupsResource) Schema(_ context.Context, _ resource.Sche
 }
 
 func (r *DBaaSParameterGroupsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, dbaasParameterGroupsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer dbaasParameterGroupIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSParametersModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	engineID, err := ValidateAndGetEngineID(ctx, r.dbaasEngines.ListAll, data.EngineName.ValueString(), data.EngineVersion.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Invalid engine name", err.Error())
@@ -112,7 +132,7 @@ func (r *DBaaSParameterGroupsResource) Create(ctx context.Context, req resource.
 		Description: data.Description.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -121,21 +141,33 @@ func (r *DBaaSParameterGroupsResource) Create(ctx context.Context, req resource.
 }
 
 func (r *DBaaSParameterGroupsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, dbaasParameterGroupsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer dbaasParameterGroupIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSParametersModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	p, err := r.dbaasParameterGroups.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	engineName, engineVersion, err := GetEngineNameAndVersionByID(ctx, r.dbaasEngines.Get, p.EngineID)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -147,6 +179,9 @@ func (r *DBaaSParameterGroupsResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *DBaaSParameterGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, dbaasParameterGroupsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer dbaasParameterGroupIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSParametersModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -159,6 +194,11 @@ func (r *DBaaSParameterGroupsResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&currentData.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	currentData.Description = data.Description
 	currentData.Name = data.Name
 	_, err := r.dbaasParameterGroups.Update(ctx, currentData.ID.ValueString(), dbSDK.ParameterGroupUpdateRequest{
@@ -166,26 +206,37 @@ func (r *DBaaSParameterGroupsResource) Update(ctx context.Context, req resource.
 		Description: data.Description.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &currentData)...)
 }
 
 func (r *DBaaSParameterGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, dbaasParameterGroupsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data DBaaSParametersModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.dbaasParameterGroups.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+func (r *DBaaSParameterGroupsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = dbaasParameterGroupIdentity.Schema()
+}
+
 func (r *DBaaSParameterGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.Set(ctx, &DBaaSParametersModel{ID: types.StringValue(req.ID)})...)
+	dbaasParameterGroupIdentity.ImportState(ctx, req, resp)
 }
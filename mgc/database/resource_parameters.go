@@ -2,9 +2,9 @@ package database
 
 import (
 	"context"
-	"strings"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -14,23 +14,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasParametersTypeName = "mgc_dbaas_parameters"
+
 type DBaaSParameterModel struct {
 	ParameterGroupID types.String  `tfsdk:"parameter_group_id"`
 	ID               types.String  `tfsdk:"id"`
 	Name             types.String  `tfsdk:"name"`
 	Value            types.Dynamic `tfsdk:"value"`
+	Region           types.String  `tfsdk:"region"`
 }
 
 type DBaaSParameterResource struct {
+	regional        utils.RegionalService
 	dbaasParameters dbSDK.ParameterService
 }
 
+var dbaasParameterIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "parameter_group_id", Description: "ID of the parameter group."},
+	utils.IdentityAttribute{Name: "parameter_id", Description: "ID of the parameter.", StateAttribute: "id"},
+)
+
 func NewDBaaSParameterResource() resource.Resource {
 	return &DBaaSParameterResource{}
 }
 
-func (r *DBaaSParameterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_parameters"
+func (r *DBaaSParameterResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = dbaasParametersTypeName
 }
 
 func (r *DBaaSParameterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -42,13 +51,16 @@ func (r *DBaaSParameterResource) Configure(ctx context.Context, req resource.Con
 		resp.Diagnostics.AddError("Invalid provider data", "expected utils.DataConfig")
 		return
 	}
-	r.dbaasParameters = dbSDK.New(&cfg.CoreConfig).Parameters()
+	r.regional = utils.NewRegionalService(cfg, func(cfg utils.DataConfig) {
+		r.dbaasParameters = dbSDK.New(&cfg.CoreConfig).Parameters()
+	})
 }
 
 func (r *DBaaSParameterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a DBaaS parameter",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Unique identifier for the parameter",
 				Computed:    true,
@@ -76,12 +88,20 @@ func (r *DBaaSParameterResource) Schema(_ context.Context, _ resource.SchemaRequ
 }
 
 func (r *DBaaSParameterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, dbaasParametersTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer dbaasParameterIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSParameterModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	s, err := utils.DynamicToGo[any](data.Value)
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid attribute type", "Invalid attribute type for field `value`, allowed values are [string, bool and numbers]")
@@ -93,7 +113,7 @@ func (r *DBaaSParameterResource) Create(ctx context.Context, req resource.Create
 		Value: s,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	data.ID = types.StringValue(created.ID)
@@ -101,16 +121,29 @@ func (r *DBaaSParameterResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *DBaaSParameterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, dbaasParametersTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer dbaasParameterIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSParameterModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	all, err := r.dbaasParameters.ListAll(ctx, dbSDK.ParameterFilterOptions{
 		ParameterGroupID: data.ParameterGroupID.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	var found *dbSDK.ParameterDetailResponse
@@ -137,6 +170,9 @@ func (r *DBaaSParameterResource) Read(ctx context.Context, req resource.ReadRequ
 }
 
 func (r *DBaaSParameterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, dbaasParametersTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer dbaasParameterIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSParameterModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -149,6 +185,11 @@ func (r *DBaaSParameterResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&currentData.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	s, err := utils.DynamicToGo[any](data.Value)
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid attribute type", "Invalid attribute type for field `value`, allowed values are [string, bool and numbers]")
@@ -160,30 +201,36 @@ func (r *DBaaSParameterResource) Update(ctx context.Context, req resource.Update
 		Value: s,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &currentData)...)
 }
 
 func (r *DBaaSParameterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, dbaasParametersTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data DBaaSParameterModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.dbaasParameters.Delete(ctx, data.ParameterGroupID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 	}
 }
 
+func (r *DBaaSParameterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = dbaasParameterIdentity.Schema()
+}
+
 func (r *DBaaSParameterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.SplitN(req.ID, ",", 2)
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid import format", "Use `<parameter_group_id>,<parameter_id>`")
-		return
-	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parameter_group_id"), types.StringValue(parts[0]))...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(parts[1]))...)
+	dbaasParameterIdentity.ImportState(ctx, req, resp)
 }
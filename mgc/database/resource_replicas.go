@@ -3,15 +3,13 @@ package database
 import (
 	"context"
 	"fmt"
-	"net/http"
-	"strconv"
-	"strings"
 	"time"
 
 	dbSDK "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,33 +18,42 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const dbaasReplicasTypeName = "mgc_dbaas_replicas"
+
 const (
 	dbaasReplicaProductFamily = "SINGLE_INSTANCE_REPLICA"
 	poolingWaitInterval       = 10 * time.Second
 )
 
 type DBaaSReplicaModel struct {
-	ID           types.String `tfsdk:"id"`
-	SourceID     types.String `tfsdk:"source_id"`
-	Name         types.String `tfsdk:"name"`
-	EngineID     types.String `tfsdk:"engine_id"`
-	InstanceType types.String `tfsdk:"instance_type"`
-	VolumeSize   types.Int64  `tfsdk:"volume_size"`
-	Status       types.String `tfsdk:"status"`
+	ID           types.String   `tfsdk:"id"`
+	SourceID     types.String   `tfsdk:"source_id"`
+	Name         types.String   `tfsdk:"name"`
+	EngineID     types.String   `tfsdk:"engine_id"`
+	InstanceType types.String   `tfsdk:"instance_type"`
+	VolumeSize   types.Int64    `tfsdk:"volume_size"`
+	Status       types.String   `tfsdk:"status"`
+	Region       types.String   `tfsdk:"region"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
 }
 
 type DBaaSReplicaResource struct {
+	regional           utils.RegionalService
 	dbaasReplicas      dbSDK.ReplicaService
 	dbaasInstances     dbSDK.InstanceService
 	dbaasInstanceTypes dbSDK.InstanceTypeService
 }
 
+var dbaasReplicaIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the DBaaS replica."),
+)
+
 func NewDBaaSReplicaResource() resource.Resource {
 	return &DBaaSReplicaResource{}
 }
 
-func (r *DBaaSReplicaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_dbaas_replicas"
+func (r *DBaaSReplicaResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = dbaasReplicasTypeName
 }
 
 func (r *DBaaSReplicaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -58,15 +65,18 @@ func (r *DBaaSReplicaResource) Configure(_ context.Context, req resource.Configu
 		resp.Diagnostics.AddError("invalid provider data", "expected utils.DataConfig")
 		return
 	}
-	r.dbaasReplicas = dbSDK.New(&cfg.CoreConfig).Replicas()
-	r.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
-	r.dbaasInstanceTypes = dbSDK.New(&cfg.CoreConfig).InstanceTypes()
+	r.regional = utils.NewRegionalService(cfg, func(cfg utils.DataConfig) {
+		r.dbaasReplicas = dbSDK.New(&cfg.CoreConfig).Replicas()
+		r.dbaasInstances = dbSDK.New(&cfg.CoreConfig).Instances()
+		r.dbaasInstanceTypes = catalogInstanceTypes{dbSDK.New(&cfg.CoreConfig).InstanceTypes(), cfg.Catalog()}
+	})
 }
 
-func (r *DBaaSReplicaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *DBaaSReplicaResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a DBaaS replica",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "Replica ID",
@@ -107,28 +117,45 @@ func (r *DBaaSReplicaResource) Schema(_ context.Context, _ resource.SchemaReques
 				Description: "Replica status",
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
 	}
 }
 
 func (r *DBaaSReplicaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, dbaasReplicasTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer dbaasReplicaIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSReplicaModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, instanceStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var ptrTypeID *string
 	if !data.InstanceType.IsNull() && data.InstanceType.ValueString() != "" {
 		sourceData, err := r.dbaasInstances.Get(ctx, data.SourceID.ValueString(), dbSDK.GetInstanceOptions{})
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 
 		instanceTypeID, err := ValidateAndGetInstanceTypeID(ctx, r.dbaasInstanceTypes.ListAll, data.InstanceType.ValueString(),
 			sourceData.EngineID, dbaasReplicaProductFamily)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 
@@ -141,16 +168,17 @@ func (r *DBaaSReplicaResource) Create(ctx context.Context, req resource.CreateRe
 		InstanceTypeID: ptrTypeID,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	data.ID = types.StringValue(created.ID)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	partial := map[string]string{"region": data.Region.ValueString(), "id": created.ID}
 
-	found, err := r.waitUntilReplicaStatusMatches(ctx, created.ID, string(dbSDK.InstanceStatusActive))
+	found, err := r.waitUntilReplicaStatusMatches(ctx, created.ID, string(dbSDK.InstanceStatusActive), createTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Error waiting for replica to be active", err.Error())
+		dbaasReplicaIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 		return
 	}
 
@@ -161,7 +189,8 @@ func (r *DBaaSReplicaResource) Create(ctx context.Context, req resource.CreateRe
 
 	instanceTypeName, err := GetInstanceTypeNameByID(ctx, r.dbaasInstanceTypes.Get, found.InstanceTypeID)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		dbaasReplicaIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 		return
 	}
 
@@ -171,15 +200,27 @@ func (r *DBaaSReplicaResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *DBaaSReplicaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, dbaasReplicasTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer dbaasReplicaIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data DBaaSReplicaModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	detail, err := r.dbaasReplicas.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -190,7 +231,7 @@ func (r *DBaaSReplicaResource) Read(ctx context.Context, req resource.ReadReques
 
 	instanceType, err := r.dbaasInstanceTypes.Get(ctx, detail.InstanceTypeID)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -200,6 +241,9 @@ func (r *DBaaSReplicaResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *DBaaSReplicaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, dbaasReplicasTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer dbaasReplicaIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var planData DBaaSReplicaModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
 	if resp.Diagnostics.HasError() {
@@ -212,6 +256,18 @@ func (r *DBaaSReplicaResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&stateData.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := planData.Timeouts.Update(ctx, instanceStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	stateData.Timeouts = planData.Timeouts
+
 	var hasResizeUpdate bool
 	var replicaResizeRequest dbSDK.ReplicaResizeRequest
 
@@ -220,7 +276,7 @@ func (r *DBaaSReplicaResource) Update(ctx context.Context, req resource.UpdateRe
 			ctx, r.dbaasInstanceTypes.ListAll, planData.InstanceType.ValueString(), stateData.EngineID.ValueString(), dbaasReplicaProductFamily,
 		)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 		stateData.InstanceType = planData.InstanceType
@@ -239,11 +295,11 @@ func (r *DBaaSReplicaResource) Update(ctx context.Context, req resource.UpdateRe
 	if hasResizeUpdate {
 		_, err := r.dbaasReplicas.Resize(ctx, stateData.ID.ValueString(), replicaResizeRequest)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 
-		if _, err := r.waitUntilReplicaStatusMatches(ctx, stateData.ID.ValueString(), DBaaSInstanceStatusActive.String()); err != nil {
+		if _, err := r.waitUntilReplicaStatusMatches(ctx, stateData.ID.ValueString(), DBaaSInstanceStatusActive.String(), updateTimeout); err != nil {
 			resp.Diagnostics.AddError("Error waiting for replica to be active", err.Error())
 			return
 		}
@@ -253,81 +309,95 @@ func (r *DBaaSReplicaResource) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *DBaaSReplicaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, dbaasReplicasTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data DBaaSReplicaModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, instanceStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := r.dbaasReplicas.Delete(ctx, data.ID.ValueString()); err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 	}
 
 	instanceID := data.ID.ValueString()
 	replica, err := r.dbaasReplicas.Get(ctx, instanceID)
 
-	if err != nil && strings.Contains(err.Error(), strconv.Itoa(http.StatusNotFound)) {
+	if utils.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	if DBaaSInstanceStatus(replica.Status) != DBaaSInstanceStatusDeleting {
 		err := r.dbaasInstances.Delete(ctx, string(instanceID))
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		}
 	}
 
-	if _, err := r.waitUntilReplicaIsDeleted(ctx, instanceID); err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+	if _, err := r.waitUntilReplicaIsDeleted(ctx, instanceID, deleteTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+func (r *DBaaSReplicaResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = dbaasReplicaIdentity.Schema()
+}
+
 func (r *DBaaSReplicaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(req.ID))...)
+	dbaasReplicaIdentity.ImportState(ctx, req, resp)
 }
 
-func (r *DBaaSReplicaResource) waitUntilReplicaStatusMatches(ctx context.Context, instanceID string, status string) (*dbSDK.ReplicaDetailResponse, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, instanceStatusTimeout)
-	defer cancel()
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for replica %s to reach status %s", instanceID, status)
-		case <-time.After(poolingWaitInterval):
-			instance, err := r.dbaasReplicas.Get(ctx, instanceID)
+func (r *DBaaSReplicaResource) waitUntilReplicaStatusMatches(ctx context.Context, instanceID string, status string, timeout time.Duration) (*dbSDK.ReplicaDetailResponse, error) {
+	return utils.StateWaiter[*dbSDK.ReplicaDetailResponse]{
+		Description: fmt.Sprintf("replica %s", instanceID),
+		Target:      []string{status},
+		IsError:     func(state string) bool { return DBaaSInstanceStatus(state).IsAnyError() },
+		Timeout:     timeout,
+		Delay:       poolingWaitInterval,
+		MinInterval: poolingWaitInterval,
+		Refresh: func(ctx context.Context) (*dbSDK.ReplicaDetailResponse, string, error) {
+			replica, err := r.dbaasReplicas.Get(ctx, instanceID)
 			if err != nil {
-				return nil, err
+				return nil, "", err
 			}
-			currentStatus := DBaaSInstanceStatus(instance.Status)
-			if currentStatus.String() == status {
-				return instance, nil
-			}
-			if currentStatus.IsAnyError() {
-				return nil, fmt.Errorf("replica %s is in error status %s", instanceID, string(currentStatus))
-			}
-		}
-	}
+			return replica, string(replica.Status), nil
+		},
+	}.Wait(ctx)
 }
 
-func (r *DBaaSReplicaResource) waitUntilReplicaIsDeleted(ctx context.Context, instanceID string) (*dbSDK.ReplicaDetailResponse, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, instanceStatusTimeout)
-	defer cancel()
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for instance %s to be deleted", instanceID)
-		case <-time.After(poolingWaitInterval):
+func (r *DBaaSReplicaResource) waitUntilReplicaIsDeleted(ctx context.Context, instanceID string, timeout time.Duration) (*dbSDK.ReplicaDetailResponse, error) {
+	return utils.StateWaiter[*dbSDK.ReplicaDetailResponse]{
+		Description: fmt.Sprintf("replica %s", instanceID),
+		Target:      []string{DBaaSInstanceStatusDeleted.String()},
+		IsError:     func(state string) bool { return DBaaSInstanceStatus(state).IsAnyError() },
+		Timeout:     timeout,
+		Delay:       poolingWaitInterval,
+		MinInterval: poolingWaitInterval,
+		Refresh: func(ctx context.Context) (*dbSDK.ReplicaDetailResponse, string, error) {
 			replica, err := r.dbaasReplicas.Get(ctx, instanceID)
-			if err != nil && strings.Contains(err.Error(), strconv.Itoa(http.StatusNotFound)) {
-				return nil, nil
+			if utils.IsNotFound(err) {
+				return nil, DBaaSInstanceStatusDeleted.String(), nil
 			}
 			if err != nil {
-				return nil, err
-			}
-			currentStatus := DBaaSInstanceStatus(replica.Status)
-			if currentStatus.IsAnyError() {
-				return nil, fmt.Errorf("replica %s is in error status %s", instanceID, string(currentStatus))
+				return nil, "", err
 			}
-		}
-	}
+			return replica, string(replica.Status), nil
+		},
+	}.Wait(ctx)
 }
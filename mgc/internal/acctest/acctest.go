@@ -0,0 +1,72 @@
+// Package acctest runs the provider acceptance tests against the fake API in
+// internal/fakeapi, so they need a terraform binary but no cloud account.
+// Like every terraform-plugin-testing test, they only run with TF_ACC set.
+package acctest
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+)
+
+// ProviderFactories serves the provider in process.
+var ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"mgc": providerserver.NewProtocol6WithError(mgc.New("test")()),
+}
+
+// EchoProviderFactories also serves the echo provider, whose echo resource
+// stores the provider data attribute, so tests can check the values of
+// ephemeral resources that never reach the state otherwise.
+var EchoProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"mgc":  ProviderFactories["mgc"],
+	"echo": echoprovider.NewProviderServer(),
+}
+
+// TerraformVersionChecks skips the tests on terraform versions without
+// write-only attributes and resource identity, which the resources use.
+var TerraformVersionChecks = []tfversion.TerraformVersionCheck{
+	tfversion.SkipBelow(tfversion.Version1_12_0),
+}
+
+// NewServer starts a fake API closed when the test finishes.
+func NewServer(t *testing.T) *fakeapi.Server {
+	t.Helper()
+	srv := fakeapi.NewServer()
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// Config prefixes config with a provider block sending every request to srv.
+func Config(srv *fakeapi.Server, config string) string {
+	return fmt.Sprintf(`
+provider "mgc" {
+  api_key      = %q
+  region       = %q
+  api_endpoint = %q
+}
+`, apiKey, fakeapi.Region, srv.URL) + config
+}
+
+// CheckDestroy fails when an object of one of the resource types in the state
+// is still stored in srv.
+func CheckDestroy(srv *fakeapi.Server, resourceTypes ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for name, rs := range s.RootModule().Resources {
+			for _, resourceType := range resourceTypes {
+				if rs.Type == resourceType && srv.Exists(rs.Primary.ID) {
+					return fmt.Errorf("%s %s still exists", name, rs.Primary.ID)
+				}
+			}
+		}
+		return nil
+	}
+}
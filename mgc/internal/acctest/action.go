@@ -0,0 +1,153 @@
+package acctest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	sdk "github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+)
+
+const apiKey = "00000000-0000-4000-8000-000000000000"
+
+// Client returns an SDK client sending every request to srv, to set up the
+// objects an action acts on and check what it did.
+func Client(srv *fakeapi.Server) *sdk.CoreClient {
+	return sdk.NewMgcClient(
+		sdk.WithAPIKey(apiKey),
+		sdk.WithBaseURL(sdk.MgcUrl(srv.URL)),
+		sdk.WithRetryConfig(1, sdk.DefaultInitialInterval, sdk.DefaultMaxInterval, sdk.DefaultBackoffFactor),
+	)
+}
+
+// InvokeAction validates and invokes the action typeName with config, as
+// terraform apply -invoke does, with the provider configured as in Config.
+// Terraform runs actions from 1.14 on, newer than the binaries the other
+// acceptance tests need, so the provider is called through the protocol.
+//
+// It returns the progress messages of the action and the error diagnostics,
+// joined. Attributes missing from config are null.
+func InvokeAction(t *testing.T, srv *fakeapi.Server, typeName string, config map[string]any) ([]string, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	providerServer, schemas := configuredServer(t, srv)
+	// The action RPCs are not part of tfprotov6.ProviderServer yet.
+	server, ok := providerServer.(tfprotov6.ProviderServerWithActions)
+	if !ok {
+		t.Fatal("the provider server does not serve actions")
+	}
+	actionSchema, ok := schemas.ActionSchemas[typeName]
+	if !ok {
+		t.Fatalf("action %s is not registered", typeName)
+	}
+
+	actionConfig := dynamicValue(t, actionSchema.Schema, config)
+	validated, err := server.ValidateActionConfig(ctx, &tfprotov6.ValidateActionConfigRequest{
+		ActionType: typeName,
+		Config:     actionConfig,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := diagnosticsError(validated.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	stream, err := server.InvokeAction(ctx, &tfprotov6.InvokeActionRequest{
+		ActionType: typeName,
+		Config:     actionConfig,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		progress []string
+		diags    []*tfprotov6.Diagnostic
+	)
+	for event := range stream.Events {
+		switch e := event.Type.(type) {
+		case tfprotov6.ProgressInvokeActionEventType:
+			progress = append(progress, e.Message)
+		case tfprotov6.CompletedInvokeActionEventType:
+			diags = e.Diagnostics
+		}
+	}
+	return progress, diagnosticsError(diags)
+}
+
+// configuredServer returns the provider server configured as in Config, and
+// its schemas. It skips the test unless acceptance tests are enabled.
+func configuredServer(t *testing.T, srv *fakeapi.Server) (tfprotov6.ProviderServer, *tfprotov6.GetProviderSchemaResponse) {
+	t.Helper()
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance tests skipped unless env '%s' set", resource.EnvTfAcc)
+	}
+	ctx := context.Background()
+
+	server, err := ProviderFactories["mgc"]()
+	if err != nil {
+		t.Fatal(err)
+	}
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	providerConfig := dynamicValue(t, schemas.Provider, map[string]any{
+		"api_key":      apiKey,
+		"region":       fakeapi.Region,
+		"api_endpoint": srv.URL,
+	})
+	configured, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		TerraformVersion: "1.14.0",
+		Config:           providerConfig,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := diagnosticsError(configured.Diagnostics); err != nil {
+		t.Fatalf("configuring the provider: %s", err)
+	}
+	return server, schemas
+}
+
+// dynamicValue encodes values as an object of schema, leaving the other
+// attributes and blocks null.
+func dynamicValue(t *testing.T, schema *tfprotov6.Schema, values map[string]any) *tfprotov6.DynamicValue {
+	t.Helper()
+	objectType := schema.ValueType().(tftypes.Object)
+
+	attributes := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attributeType := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(attributeType, values[name])
+	}
+	for name := range values {
+		if _, ok := objectType.AttributeTypes[name]; !ok {
+			t.Fatalf("unknown attribute %q", name)
+		}
+	}
+
+	value, err := tfprotov6.NewDynamicValue(objectType, tftypes.NewValue(objectType, attributes))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &value
+}
+
+func diagnosticsError(diags []*tfprotov6.Diagnostic) error {
+	var errs []error
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			errs = append(errs, fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+	return errors.Join(errs...)
+}
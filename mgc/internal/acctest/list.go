@@ -0,0 +1,77 @@
+package acctest
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+)
+
+// ListResources validates the list resource typeName with config and lists
+// including the resources, as terraform query -generate-config-out does, with
+// the provider configured as in Config. The terraform-plugin-testing version
+// in use has no query steps, so the provider is called through the protocol.
+//
+// It returns the attributes of each listed resource and the error
+// diagnostics, joined. Attributes missing from config are null.
+func ListResources(t *testing.T, srv *fakeapi.Server, typeName string, config map[string]any) ([]map[string]tftypes.Value, error) {
+	t.Helper()
+	ctx := context.Background()
+
+	providerServer, schemas := configuredServer(t, srv)
+	// The list resource RPCs are not part of tfprotov6.ProviderServer yet.
+	server, ok := providerServer.(tfprotov6.ProviderServerWithListResource)
+	if !ok {
+		t.Fatal("the provider server does not serve list resources")
+	}
+	listSchema, ok := schemas.ListResourceSchemas[typeName]
+	if !ok {
+		t.Fatalf("list resource %s is not registered", typeName)
+	}
+	resourceType := schemas.ResourceSchemas[typeName].ValueType()
+
+	listConfig := dynamicValue(t, listSchema, config)
+	validated, err := server.ValidateListResourceConfig(ctx, &tfprotov6.ValidateListResourceConfigRequest{
+		TypeName: typeName,
+		Config:   listConfig,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := diagnosticsError(validated.Diagnostics); err != nil {
+		return nil, err
+	}
+
+	stream, err := server.ListResource(ctx, &tfprotov6.ListResourceRequest{
+		TypeName:        typeName,
+		Config:          listConfig,
+		IncludeResource: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var (
+		resources []map[string]tftypes.Value
+		errs      []error
+	)
+	for result := range stream.Results {
+		if err := diagnosticsError(result.Diagnostics); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		value, err := result.Resource.Unmarshal(resourceType)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var attributes map[string]tftypes.Value
+		if err := value.As(&attributes); err != nil {
+			t.Fatal(err)
+		}
+		resources = append(resources, attributes)
+	}
+	return resources, errors.Join(errs...)
+}
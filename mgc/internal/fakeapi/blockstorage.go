@@ -0,0 +1,216 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	storageSdk "github.com/MagaluCloud/mgc-sdk-go/blockstorage"
+)
+
+type storageState struct {
+	volumeTypes []storageSdk.VolumeType
+	volumes     *collection[storageSdk.Volume]
+	snapshots   *collection[storageSdk.Snapshot]
+}
+
+func (s *Server) seedStorage() {
+	zones := []string{AvailabilityZone}
+	s.storage.volumeTypes = []storageSdk.VolumeType{
+		{ID: s.newID(), Name: VolumeType, DiskType: "nvme", Status: "active", AvailabilityZones: zones, AllowsEncryption: true,
+			IOPS: storageSdk.VolumeTypeIOPS{Read: 1000, Write: 1000, Total: 2000}},
+		{ID: s.newID(), Name: VolumeTypeFast, DiskType: "nvme", Status: "active", AvailabilityZones: zones, AllowsEncryption: true,
+			IOPS: storageSdk.VolumeTypeIOPS{Read: 5000, Write: 5000, Total: 10000}},
+	}
+	s.storage.volumes = newCollection(s, func(v *storageSdk.Volume, status string) { v.Status = status })
+	s.storage.snapshots = newCollection(s, func(v *storageSdk.Snapshot, status string) { v.Status = storageSdk.SnapshotStatusV1(status) })
+}
+
+func (s *Server) registerStorage(mux *http.ServeMux) {
+	mux.HandleFunc("GET /volume/v1/volume-types", s.listVolumeTypes)
+	mux.HandleFunc("GET /volume/v1/volumes", s.listVolumes)
+	mux.HandleFunc("POST /volume/v1/volumes", s.createVolume)
+	mux.HandleFunc("GET /volume/v1/volumes/{id}", s.getVolume)
+	mux.HandleFunc("DELETE /volume/v1/volumes/{id}", s.deleteVolume)
+	mux.HandleFunc("PATCH /volume/v1/volumes/{id}/rename", s.renameVolume)
+	mux.HandleFunc("POST /volume/v1/volumes/{id}/extend", s.extendVolume)
+	mux.HandleFunc("POST /volume/v1/volumes/{id}/retype", s.retypeVolume)
+	mux.HandleFunc("POST /volume/v1/snapshots", s.createSnapshot)
+	mux.HandleFunc("GET /volume/v1/snapshots/{id}", s.getSnapshot)
+}
+
+func storageMeta(p page) storageSdk.Metadata {
+	return storageSdk.Metadata{Page: storageSdk.PageMetadata{
+		Offset: p.offset, Limit: p.limit, Count: p.count, Total: p.total, MaxLimit: defaultPageLimit,
+	}}
+}
+
+func (s *Server) listVolumeTypes(w http.ResponseWriter, r *http.Request) {
+	items, p := paginate(r, s.storage.volumeTypes)
+	writeJSON(w, http.StatusOK, storageSdk.ListVolumeTypesResponse{Types: items, Meta: storageMeta(p)})
+}
+
+func (s *Server) findVolumeType(ref storageSdk.IDOrName) (storageSdk.Type, bool) {
+	for _, t := range s.storage.volumeTypes {
+		if (ref.ID != nil && *ref.ID == t.ID) || (ref.Name != nil && *ref.Name == t.Name) {
+			return storageSdk.Type{
+				ID:       t.ID,
+				Name:     ptr(t.Name),
+				DiskType: ptr(t.DiskType),
+				Status:   ptr(t.Status),
+				Iops:     &storageSdk.Iops{Read: t.IOPS.Read, Write: t.IOPS.Write, Total: t.IOPS.Total},
+			}, true
+		}
+	}
+	return storageSdk.Type{}, false
+}
+
+func (s *Server) listVolumes(w http.ResponseWriter, r *http.Request) {
+	items, p := paginate(r, s.storage.volumes.list())
+	writeJSON(w, http.StatusOK, storageSdk.ListVolumesResponse{Volumes: items, Meta: storageMeta(p)})
+}
+
+func (s *Server) createVolume(w http.ResponseWriter, r *http.Request) {
+	var req storageSdk.CreateVolumeRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	volumeType, ok := s.findVolumeType(req.Type)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "volume type not found")
+		return
+	}
+	if req.Size < 10 {
+		writeError(w, http.StatusBadRequest, "size must be at least 10 GB")
+		return
+	}
+
+	zone := AvailabilityZone
+	if req.AvailabilityZone != nil {
+		zone = *req.AvailabilityZone
+	}
+	encrypted := req.Encrypted
+	if encrypted == nil {
+		encrypted = ptr(false)
+	}
+
+	id := s.newID()
+	now := s.now()
+	s.storage.volumes.insert(id, storageSdk.Volume{
+		ID:                id,
+		Name:              req.Name,
+		Size:              req.Size,
+		State:             "available",
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		Type:              volumeType,
+		AvailabilityZone:  zone,
+		AvailabilityZones: []string{zone},
+		Encrypted:         encrypted,
+	}, "creating", s.settle(req.Name, "completed", "error"))
+	writeJSON(w, http.StatusOK, map[string]string{"id": id})
+}
+
+func (s *Server) getVolume(w http.ResponseWriter, r *http.Request) {
+	volume, ok := s.storage.volumes.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "volume", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, volume)
+}
+
+func (s *Server) deleteVolume(w http.ResponseWriter, r *http.Request) {
+	if !s.storage.volumes.delete(r.PathValue("id"), "deleting") {
+		notFound(w, "volume", r.PathValue("id"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) renameVolume(w http.ResponseWriter, r *http.Request) {
+	var req storageSdk.RenameVolumeRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if _, ok := s.storage.volumes.update(r.PathValue("id"), func(v *storageSdk.Volume) { v.Name = req.Name }); !ok {
+		notFound(w, "volume", r.PathValue("id"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) extendVolume(w http.ResponseWriter, r *http.Request) {
+	var req storageSdk.ExtendVolumeRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	volume, ok := s.storage.volumes.peek(r.PathValue("id"))
+	if !ok {
+		notFound(w, "volume", r.PathValue("id"))
+		return
+	}
+	if req.Size <= volume.Size {
+		writeError(w, http.StatusBadRequest, "volumes can only be extended, from %d GB", volume.Size)
+		return
+	}
+
+	s.storage.volumes.update(r.PathValue("id"), func(v *storageSdk.Volume) { v.Size = req.Size }, "extending", "completed")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) retypeVolume(w http.ResponseWriter, r *http.Request) {
+	var req storageSdk.RetypeVolumeRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	volumeType, ok := s.findVolumeType(req.NewType)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "volume type not found")
+		return
+	}
+	if _, ok := s.storage.volumes.update(r.PathValue("id"), func(v *storageSdk.Volume) { v.Type = volumeType }, "retyping", "completed"); !ok {
+		notFound(w, "volume", r.PathValue("id"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) createSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req storageSdk.CreateSnapshotRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.Volume == nil || req.Volume.ID == nil {
+		writeError(w, http.StatusBadRequest, "volume id is required")
+		return
+	}
+	volume, ok := s.storage.volumes.peek(*req.Volume.ID)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "volume %s not found", *req.Volume.ID)
+		return
+	}
+
+	id := s.newID()
+	now := s.now()
+	s.storage.snapshots.insert(id, storageSdk.Snapshot{
+		ID:                id,
+		Name:              req.Name,
+		Size:              volume.Size,
+		Description:       req.Description,
+		State:             storageSdk.SnapshotStateAvailable,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+		Volume:            &storageSdk.IDOrName{ID: ptr(volume.ID), Name: ptr(volume.Name)},
+		AvailabilityZones: volume.AvailabilityZones,
+		Type:              valueOr(req.Type, "instant"),
+	}, string(storageSdk.SnapshotStatusCreating), s.settle(req.Name, string(storageSdk.SnapshotStatusCompleted), string(storageSdk.SnapshotStatusCreatingError)))
+	writeJSON(w, http.StatusOK, map[string]string{"id": id})
+}
+
+func (s *Server) getSnapshot(w http.ResponseWriter, r *http.Request) {
+	snapshot, ok := s.storage.snapshots.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "snapshot", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, snapshot)
+}
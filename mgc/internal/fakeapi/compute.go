@@ -0,0 +1,217 @@
+package fakeapi
+
+import (
+	"fmt"
+	"net/http"
+
+	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+)
+
+type computeState struct {
+	machineTypes []computeSdk.InstanceType
+	images       []computeSdk.Image
+	instances    *collection[computeSdk.Instance]
+}
+
+func (s *Server) seedCompute() {
+	s.compute.machineTypes = []computeSdk.InstanceType{
+		{ID: s.newID(), Name: MachineType, VCPUs: 1, RAM: 1024, Disk: 40, Status: "active"},
+		{ID: s.newID(), Name: MachineTypeLarge, VCPUs: 2, RAM: 2048, Disk: 40, Status: "active"},
+	}
+	s.compute.images = []computeSdk.Image{
+		{ID: s.newID(), Name: Image, Status: computeSdk.ImageStatusActive, Platform: ptr("linux")},
+	}
+	s.compute.instances = newCollection(s, func(i *computeSdk.Instance, status string) { i.Status = status })
+}
+
+func (s *Server) registerCompute(mux *http.ServeMux) {
+	mux.HandleFunc("GET /compute/v1/instance-types", s.listMachineTypes)
+	mux.HandleFunc("GET /compute/v1/images", s.listImages)
+	mux.HandleFunc("GET /compute/v1/instances", s.listInstances)
+	mux.HandleFunc("POST /compute/v1/instances", s.createInstance)
+	mux.HandleFunc("GET /compute/v1/instances/{id}", s.getInstance)
+	mux.HandleFunc("DELETE /compute/v1/instances/{id}", s.deleteInstance)
+	mux.HandleFunc("PATCH /compute/v1/instances/{id}/rename", s.renameInstance)
+	mux.HandleFunc("POST /compute/v1/instances/{id}/retype", s.retypeInstance)
+	mux.HandleFunc("POST /compute/v1/instances/{id}/start", s.powerInstance("starting", "running"))
+	mux.HandleFunc("POST /compute/v1/instances/{id}/stop", s.powerInstance("stopping", "stopped"))
+	mux.HandleFunc("POST /compute/v1/instances/{id}/suspend", s.powerInstance("suspending", "suspended"))
+}
+
+func computeMeta(p page) computeSdk.Meta {
+	return computeSdk.Meta{Page: computeSdk.Page{Offset: p.offset, Limit: p.limit, Count: p.count, Total: p.total}}
+}
+
+func (s *Server) listMachineTypes(w http.ResponseWriter, r *http.Request) {
+	items, p := paginate(r, s.compute.machineTypes)
+	writeJSON(w, http.StatusOK, computeSdk.InstanceTypeList{InstanceTypes: items, Meta: computeMeta(p)})
+}
+
+func (s *Server) listImages(w http.ResponseWriter, r *http.Request) {
+	items, p := paginate(r, s.compute.images)
+	writeJSON(w, http.StatusOK, computeSdk.ImageList{Images: items, Meta: computeMeta(p)})
+}
+
+func (s *Server) findMachineType(ref computeSdk.IDOrName) (computeSdk.InstanceType, bool) {
+	for _, t := range s.compute.machineTypes {
+		if (ref.ID != nil && *ref.ID == t.ID) || (ref.Name != nil && *ref.Name == t.Name) {
+			return t, true
+		}
+	}
+	return computeSdk.InstanceType{}, false
+}
+
+func instanceMachineType(t computeSdk.InstanceType) *computeSdk.InstanceTypes {
+	return &computeSdk.InstanceTypes{ID: t.ID, Name: ptr(t.Name), Vcpus: ptr(t.VCPUs), Ram: ptr(t.RAM), Disk: ptr(t.Disk)}
+}
+
+func (s *Server) findImage(ref computeSdk.IDOrName) (computeSdk.Image, bool) {
+	for _, image := range s.compute.images {
+		if (ref.ID != nil && *ref.ID == image.ID) || (ref.Name != nil && *ref.Name == image.Name) {
+			return image, true
+		}
+	}
+	return computeSdk.Image{}, false
+}
+
+func (s *Server) listInstances(w http.ResponseWriter, r *http.Request) {
+	items, p := paginate(r, s.compute.instances.list())
+	writeJSON(w, http.StatusOK, computeSdk.ListInstancesResponse{Instances: items, Meta: computeMeta(p)})
+}
+
+func (s *Server) createInstance(w http.ResponseWriter, r *http.Request) {
+	var req computeSdk.CreateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	machineType, ok := s.findMachineType(req.MachineType)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "machine type not found")
+		return
+	}
+	image, ok := s.findImage(req.Image)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "image not found")
+		return
+	}
+
+	vpcID := s.network.defaultVPC
+	securityGroups := []string{}
+	var publicIP *string
+	if req.Network != nil {
+		if req.Network.Vpc != nil && req.Network.Vpc.ID != nil {
+			vpcID = *req.Network.Vpc.ID
+		}
+		if req.Network.Interface != nil && req.Network.Interface.SecurityGroups != nil {
+			for _, sg := range *req.Network.Interface.SecurityGroups {
+				securityGroups = append(securityGroups, sg.ID)
+			}
+		}
+		if req.Network.AssociatePublicIp != nil && *req.Network.AssociatePublicIp {
+			publicIP = ptr(fmt.Sprintf("201.54.0.%d", len(s.compute.instances.ids)+10))
+		}
+	}
+	vpc, ok := s.network.vpcs.peek(vpcID)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "vpc %s not found", vpcID)
+		return
+	}
+
+	zone := AvailabilityZone
+	if req.AvailabilityZone != nil {
+		zone = *req.AvailabilityZone
+	}
+
+	id := s.newID()
+	instance := computeSdk.Instance{
+		ID:               id,
+		Name:             ptr(req.Name),
+		MachineType:      instanceMachineType(machineType),
+		Image:            &computeSdk.VmImage{ID: image.ID, Name: ptr(image.Name), Platform: image.Platform},
+		State:            "running",
+		CreatedAt:        s.now(),
+		SSHKeyName:       req.SshKeyName,
+		AvailabilityZone: &zone,
+		UserData:         req.UserData,
+		Labels:           req.Labels,
+		Network: &computeSdk.Network{
+			Vpc: &computeSdk.IDOrName{ID: vpc.ID, Name: vpc.Name},
+			Interfaces: &[]computeSdk.NetworkInterface{{
+				ID:                   s.newID(),
+				Name:                 id + "-primary",
+				SecurityGroups:       &securityGroups,
+				Primary:              ptr(true),
+				AssociatedPublicIpv4: publicIP,
+				IpAddresses: computeSdk.IpAddressNewExpand{
+					PrivateIpv4: fmt.Sprintf("172.18.0.%d", len(s.compute.instances.ids)+10),
+				},
+			}},
+		},
+	}
+	s.compute.instances.insert(id, instance, "provisioning", s.settle(req.Name, "completed", "creating_error_capacity"))
+	writeJSON(w, http.StatusOK, map[string]string{"id": id})
+}
+
+func (s *Server) getInstance(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.compute.instances.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "instance", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) deleteInstance(w http.ResponseWriter, r *http.Request) {
+	if !s.compute.instances.delete(r.PathValue("id"), "deleting") {
+		notFound(w, "instance", r.PathValue("id"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) renameInstance(w http.ResponseWriter, r *http.Request) {
+	var req computeSdk.UpdateNameRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if _, ok := s.compute.instances.update(r.PathValue("id"), func(i *computeSdk.Instance) { i.Name = ptr(req.Name) }); !ok {
+		notFound(w, "instance", r.PathValue("id"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) retypeInstance(w http.ResponseWriter, r *http.Request) {
+	var req computeSdk.RetypeRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	machineType, ok := s.findMachineType(req.MachineType)
+	if !ok {
+		writeError(w, http.StatusBadRequest, "machine type not found")
+		return
+	}
+
+	_, ok = s.compute.instances.update(r.PathValue("id"), func(i *computeSdk.Instance) {
+		i.MachineType = instanceMachineType(machineType)
+	}, "retyping", "completed")
+	if !ok {
+		notFound(w, "instance", r.PathValue("id"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// powerInstance changes the power state of an instance, going through the
+// transitional status.
+func (s *Server) powerInstance(transitional, state string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		_, ok := s.compute.instances.update(r.PathValue("id"), func(i *computeSdk.Instance) { i.State = state },
+			transitional, "completed")
+		if !ok {
+			notFound(w, "instance", r.PathValue("id"))
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
@@ -0,0 +1,27 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	crSdk "github.com/MagaluCloud/mgc-sdk-go/containerregistry"
+)
+
+type containerRegistryState struct {
+	credentials crSdk.CredentialsResponse
+}
+
+func (s *Server) seedContainerRegistry() {
+	s.containerRegistry.credentials = crSdk.CredentialsResponse{
+		Username: "fake-tenant",
+		Password: "fake-password",
+		Email:    "fake-tenant@example.com",
+	}
+}
+
+func (s *Server) registerContainerRegistry(mux *http.ServeMux) {
+	mux.HandleFunc("GET /container-registry/v0/credentials", s.getRegistryCredentials)
+}
+
+func (s *Server) getRegistryCredentials(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.containerRegistry.credentials)
+}
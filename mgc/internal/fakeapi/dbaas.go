@@ -0,0 +1,302 @@
+package fakeapi
+
+import (
+	"net/http"
+	"time"
+
+	dbSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+)
+
+type dbaasState struct {
+	engines        []dbSdk.EngineDetail
+	instanceTypes  []dbSdk.InstanceType
+	parameterGroup string
+	instances      *collection[dbSdk.InstanceDetail]
+	snapshots      *collection[dbSdk.SnapshotDetailResponse]
+}
+
+func (s *Server) seedDBaaS() {
+	s.dbaas.engines = []dbSdk.EngineDetail{
+		{ID: s.newID(), Name: DBaaSEngine, Version: DBaaSEngineVersion, Status: "ACTIVE"},
+		{ID: s.newID(), Name: "postgresql", Version: "16", Status: "ACTIVE"},
+	}
+	s.dbaas.instanceTypes = []dbSdk.InstanceType{
+		{ID: s.newID(), Name: "cloud-dbaas-gp1.small", Label: DBaaSInstanceType, VCPU: "2", RAM: "8", Size: "small",
+			FamilyDescription: "General Purpose", FamilySlug: "gp1", CompatibleProduct: "SINGLE_INSTANCE"},
+		{ID: s.newID(), Name: "cloud-dbaas-gp1.medium", Label: DBaaSInstanceTypeLarge, VCPU: "4", RAM: "16", Size: "medium",
+			FamilyDescription: "General Purpose", FamilySlug: "gp1", CompatibleProduct: "SINGLE_INSTANCE"},
+	}
+	s.dbaas.parameterGroup = s.newID()
+	s.dbaas.instances = newCollection(s, func(i *dbSdk.InstanceDetail, status string) { i.Status = dbSdk.InstanceStatus(status) })
+	s.dbaas.snapshots = newCollection(s, func(sn *dbSdk.SnapshotDetailResponse, status string) { sn.Status = dbSdk.SnapshotStatus(status) })
+}
+
+func (s *Server) registerDBaaS(mux *http.ServeMux) {
+	mux.HandleFunc("GET /database/v2/engines", s.listEngines)
+	mux.HandleFunc("GET /database/v2/engines/{id}", s.getEngine)
+	mux.HandleFunc("GET /database/v2/instance-types", s.listDBaaSInstanceTypes)
+	mux.HandleFunc("GET /database/v2/instance-types/{id}", s.getDBaaSInstanceType)
+	mux.HandleFunc("GET /database/v2/instances", s.listDBaaSInstances)
+	mux.HandleFunc("POST /database/v2/instances", s.createDBaaSInstance)
+	mux.HandleFunc("GET /database/v2/instances/{id}", s.getDBaaSInstance)
+	mux.HandleFunc("PATCH /database/v2/instances/{id}", s.updateDBaaSInstance)
+	mux.HandleFunc("DELETE /database/v2/instances/{id}", s.deleteDBaaSInstance)
+	mux.HandleFunc("POST /database/v2/instances/{id}/resize", s.resizeDBaaSInstance)
+	mux.HandleFunc("POST /database/v2/instances/{id}/start", s.powerDBaaSInstance(dbSdk.InstanceStatusStarting, dbSdk.InstanceStatusActive))
+	mux.HandleFunc("POST /database/v2/instances/{id}/stop", s.powerDBaaSInstance(dbSdk.InstanceStatusStopping, dbSdk.InstanceStatusStopped))
+	mux.HandleFunc("POST /database/v2/instances/{id}/snapshots", s.createDBaaSSnapshot)
+	mux.HandleFunc("GET /database/v2/instances/{id}/snapshots/{snapshotID}", s.getDBaaSSnapshot)
+}
+
+func dbaasMeta(p page) dbSdk.MetaResponse {
+	return dbSdk.MetaResponse{
+		Page:    dbSdk.PageResponse{Offset: p.offset, Limit: p.limit, Count: p.count, Total: p.total, MaxLimit: defaultPageLimit},
+		Filters: []dbSdk.FieldValueFilter{},
+	}
+}
+
+func (s *Server) listEngines(w http.ResponseWriter, r *http.Request) {
+	items, p := paginate(r, s.dbaas.engines)
+	writeJSON(w, http.StatusOK, dbSdk.ListEnginesResponse{Results: items, Meta: dbaasMeta(p)})
+}
+
+func (s *Server) findEngine(id string) (dbSdk.EngineDetail, bool) {
+	for _, engine := range s.dbaas.engines {
+		if engine.ID == id {
+			return engine, true
+		}
+	}
+	return dbSdk.EngineDetail{}, false
+}
+
+func (s *Server) getEngine(w http.ResponseWriter, r *http.Request) {
+	engine, ok := s.findEngine(r.PathValue("id"))
+	if !ok {
+		notFound(w, "engine", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, engine)
+}
+
+func (s *Server) listDBaaSInstanceTypes(w http.ResponseWriter, r *http.Request) {
+	if engineID := r.URL.Query().Get("engine_id"); engineID != "" {
+		if _, ok := s.findEngine(engineID); !ok {
+			writeError(w, http.StatusBadRequest, "engine %s not found", engineID)
+			return
+		}
+	}
+	items, p := paginate(r, s.dbaas.instanceTypes)
+	writeJSON(w, http.StatusOK, dbSdk.ListInstanceTypesResponse{Results: items, Meta: dbaasMeta(p)})
+}
+
+func (s *Server) findDBaaSInstanceType(id string) (dbSdk.InstanceType, bool) {
+	for _, t := range s.dbaas.instanceTypes {
+		if t.ID == id {
+			return t, true
+		}
+	}
+	return dbSdk.InstanceType{}, false
+}
+
+func (s *Server) getDBaaSInstanceType(w http.ResponseWriter, r *http.Request) {
+	instanceType, ok := s.findDBaaSInstanceType(r.PathValue("id"))
+	if !ok {
+		notFound(w, "instance type", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, instanceType)
+}
+
+func (s *Server) listDBaaSInstances(w http.ResponseWriter, r *http.Request) {
+	items, p := paginate(r, s.dbaas.instances.list())
+	writeJSON(w, http.StatusOK, dbSdk.InstancesResponse{Results: items, Meta: dbaasMeta(p)})
+}
+
+func (s *Server) createDBaaSInstance(w http.ResponseWriter, r *http.Request) {
+	var req dbSdk.InstanceCreateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.User == "" || req.Password == "" {
+		writeError(w, http.StatusBadRequest, "user and password are required")
+		return
+	}
+	if req.EngineID == nil || req.InstanceTypeID == nil {
+		writeError(w, http.StatusBadRequest, "engine_id and instance_type_id are required")
+		return
+	}
+	if _, ok := s.findEngine(*req.EngineID); !ok {
+		writeError(w, http.StatusBadRequest, "engine %s not found", *req.EngineID)
+		return
+	}
+	if _, ok := s.findDBaaSInstanceType(*req.InstanceTypeID); !ok {
+		writeError(w, http.StatusBadRequest, "instance type %s not found", *req.InstanceTypeID)
+		return
+	}
+
+	id := s.newID()
+	instance := dbSdk.InstanceDetail{
+		ID:                  id,
+		Name:                req.Name,
+		EngineID:            *req.EngineID,
+		InstanceTypeID:      *req.InstanceTypeID,
+		Volume:              dbSdk.Volume{Size: req.Volume.Size, Type: "CLOUD_NVME15K"},
+		Addresses:           []dbSdk.Address{{Access: dbSdk.AddressAccessPrivate, Address: ptr("10.0.1.10")}},
+		Generation:          "GEN1",
+		ParameterGroupID:    s.dbaas.parameterGroup,
+		AvailabilityZone:    AvailabilityZone,
+		BackupRetentionDays: 7,
+		BackupStartAt:       "04:00:00",
+		CreatedAt:           s.now().Format(time.RFC3339),
+	}
+	if req.Volume.Type != "" {
+		instance.Volume.Type = req.Volume.Type
+	}
+	if req.ParameterGroupID != nil {
+		instance.ParameterGroupID = *req.ParameterGroupID
+	}
+	if req.AvailabilityZone != nil {
+		instance.AvailabilityZone = *req.AvailabilityZone
+	}
+	if req.BackupRetentionDays != nil {
+		instance.BackupRetentionDays = *req.BackupRetentionDays
+	}
+	if req.BackupStartAt != nil {
+		instance.BackupStartAt = *req.BackupStartAt
+	}
+	if req.DeletionProtected != nil {
+		instance.DeletionProtected = *req.DeletionProtected
+	}
+
+	s.dbaas.instances.insert(id, instance, string(dbSdk.InstanceStatusCreating),
+		s.settle(req.Name, string(dbSdk.InstanceStatusActive), string(dbSdk.InstanceStatusError)))
+	writeJSON(w, http.StatusOK, dbSdk.InstanceResponse{ID: id})
+}
+
+func (s *Server) getDBaaSInstance(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.dbaas.instances.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "instance", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) updateDBaaSInstance(w http.ResponseWriter, r *http.Request) {
+	var req dbSdk.DatabaseInstanceUpdateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	instance, ok := s.dbaas.instances.update(r.PathValue("id"), func(i *dbSdk.InstanceDetail) {
+		if req.BackupRetentionDays != nil {
+			i.BackupRetentionDays = *req.BackupRetentionDays
+		}
+		if req.BackupStartAt != nil {
+			i.BackupStartAt = *req.BackupStartAt
+		}
+		if req.ParameterGroupID != nil {
+			i.ParameterGroupID = *req.ParameterGroupID
+		}
+		if req.DeletionProtected != nil {
+			i.DeletionProtected = *req.DeletionProtected
+		}
+	})
+	if !ok {
+		notFound(w, "instance", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) resizeDBaaSInstance(w http.ResponseWriter, r *http.Request) {
+	var req dbSdk.InstanceResizeRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.InstanceTypeID != nil {
+		if _, ok := s.findDBaaSInstanceType(*req.InstanceTypeID); !ok {
+			writeError(w, http.StatusBadRequest, "instance type %s not found", *req.InstanceTypeID)
+			return
+		}
+	}
+	current, ok := s.dbaas.instances.peek(r.PathValue("id"))
+	if !ok {
+		notFound(w, "instance", r.PathValue("id"))
+		return
+	}
+	if req.Volume != nil && req.Volume.Size < current.Volume.Size {
+		writeError(w, http.StatusBadRequest, "volumes can only be extended, from %d GB", current.Volume.Size)
+		return
+	}
+
+	instance, _ := s.dbaas.instances.update(r.PathValue("id"), func(i *dbSdk.InstanceDetail) {
+		if req.InstanceTypeID != nil {
+			i.InstanceTypeID = *req.InstanceTypeID
+		}
+		if req.Volume != nil {
+			i.Volume.Size = req.Volume.Size
+		}
+	}, string(dbSdk.InstanceStatusResizing), string(dbSdk.InstanceStatusActive))
+	writeJSON(w, http.StatusOK, instance)
+}
+
+func (s *Server) deleteDBaaSInstance(w http.ResponseWriter, r *http.Request) {
+	instance, ok := s.dbaas.instances.peek(r.PathValue("id"))
+	if !ok {
+		notFound(w, "instance", r.PathValue("id"))
+		return
+	}
+	if instance.DeletionProtected {
+		writeError(w, http.StatusConflict, "instance %s is deletion protected", instance.ID)
+		return
+	}
+	s.dbaas.instances.delete(instance.ID, string(dbSdk.InstanceStatusDeleting))
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// powerDBaaSInstance starts or stops an instance, going through the
+// transitional status.
+func (s *Server) powerDBaaSInstance(transitional, final dbSdk.InstanceStatus) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		instance, ok := s.dbaas.instances.update(r.PathValue("id"), nil, string(transitional), string(final))
+		if !ok {
+			notFound(w, "instance", r.PathValue("id"))
+			return
+		}
+		writeJSON(w, http.StatusOK, instance)
+	}
+}
+
+func (s *Server) createDBaaSSnapshot(w http.ResponseWriter, r *http.Request) {
+	var req dbSdk.SnapshotCreateRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	instance, ok := s.dbaas.instances.peek(r.PathValue("id"))
+	if !ok {
+		notFound(w, "instance", r.PathValue("id"))
+		return
+	}
+
+	id := s.newID()
+	s.dbaas.snapshots.insert(id, dbSdk.SnapshotDetailResponse{
+		ID:               id,
+		Instance:         dbSdk.SnapshotInstanceDetailResponse{ID: instance.ID, Name: instance.Name},
+		Name:             req.Name,
+		Description:      valueOr(req.Description, ""),
+		Type:             dbSdk.SnapshotTypeOnDemand,
+		AllocatedSize:    instance.Volume.Size,
+		CreatedAt:        s.now(),
+		AvailabilityZone: instance.AvailabilityZone,
+	}, string(dbSdk.SnapshotStatusCreating), s.settle(req.Name, string(dbSdk.SnapshotStatusAvailable), string(dbSdk.SnapshotStatusError)))
+	writeJSON(w, http.StatusOK, dbSdk.SnapshotResponse{ID: id})
+}
+
+func (s *Server) getDBaaSSnapshot(w http.ResponseWriter, r *http.Request) {
+	if current, ok := s.dbaas.snapshots.peek(r.PathValue("snapshotID")); !ok || current.Instance.ID != r.PathValue("id") {
+		notFound(w, "snapshot", r.PathValue("snapshotID"))
+		return
+	}
+	snapshot, _ := s.dbaas.snapshots.get(r.PathValue("snapshotID"))
+	writeJSON(w, http.StatusOK, snapshot)
+}
@@ -0,0 +1,275 @@
+package fakeapi
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"slices"
+
+	k8sSdk "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+)
+
+type kubernetesState struct {
+	versions []k8sSdk.Version
+	clusters *collection[k8sSdk.Cluster]
+	// nodePools holds the node pools of every cluster, nodePoolCluster the
+	// cluster of each one.
+	nodePools       *collection[k8sSdk.NodePool]
+	nodePoolCluster map[string]string
+}
+
+func (s *Server) seedKubernetes() {
+	s.kubernetes.versions = []k8sSdk.Version{
+		{Version: "v1.30.2", Deprecated: true},
+		{Version: "v1.31.6"},
+		{Version: KubernetesVersion},
+	}
+	s.kubernetes.clusters = newCollection(s, func(c *k8sSdk.Cluster, status string) {
+		c.Status = &k8sSdk.MessageState{State: status, Message: "Cluster is " + status}
+	})
+	s.kubernetes.nodePools = newCollection(s, func(np *k8sSdk.NodePool, status string) { np.Status.State = status })
+	s.kubernetes.nodePoolCluster = map[string]string{}
+}
+
+func (s *Server) registerKubernetes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /kubernetes/v1/versions", s.listKubernetesVersions)
+	mux.HandleFunc("GET /kubernetes/v0/clusters", s.listClusters)
+	mux.HandleFunc("POST /kubernetes/v0/clusters", s.createCluster)
+	mux.HandleFunc("GET /kubernetes/v0/clusters/{id}", s.getCluster)
+	mux.HandleFunc("PATCH /kubernetes/v0/clusters/{id}", s.patchCluster)
+	mux.HandleFunc("DELETE /kubernetes/v0/clusters/{id}", s.deleteCluster)
+	mux.HandleFunc("GET /kubernetes/v0/clusters/{id}/kubeconfig", s.getKubeConfig)
+	mux.HandleFunc("POST /kubernetes/v0/clusters/{id}/node_pools", s.createNodePool)
+	mux.HandleFunc("GET /kubernetes/v0/clusters/{id}/node_pools/{nodePoolID}", s.getNodePool)
+	mux.HandleFunc("PATCH /kubernetes/v0/clusters/{id}/node_pools/{nodePoolID}", s.patchNodePool)
+}
+
+func (s *Server) listKubernetesVersions(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, k8sSdk.VersionList{Results: s.kubernetes.versions})
+}
+
+func (s *Server) listClusters(w http.ResponseWriter, r *http.Request) {
+	clusters, _ := paginate(r, s.kubernetes.clusters.list())
+	results := make([]k8sSdk.ClusterList, 0, len(clusters))
+	for _, c := range clusters {
+		results = append(results, k8sSdk.ClusterList{
+			ID:                 c.ID,
+			Name:               c.Name,
+			Description:        c.Description,
+			Region:             c.Region,
+			Status:             c.Status,
+			Version:            ptr(c.Version),
+			CreatedAt:          c.CreatedAt,
+			MachineTypesSource: c.MachineTypesSource,
+			ClusterIPv4CIDR:    c.ClusterIPv4CIDR,
+			ServicesIpV4CIDR:   c.ServicesIpV4CIDR,
+			Platform:           c.Platform,
+		})
+	}
+	writeJSON(w, http.StatusOK, k8sSdk.ClusterListResponse{Results: results})
+}
+
+func (s *Server) createCluster(w http.ResponseWriter, r *http.Request) {
+	var req k8sSdk.ClusterRequest
+	if !decode(w, r, &req) {
+		return
+	}
+
+	version := KubernetesVersion
+	if req.Version != nil {
+		version = *req.Version
+	}
+	if !slices.ContainsFunc(s.kubernetes.versions, func(v k8sSdk.Version) bool { return v.Version == version }) {
+		writeError(w, http.StatusBadRequest, "kubernetes version %s is not available", version)
+		return
+	}
+
+	clusterCIDR, servicesCIDR := "172.16.0.0/16", "10.96.0.0/12"
+	if req.ClusterIPv4CIDR != nil {
+		clusterCIDR = *req.ClusterIPv4CIDR
+	}
+	if req.ServicesIpV4CIDR != nil {
+		servicesCIDR = *req.ServicesIpV4CIDR
+	}
+
+	var network *k8sSdk.Network
+	if req.Network != nil && len(req.Network.SubnetIDs) > 0 {
+		network = &k8sSdk.Network{VPCID: s.network.defaultVPC}
+		for _, id := range req.Network.SubnetIDs {
+			network.Subnets = append(network.Subnets, k8sSdk.Subnet{ID: id, CIDR: "10.0.0.0/24", AvailabilityZone: AvailabilityZone})
+		}
+	}
+
+	id := s.newID()
+	now := s.now()
+	source := k8sSdk.MachineTypesSourceInternal
+	cluster := k8sSdk.Cluster{
+		ID:                 id,
+		Name:               req.Name,
+		Version:            version,
+		Description:        req.Description,
+		Region:             ptr(Region),
+		CreatedAt:          &now,
+		UpdatedAt:          &now,
+		Network:            network,
+		AllowedCIDRs:       req.AllowedCIDRs,
+		ClusterIPv4CIDR:    &clusterCIDR,
+		ServicesIpV4CIDR:   &servicesCIDR,
+		MachineTypesSource: &source,
+		Platform:           &k8sSdk.Platform{Version: "v1"},
+	}
+	s.kubernetes.clusters.insert(id, cluster, "provisioning", s.settle(req.Name, "running", "failed"))
+
+	created, _ := s.kubernetes.clusters.peek(id)
+	writeJSON(w, http.StatusCreated, k8sSdk.CreateClusterResponse{
+		ID:               id,
+		Name:             req.Name,
+		Status:           *created.Status,
+		AllowedCidrs:     req.AllowedCIDRs,
+		ClusterIPv4CIDR:  &clusterCIDR,
+		ServicesIpV4CIDR: &servicesCIDR,
+	})
+}
+
+func (s *Server) getCluster(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.kubernetes.clusters.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "cluster", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, cluster)
+}
+
+// getKubeConfig answers with YAML, as the API does, holding the cluster ID
+// in every name and certificate so tests can tell clusters apart.
+func (s *Server) getKubeConfig(w http.ResponseWriter, r *http.Request) {
+	cluster, ok := s.kubernetes.clusters.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "cluster", r.PathValue("id"))
+		return
+	}
+
+	encode := func(v string) string { return base64.StdEncoding.EncodeToString([]byte(v)) }
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprintf(w, `apiVersion: v1
+kind: Config
+clusters:
+  - name: %[1]s
+    cluster:
+      server: https://%[2]s.k8s.fake.magalu.cloud
+      certificate-authority-data: %[3]s
+contexts:
+  - name: %[1]s
+    context:
+      cluster: %[1]s
+      user: %[1]s-admin
+current-context: %[1]s
+users:
+  - name: %[1]s-admin
+    user:
+      client-certificate-data: %[4]s
+      client-key-data: %[5]s
+`, cluster.Name, cluster.ID, encode("ca-"+cluster.ID), encode("cert-"+cluster.ID), encode("key-"+cluster.ID))
+}
+
+func (s *Server) patchCluster(w http.ResponseWriter, r *http.Request) {
+	var req k8sSdk.PatchClusterRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	cluster, ok := s.kubernetes.clusters.update(r.PathValue("id"), func(c *k8sSdk.Cluster) {
+		if req.AllowedCIDRs != nil {
+			c.AllowedCIDRs = req.AllowedCIDRs
+		}
+		if req.Description != nil {
+			c.Description = req.Description
+		}
+	})
+	if !ok {
+		notFound(w, "cluster", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, k8sSdk.PatchClusterResponse{
+		AllowedCIDRs: cluster.AllowedCIDRs,
+		Version:      ptr(cluster.Version),
+		Description:  cluster.Description,
+	})
+}
+
+func (s *Server) deleteCluster(w http.ResponseWriter, r *http.Request) {
+	if !s.kubernetes.clusters.delete(r.PathValue("id"), "deleting") {
+		notFound(w, "cluster", r.PathValue("id"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) createNodePool(w http.ResponseWriter, r *http.Request) {
+	var req k8sSdk.CreateNodePoolRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if _, ok := s.kubernetes.clusters.peek(r.PathValue("id")); !ok {
+		notFound(w, "cluster", r.PathValue("id"))
+		return
+	}
+
+	id := s.newID()
+	now := s.now()
+	s.kubernetes.nodePools.insert(id, k8sSdk.NodePool{
+		ID:               id,
+		Name:             req.Name,
+		Flavor:           req.Flavor,
+		InstanceTemplate: k8sSdk.InstanceTemplate{Flavor: k8sSdk.Flavor{Name: req.Flavor}, DiskSize: 20, DiskType: "nvme"},
+		Replicas:         req.Replicas,
+		AutoScale:        req.AutoScale,
+		CreatedAt:        &now,
+		UpdatedAt:        &now,
+	}, "Provisioning", s.settle(req.Name, "Running", "Failed"))
+	s.kubernetes.nodePoolCluster[id] = r.PathValue("id")
+
+	created, _ := s.kubernetes.nodePools.peek(id)
+	writeJSON(w, http.StatusCreated, created)
+}
+
+// clusterNodePool reports whether the node pool in the request path belongs
+// to the cluster in it, answering not found otherwise.
+func (s *Server) clusterNodePool(w http.ResponseWriter, r *http.Request) bool {
+	if s.kubernetes.nodePoolCluster[r.PathValue("nodePoolID")] != r.PathValue("id") {
+		notFound(w, "node pool", r.PathValue("nodePoolID"))
+		return false
+	}
+	return true
+}
+
+func (s *Server) getNodePool(w http.ResponseWriter, r *http.Request) {
+	if !s.clusterNodePool(w, r) {
+		return
+	}
+	nodePool, _ := s.kubernetes.nodePools.get(r.PathValue("nodePoolID"))
+	writeJSON(w, http.StatusOK, nodePool)
+}
+
+func (s *Server) patchNodePool(w http.ResponseWriter, r *http.Request) {
+	var req k8sSdk.PatchNodePoolRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if !s.clusterNodePool(w, r) {
+		return
+	}
+	nodePool, _ := s.kubernetes.nodePools.update(r.PathValue("nodePoolID"), func(np *k8sSdk.NodePool) {
+		if req.Replicas != nil {
+			np.Replicas = *req.Replicas
+		}
+		if req.AutoScale != nil {
+			np.AutoScale = req.AutoScale
+		}
+		if req.Flavor != nil {
+			np.Flavor = *req.Flavor
+			np.InstanceTemplate.Flavor.Name = *req.Flavor
+		}
+	}, "Scaling", "Running")
+	writeJSON(w, http.StatusOK, nodePool)
+}
@@ -0,0 +1,347 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	lbSdk "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+)
+
+type lbaasState struct {
+	loadBalancers *collection[lbSdk.NetworkLoadBalancerResponse]
+}
+
+func (s *Server) seedLBaaS() {
+	s.lbaas.loadBalancers = newCollection(s, func(lb *lbSdk.NetworkLoadBalancerResponse, status string) {
+		lb.Status = lbSdk.LoadBalancerStatus(status)
+	})
+}
+
+func (s *Server) registerLBaaS(mux *http.ServeMux) {
+	const base = "/load-balancer/v0beta1/network-load-balancers"
+	mux.HandleFunc("GET "+base, s.listLoadBalancers)
+	mux.HandleFunc("POST "+base, s.createLoadBalancer)
+	mux.HandleFunc("GET "+base+"/{id}", s.getLoadBalancer)
+	mux.HandleFunc("PUT "+base+"/{id}", s.updateLoadBalancer)
+	mux.HandleFunc("DELETE "+base+"/{id}", s.deleteLoadBalancer)
+	mux.HandleFunc("PUT "+base+"/{id}/acls", s.replaceLoadBalancerACLs)
+	mux.HandleFunc("PUT "+base+"/{id}/health-checks/{hcID}", s.updateLoadBalancerHealthCheck)
+	mux.HandleFunc("PUT "+base+"/{id}/backends/{backendID}", s.updateLoadBalancerBackend)
+	mux.HandleFunc("PUT "+base+"/{id}/backends/{backendID}/targets", s.replaceLoadBalancerTargets)
+}
+
+func (s *Server) listLoadBalancers(w http.ResponseWriter, r *http.Request) {
+	items, p := paginate(r, s.lbaas.loadBalancers.list())
+	writeJSON(w, http.StatusOK, lbSdk.NetworkLBPaginatedResponse{
+		Meta: lbSdk.PaginationMeta{
+			Links: lbSdk.PaginationLinks{Self: r.URL.String()},
+			Page:  lbSdk.PaginationPage{Offset: p.offset, Limit: p.limit, Count: p.count, Total: p.total},
+		},
+		Results: items,
+	})
+}
+
+func (s *Server) newHealthCheck(req lbSdk.CreateNetworkHealthCheckRequest) lbSdk.NetworkHealthCheckResponse {
+	now := s.now()
+	return lbSdk.NetworkHealthCheckResponse{
+		ID:                      s.newID(),
+		Name:                    req.Name,
+		Description:             req.Description,
+		Protocol:                req.Protocol,
+		Path:                    req.Path,
+		Port:                    req.Port,
+		HealthyStatusCode:       valueOr(req.HealthyStatusCode, 200),
+		IntervalSeconds:         valueOr(req.IntervalSeconds, 30),
+		TimeoutSeconds:          valueOr(req.TimeoutSeconds, 10),
+		InitialDelaySeconds:     valueOr(req.InitialDelaySeconds, 20),
+		HealthyThresholdCount:   valueOr(req.HealthyThresholdCount, 8),
+		UnhealthyThresholdCount: valueOr(req.UnhealthyThresholdCount, 3),
+		CreatedAt:               now,
+		UpdatedAt:               now,
+	}
+}
+
+func (s *Server) newTargets(req []lbSdk.NetworkBackendInstanceTargetRequest) []lbSdk.NetworkBackedTarget {
+	now := s.now()
+	targets := make([]lbSdk.NetworkBackedTarget, 0, len(req))
+	for _, t := range req {
+		targets = append(targets, lbSdk.NetworkBackedTarget{
+			ID:        s.newID(),
+			IPAddress: t.IPAddress,
+			NicID:     t.NicID,
+			Port:      ptr(t.Port),
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+	}
+	return targets
+}
+
+func (s *Server) newACLs(req []lbSdk.CreateNetworkACLRequest) []lbSdk.NetworkAclResponse {
+	acls := make([]lbSdk.NetworkAclResponse, 0, len(req))
+	for _, a := range req {
+		acls = append(acls, lbSdk.NetworkAclResponse{
+			ID:             s.newID(),
+			Name:           a.Name,
+			Ethertype:      a.Ethertype,
+			Protocol:       a.Protocol,
+			RemoteIPPrefix: a.RemoteIPPrefix,
+			Action:         string(a.Action),
+		})
+	}
+	return acls
+}
+
+func (s *Server) createLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	var req lbSdk.CreateNetworkLoadBalancerRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if _, ok := s.network.vpcs.peek(req.VPCID); !ok {
+		writeError(w, http.StatusBadRequest, "vpc %s not found", req.VPCID)
+		return
+	}
+	if len(req.Listeners) == 0 || len(req.Backends) == 0 {
+		writeError(w, http.StatusBadRequest, "at least one listener and one backend are required")
+		return
+	}
+
+	now := s.now()
+	id := s.newID()
+	lb := lbSdk.NetworkLoadBalancerResponse{
+		ID:              id,
+		Name:            req.Name,
+		Description:     req.Description,
+		Type:            valueOr(req.Type, "proxy"),
+		Visibility:      req.Visibility,
+		VPCID:           req.VPCID,
+		SubnetPoolID:    req.SubnetPoolID,
+		TLSCertificates: []lbSdk.NetworkTLSCertificateResponse{},
+		ACLs:            s.newACLs(req.ACLs),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if req.PublicIPID != nil {
+		lb.PublicIP = &lbSdk.NetworkPublicIPResponse{ID: *req.PublicIPID, IPAddress: ptr("201.54.1.10"), ExternalID: s.newID()}
+	}
+
+	healthChecks := map[string]string{}
+	for _, hc := range req.HealthChecks {
+		created := s.newHealthCheck(hc)
+		healthChecks[hc.Name] = created.ID
+		lb.HealthChecks = append(lb.HealthChecks, created)
+	}
+
+	backends := map[string]string{}
+	for _, b := range req.Backends {
+		backend := lbSdk.NetworkBackendResponse{
+			ID:                                  s.newID(),
+			Name:                                b.Name,
+			Description:                         b.Description,
+			BalanceAlgorithm:                    b.BalanceAlgorithm,
+			PanicThreshold:                      ptr(valueOr(b.PanicThreshold, 50)),
+			CloseConnectionsOnHostHealthFailure: ptr(valueOr(b.CloseConnectionsOnHostHealthFailure, true)),
+			TargetsType:                         b.TargetsType,
+			Targets:                             []lbSdk.NetworkBackedTarget{},
+			CreatedAt:                           now,
+			UpdatedAt:                           now,
+		}
+		if b.HealthCheckName != nil {
+			hcID, ok := healthChecks[*b.HealthCheckName]
+			if !ok {
+				writeError(w, http.StatusBadRequest, "health check %s not found", *b.HealthCheckName)
+				return
+			}
+			backend.HealthCheckID = &hcID
+		}
+		if b.Targets != nil {
+			backend.Targets = s.newTargets(*b.Targets)
+		}
+		backends[b.Name] = backend.ID
+		lb.Backends = append(lb.Backends, backend)
+	}
+
+	for _, l := range req.Listeners {
+		backendID, ok := backends[l.BackendName]
+		if !ok {
+			writeError(w, http.StatusBadRequest, "backend %s not found", l.BackendName)
+			return
+		}
+		lb.Listeners = append(lb.Listeners, lbSdk.NetworkListenerResponse{
+			ID:          s.newID(),
+			BackendID:   backendID,
+			Name:        l.Name,
+			Description: l.Description,
+			Protocol:    l.Protocol,
+			Port:        l.Port,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+	}
+
+	s.lbaas.loadBalancers.insert(id, lb, string(lbSdk.LoadBalancerStatusCreating),
+		s.settle(req.Name, string(lbSdk.LoadBalancerStatusRunning), string(lbSdk.LoadBalancerStatusFailed)))
+	writeJSON(w, http.StatusOK, lbSdk.NetworkGenericCreationResponse{ID: id})
+}
+
+func (s *Server) getLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	lb, ok := s.lbaas.loadBalancers.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "load balancer", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, lb)
+}
+
+// updateLoadBalancerWith applies mutate to a load balancer and puts it
+// through the updating status, like every change in the real API does.
+func (s *Server) updateLoadBalancerWith(w http.ResponseWriter, r *http.Request, mutate func(*lbSdk.NetworkLoadBalancerResponse)) bool {
+	_, ok := s.lbaas.loadBalancers.update(r.PathValue("id"), func(lb *lbSdk.NetworkLoadBalancerResponse) {
+		mutate(lb)
+		lb.UpdatedAt = s.now()
+	}, string(lbSdk.LoadBalancerStatusUpdating), string(lbSdk.LoadBalancerStatusRunning))
+	if !ok {
+		notFound(w, "load balancer", r.PathValue("id"))
+	}
+	return ok
+}
+
+// findLoadBalancerChild returns the index of the sub-resource with the id in
+// the path value, writing the not found error when the load balancer or the
+// sub-resource does not exist.
+func findLoadBalancerChild[T any](s *Server, w http.ResponseWriter, r *http.Request, value, kind string,
+	children func(*lbSdk.NetworkLoadBalancerResponse) []T, id func(T) string,
+) (int, bool) {
+	lb, ok := s.lbaas.loadBalancers.peek(r.PathValue("id"))
+	if !ok {
+		notFound(w, "load balancer", r.PathValue("id"))
+		return 0, false
+	}
+	for i, child := range children(lb) {
+		if id(child) == r.PathValue(value) {
+			return i, true
+		}
+	}
+	notFound(w, kind, r.PathValue(value))
+	return 0, false
+}
+
+func (s *Server) findHealthCheck(w http.ResponseWriter, r *http.Request) (int, bool) {
+	return findLoadBalancerChild(s, w, r, "hcID", "health check",
+		func(lb *lbSdk.NetworkLoadBalancerResponse) []lbSdk.NetworkHealthCheckResponse { return lb.HealthChecks },
+		func(hc lbSdk.NetworkHealthCheckResponse) string { return hc.ID })
+}
+
+func (s *Server) findBackend(w http.ResponseWriter, r *http.Request) (int, bool) {
+	return findLoadBalancerChild(s, w, r, "backendID", "backend",
+		func(lb *lbSdk.NetworkLoadBalancerResponse) []lbSdk.NetworkBackendResponse { return lb.Backends },
+		func(b lbSdk.NetworkBackendResponse) string { return b.ID })
+}
+
+func (s *Server) updateLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	var req lbSdk.UpdateNetworkLoadBalancerRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	ok := s.updateLoadBalancerWith(w, r, func(lb *lbSdk.NetworkLoadBalancerResponse) {
+		if req.Name != nil {
+			lb.Name = *req.Name
+		}
+		if req.Description != nil {
+			lb.Description = req.Description
+		}
+	})
+	if ok {
+		writeJSON(w, http.StatusOK, lbSdk.NetworkGenericCreationResponse{ID: r.PathValue("id")})
+	}
+}
+
+func (s *Server) deleteLoadBalancer(w http.ResponseWriter, r *http.Request) {
+	if !s.lbaas.loadBalancers.delete(r.PathValue("id"), string(lbSdk.LoadBalancerStatusDeleting)) {
+		notFound(w, "load balancer", r.PathValue("id"))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) replaceLoadBalancerACLs(w http.ResponseWriter, r *http.Request) {
+	var req lbSdk.UpdateNetworkACLRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	acls := s.newACLs(req.Acls)
+	if s.updateLoadBalancerWith(w, r, func(lb *lbSdk.NetworkLoadBalancerResponse) { lb.ACLs = acls }) {
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func (s *Server) updateLoadBalancerHealthCheck(w http.ResponseWriter, r *http.Request) {
+	var req lbSdk.UpdateNetworkHealthCheckRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	idx, ok := s.findHealthCheck(w, r)
+	if !ok {
+		return
+	}
+	s.updateLoadBalancerWith(w, r, func(lb *lbSdk.NetworkLoadBalancerResponse) {
+		hc := &lb.HealthChecks[idx]
+		hc.Protocol = req.Protocol
+		hc.Port = req.Port
+		hc.Path = req.Path
+		hc.HealthyStatusCode = valueOr(req.HealthyStatusCode, hc.HealthyStatusCode)
+		hc.IntervalSeconds = valueOr(req.IntervalSeconds, hc.IntervalSeconds)
+		hc.TimeoutSeconds = valueOr(req.TimeoutSeconds, hc.TimeoutSeconds)
+		hc.InitialDelaySeconds = valueOr(req.InitialDelaySeconds, hc.InitialDelaySeconds)
+		hc.HealthyThresholdCount = valueOr(req.HealthyThresholdCount, hc.HealthyThresholdCount)
+		hc.UnhealthyThresholdCount = valueOr(req.UnhealthyThresholdCount, hc.UnhealthyThresholdCount)
+		hc.UpdatedAt = s.now()
+	})
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) updateLoadBalancerBackend(w http.ResponseWriter, r *http.Request) {
+	var req lbSdk.UpdateNetworkBackendRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	idx, ok := s.findBackend(w, r)
+	if !ok {
+		return
+	}
+	s.updateLoadBalancerWith(w, r, func(lb *lbSdk.NetworkLoadBalancerResponse) {
+		b := &lb.Backends[idx]
+		if req.HealthCheckID != nil {
+			b.HealthCheckID = req.HealthCheckID
+		}
+		if req.PanicThreshold != nil {
+			b.PanicThreshold = req.PanicThreshold
+		}
+		if req.CloseConnectionsOnHostHealthFailure != nil {
+			b.CloseConnectionsOnHostHealthFailure = req.CloseConnectionsOnHostHealthFailure
+		}
+		b.UpdatedAt = s.now()
+	})
+	writeJSON(w, http.StatusOK, lbSdk.NetworkGenericCreationResponse{ID: r.PathValue("backendID")})
+}
+
+func (s *Server) replaceLoadBalancerTargets(w http.ResponseWriter, r *http.Request) {
+	var req lbSdk.CreateNetworkBackendTargetRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	idx, ok := s.findBackend(w, r)
+	if !ok {
+		return
+	}
+	targets := s.newTargets(req.Targets)
+	s.updateLoadBalancerWith(w, r, func(lb *lbSdk.NetworkLoadBalancerResponse) {
+		b := &lb.Backends[idx]
+		b.TargetsType = req.TargetsType
+		b.Targets = targets
+		if req.HealthCheckID != nil {
+			b.HealthCheckID = req.HealthCheckID
+		}
+		b.UpdatedAt = s.now()
+	})
+	writeJSON(w, http.StatusOK, lbSdk.NetworkGenericCreationResponse{ID: r.PathValue("backendID")})
+}
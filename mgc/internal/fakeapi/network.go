@@ -0,0 +1,93 @@
+package fakeapi
+
+import (
+	"net/http"
+
+	netSdk "github.com/MagaluCloud/mgc-sdk-go/network"
+)
+
+type networkState struct {
+	defaultVPC string
+	vpcs       *collection[netSdk.VPC]
+}
+
+func (s *Server) seedNetwork() {
+	s.network.vpcs = newCollection(s, func(v *netSdk.VPC, status string) { v.Status = status })
+
+	s.network.defaultVPC = s.newID()
+	s.network.vpcs.insert(s.network.defaultVPC, netSdk.VPC{
+		ID:          ptr(s.network.defaultVPC),
+		Name:        ptr("default"),
+		Description: ptr("Default VPC"),
+		IsDefault:   ptr(true),
+	}, "created")
+}
+
+func (s *Server) registerNetwork(mux *http.ServeMux) {
+	mux.HandleFunc("GET /network/v0/vpcs", s.listVPCs)
+	mux.HandleFunc("POST /network/v1/vpcs", s.createVPC)
+	mux.HandleFunc("GET /network/v0/vpcs/{id}", s.getVPC)
+	mux.HandleFunc("DELETE /network/v0/vpcs/{id}", s.deleteVPC)
+	mux.HandleFunc("PATCH /network/v0/vpcs/{id}/rename", s.renameVPC)
+}
+
+func (s *Server) listVPCs(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, netSdk.ListVPCsResponse{VPCs: s.network.vpcs.list()})
+}
+
+func (s *Server) createVPC(w http.ResponseWriter, r *http.Request) {
+	var req netSdk.CreateVPCRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	for _, vpc := range s.network.vpcs.list() {
+		if vpc.Name != nil && *vpc.Name == req.Name {
+			writeError(w, http.StatusConflict, "vpc %s already exists", req.Name)
+			return
+		}
+	}
+
+	id := s.newID()
+	s.network.vpcs.insert(id, netSdk.VPC{
+		ID:          ptr(id),
+		Name:        ptr(req.Name),
+		Description: req.Description,
+		IsDefault:   ptr(false),
+	}, "pending", s.settle(req.Name, "created", "error"))
+	writeJSON(w, http.StatusCreated, netSdk.CreateVPCResponse{ID: id, Status: "pending"})
+}
+
+func (s *Server) getVPC(w http.ResponseWriter, r *http.Request) {
+	vpc, ok := s.network.vpcs.get(r.PathValue("id"))
+	if !ok {
+		notFound(w, "vpc", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, vpc)
+}
+
+func (s *Server) deleteVPC(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == s.network.defaultVPC {
+		writeError(w, http.StatusBadRequest, "the default vpc cannot be deleted")
+		return
+	}
+	if !s.network.vpcs.delete(id, "deleting") {
+		notFound(w, "vpc", id)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) renameVPC(w http.ResponseWriter, r *http.Request) {
+	var req netSdk.RenameVPCRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	vpc, ok := s.network.vpcs.update(r.PathValue("id"), func(v *netSdk.VPC) { v.Name = ptr(req.Name) })
+	if !ok {
+		notFound(w, "vpc", r.PathValue("id"))
+		return
+	}
+	writeJSON(w, http.StatusOK, vpc)
+}
@@ -0,0 +1,326 @@
+// Package fakeapi is an in-memory fake of the Magalu Cloud API, used to run
+// the provider acceptance tests without a cloud account.
+//
+// It serves the part of the compute, block storage, network, kubernetes,
+// DBaaS, load balancer and container registry APIs the provider uses, under
+// the service paths of the SDK clients, e.g. <URL>/compute/v1/instances, so
+// it can be set as the provider api_endpoint. Objects are kept in memory and
+// asynchronous operations are simulated: an object being created, changed or
+// deleted reports a transitional status on its next read and settles on the
+// one after, so the provider waiters go through at least one pending state.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Catalog entries every server starts with, to be used in test
+// configurations.
+const (
+	Region           = "br-se1"
+	AvailabilityZone = "br-se1-a"
+
+	MachineType      = "BV1-1-40"
+	MachineTypeLarge = "BV2-2-40"
+	Image            = "cloud-ubuntu-24.04 LTS"
+
+	VolumeType     = "cloud_nvme1k"
+	VolumeTypeFast = "cloud_nvme5k"
+
+	KubernetesVersion = "v1.32.3"
+
+	DBaaSEngine            = "mysql"
+	DBaaSEngineVersion     = "8.0"
+	DBaaSInstanceType      = "DP2-8-40"
+	DBaaSInstanceTypeLarge = "DP2-16-40"
+)
+
+const defaultPageLimit = 50
+
+// Server is a fake Magalu Cloud API listening on a local address.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	seq      int
+	requests int
+	stores   []store
+	failing  map[string]bool
+
+	compute    computeState
+	storage    storageState
+	network    networkState
+	kubernetes kubernetesState
+	dbaas      dbaasState
+	lbaas      lbaasState
+
+	containerRegistry containerRegistryState
+}
+
+// NewServer starts a fake API. It must be closed by the caller.
+func NewServer() *Server {
+	s := &Server{failing: map[string]bool{}}
+	s.seedCompute()
+	s.seedStorage()
+	s.seedNetwork()
+	s.seedKubernetes()
+	s.seedDBaaS()
+	s.seedLBaaS()
+	s.seedContainerRegistry()
+
+	mux := http.NewServeMux()
+	s.registerCompute(mux)
+	s.registerStorage(mux)
+	s.registerNetwork(mux)
+	s.registerKubernetes(mux)
+	s.registerDBaaS(mux)
+	s.registerLBaaS(mux)
+	s.registerContainerRegistry(mux)
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		s.requests++
+		w.Header().Set("X-Request-ID", fmt.Sprintf("fake-%d", s.requests))
+		if r.Header.Get("X-API-Key") == "" {
+			writeError(w, http.StatusUnauthorized, "missing X-API-Key header")
+			return
+		}
+		mux.ServeHTTP(w, r)
+	}))
+	return s
+}
+
+// Exists reports whether the object with the given id is stored and not
+// being deleted, e.g. to check it was destroyed.
+func (s *Server) Exists(id string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, st := range s.stores {
+		if st.exists(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// FailProvisioning makes the virtual machines, volumes, VPCs, Kubernetes
+// clusters, DBaaS instances and load balancers created with name settle on
+// an error status instead of becoming ready, as after a capacity error.
+func (s *Server) FailProvisioning(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failing[name] = true
+}
+
+// settle returns the status an object created with name ends up in.
+func (s *Server) settle(name, ready, failed string) string {
+	if s.failing[name] {
+		return failed
+	}
+	return ready
+}
+
+func (s *Server) newID() string {
+	s.seq++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", s.seq)
+}
+
+func (s *Server) now() time.Time {
+	return time.Now().UTC().Truncate(time.Second)
+}
+
+type store interface {
+	exists(id string) bool
+}
+
+// entry is an object of a collection and the statuses it has yet to go
+// through.
+type entry[T any] struct {
+	value    T
+	pending  []string
+	deleting bool
+}
+
+// collection keeps objects of one kind in creation order. setStatus writes a
+// status to an object.
+type collection[T any] struct {
+	ids       []string
+	entries   map[string]*entry[T]
+	setStatus func(*T, string)
+}
+
+func newCollection[T any](s *Server, setStatus func(*T, string)) *collection[T] {
+	c := &collection[T]{entries: map[string]*entry[T]{}, setStatus: setStatus}
+	s.stores = append(s.stores, c)
+	return c
+}
+
+// insert stores value under id in the first of statuses, the others are
+// reached one per read.
+func (c *collection[T]) insert(id string, value T, statuses ...string) {
+	c.ids = append(c.ids, id)
+	c.entries[id] = &entry[T]{value: value}
+	c.transition(c.entries[id], statuses)
+}
+
+// get returns the object and advances it to its next pending status, which
+// is seen by the following read. Objects being deleted are removed once they
+// have no pending status left.
+func (c *collection[T]) get(id string) (T, bool) {
+	e, ok := c.entries[id]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+
+	value := e.value
+	switch {
+	case len(e.pending) > 0:
+		c.setStatus(&e.value, e.pending[0])
+		e.pending = e.pending[1:]
+	case e.deleting:
+		c.remove(id)
+	}
+	return value, true
+}
+
+// peek returns the object without advancing it.
+func (c *collection[T]) peek(id string) (*T, bool) {
+	e, ok := c.entries[id]
+	if !ok {
+		return nil, false
+	}
+	return &e.value, true
+}
+
+// update applies mutate and moves the object through statuses.
+func (c *collection[T]) update(id string, mutate func(*T), statuses ...string) (T, bool) {
+	e, ok := c.entries[id]
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	if mutate != nil {
+		mutate(&e.value)
+	}
+	c.transition(e, statuses)
+	return e.value, true
+}
+
+// delete moves the object through statuses and removes it on the read after
+// the last one. Without statuses it is removed right away.
+func (c *collection[T]) delete(id string, statuses ...string) bool {
+	e, ok := c.entries[id]
+	if !ok {
+		return false
+	}
+	if len(statuses) == 0 {
+		c.remove(id)
+		return true
+	}
+	e.deleting = true
+	c.transition(e, statuses)
+	return true
+}
+
+func (c *collection[T]) transition(e *entry[T], statuses []string) {
+	if len(statuses) == 0 {
+		return
+	}
+	c.setStatus(&e.value, statuses[0])
+	e.pending = statuses[1:]
+}
+
+func (c *collection[T]) remove(id string) {
+	delete(c.entries, id)
+	for i, current := range c.ids {
+		if current == id {
+			c.ids = append(c.ids[:i], c.ids[i+1:]...)
+			break
+		}
+	}
+}
+
+// list returns the stored objects in creation order, without advancing them.
+func (c *collection[T]) list() []T {
+	values := make([]T, 0, len(c.ids))
+	for _, id := range c.ids {
+		values = append(values, c.entries[id].value)
+	}
+	return values
+}
+
+func (c *collection[T]) exists(id string) bool {
+	e, ok := c.entries[id]
+	return ok && !e.deleting
+}
+
+// page is the slice of a list selected by the _offset and _limit query
+// parameters.
+type page struct {
+	offset, limit, count, total int
+}
+
+func paginate[T any](r *http.Request, items []T) ([]T, page) {
+	p := page{limit: defaultPageLimit, total: len(items)}
+	if v, err := strconv.Atoi(r.URL.Query().Get("_offset")); err == nil && v > 0 {
+		p.offset = v
+	}
+	if v, err := strconv.Atoi(r.URL.Query().Get("_limit")); err == nil && v > 0 {
+		p.limit = v
+	}
+
+	start := min(p.offset, len(items))
+	end := min(start+p.limit, len(items))
+	items = items[start:end]
+	p.count = len(items)
+	return items, p
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, format string, args ...any) {
+	writeJSON(w, status, map[string]string{
+		"error":   http.StatusText(status),
+		"message": fmt.Sprintf(format, args...),
+	})
+}
+
+func notFound(w http.ResponseWriter, kind, id string) {
+	writeError(w, http.StatusNotFound, "%s %s not found", kind, id)
+}
+
+// decode reads the JSON request body into v, answering 400 when it is
+// invalid.
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid request body: %s", err)
+		return false
+	}
+	return true
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func valueOr[T any](v *T, fallback T) T {
+	if v == nil {
+		return fallback
+	}
+	return *v
+}
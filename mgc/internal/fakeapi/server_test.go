@@ -0,0 +1,177 @@
+package fakeapi
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	sdk "github.com/MagaluCloud/mgc-sdk-go/client"
+	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+	dbSdk "github.com/MagaluCloud/mgc-sdk-go/dbaas"
+	lbSdk "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	netSdk "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+func newClient(t *testing.T) (*Server, *sdk.CoreClient) {
+	t.Helper()
+	srv := NewServer()
+	t.Cleanup(srv.Close)
+	return srv, sdk.NewMgcClient(
+		sdk.WithAPIKey("test"),
+		sdk.WithBaseURL(sdk.MgcUrl(srv.URL)),
+		sdk.WithRetryConfig(1, sdk.DefaultInitialInterval, sdk.DefaultMaxInterval, sdk.DefaultBackoffFactor),
+	)
+}
+
+func TestServer_RequiresAPIKey(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/compute/v1/instances")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "fake-1", resp.Header.Get("X-Request-ID"))
+}
+
+func TestServer_InstanceLifecycle(t *testing.T) {
+	ctx := context.Background()
+	srv, core := newClient(t)
+	instances := computeSdk.New(core).Instances()
+
+	id, err := instances.Create(ctx, computeSdk.CreateRequest{
+		Name:        "vm",
+		MachineType: computeSdk.IDOrName{Name: ptr(MachineType)},
+		Image:       computeSdk.IDOrName{Name: ptr(Image)},
+	})
+	require.NoError(t, err)
+
+	instance, err := instances.Get(ctx, id, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "provisioning", instance.Status)
+	instance, err = instances.Get(ctx, id, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "completed", instance.Status)
+	assert.Equal(t, srv.network.defaultVPC, *instance.Network.Vpc.ID)
+
+	require.NoError(t, instances.Delete(ctx, id, false))
+	assert.False(t, srv.Exists(id))
+	instance, err = instances.Get(ctx, id, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "deleting", instance.Status)
+	_, err = instances.Get(ctx, id, nil)
+	assert.True(t, utils.IsNotFound(err))
+}
+
+func TestServer_InstanceUnknownMachineType(t *testing.T) {
+	_, core := newClient(t)
+
+	_, err := computeSdk.New(core).Instances().Create(context.Background(), computeSdk.CreateRequest{
+		Name:        "vm",
+		MachineType: computeSdk.IDOrName{Name: ptr("unknown")},
+		Image:       computeSdk.IDOrName{Name: ptr(Image)},
+	})
+
+	var httpErr *sdk.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.StatusCode)
+}
+
+func TestServer_VPCNameConflict(t *testing.T) {
+	ctx := context.Background()
+	srv, core := newClient(t)
+	vpcs := netSdk.New(core).VPCs()
+
+	id, err := vpcs.Create(ctx, netSdk.CreateVPCRequest{Name: "vpc"})
+	require.NoError(t, err)
+	assert.True(t, srv.Exists(id))
+
+	_, err = vpcs.Create(ctx, netSdk.CreateVPCRequest{Name: "vpc"})
+	var httpErr *sdk.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusConflict, httpErr.StatusCode)
+
+	assert.Error(t, vpcs.Delete(ctx, srv.network.defaultVPC))
+}
+
+func TestServer_DBaaSCatalog(t *testing.T) {
+	ctx := context.Background()
+	_, core := newClient(t)
+	client := dbSdk.New(core)
+
+	engines, err := client.Engines().ListAll(ctx, dbSdk.EngineFilterOptions{})
+	require.NoError(t, err)
+	require.NotEmpty(t, engines)
+	assert.Equal(t, DBaaSEngine, engines[0].Name)
+
+	types, err := client.InstanceTypes().ListAll(ctx, dbSdk.InstanceTypeFilterOptions{EngineID: &engines[0].ID})
+	require.NoError(t, err)
+	labels := []string{}
+	for _, it := range types {
+		labels = append(labels, it.Label)
+	}
+	assert.Contains(t, labels, DBaaSInstanceType)
+}
+
+func TestServer_DBaaSDeletionProtection(t *testing.T) {
+	ctx := context.Background()
+	srv, core := newClient(t)
+	instances := dbSdk.New(core).Instances()
+
+	created, err := instances.Create(ctx, dbSdk.InstanceCreateRequest{
+		Name:              "db",
+		EngineID:          &srv.dbaas.engines[0].ID,
+		InstanceTypeID:    &srv.dbaas.instanceTypes[0].ID,
+		User:              "admin",
+		Password:          "password",
+		Volume:            dbSdk.InstanceVolumeRequest{Size: 20},
+		DeletionProtected: ptr(true),
+	})
+	require.NoError(t, err)
+
+	var httpErr *sdk.HTTPError
+	require.ErrorAs(t, instances.Delete(ctx, created.ID), &httpErr)
+	assert.Equal(t, http.StatusConflict, httpErr.StatusCode)
+	assert.True(t, srv.Exists(created.ID))
+}
+
+func TestServer_LoadBalancerReferences(t *testing.T) {
+	ctx := context.Background()
+	srv, core := newClient(t)
+	lbs := lbSdk.New(core).NetworkLoadBalancers()
+
+	request := lbSdk.CreateNetworkLoadBalancerRequest{
+		Name:       "lb",
+		Visibility: lbSdk.LoadBalancerVisibilityInternal,
+		VPCID:      srv.network.defaultVPC,
+		HealthChecks: []lbSdk.CreateNetworkHealthCheckRequest{
+			{Name: "hc", Protocol: lbSdk.HealthCheckProtocolTCP, Port: 80},
+		},
+		Backends: []lbSdk.CreateNetworkBackendRequest{
+			{Name: "backend", HealthCheckName: ptr("hc"), BalanceAlgorithm: lbSdk.BackendBalanceAlgorithmRoundRobin, TargetsType: lbSdk.BackendTypeRaw},
+		},
+		Listeners: []lbSdk.NetworkListenerRequest{
+			{Name: "listener", BackendName: "backend", Protocol: lbSdk.ListenerProtocolTCP, Port: 80},
+		},
+	}
+	id, err := lbs.Create(ctx, request)
+	require.NoError(t, err)
+
+	lb, err := lbs.Get(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, lbSdk.LoadBalancerStatusCreating, lb.Status)
+	assert.Equal(t, lb.Backends[0].ID, lb.Listeners[0].BackendID)
+	assert.Equal(t, lb.HealthChecks[0].ID, *lb.Backends[0].HealthCheckID)
+	assert.Equal(t, 30, lb.HealthChecks[0].IntervalSeconds)
+
+	request.Listeners[0].BackendName = "unknown"
+	_, err = lbs.Create(ctx, request)
+	var httpErr *sdk.HTTPError
+	require.ErrorAs(t, err, &httpErr)
+	assert.Equal(t, http.StatusBadRequest, httpErr.StatusCode)
+}
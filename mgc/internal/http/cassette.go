@@ -0,0 +1,307 @@
+package http
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const cassetteVersion = 1
+
+// cassette is the file written by RecordRoundTripper. Each provider process
+// appends its own session, since terraform runs plan and apply in separate
+// processes, and ReplayRoundTripper walks the sessions in the same order.
+type cassette struct {
+	Version  int               `json:"version"`
+	Sessions []cassetteSession `json:"sessions"`
+}
+
+type cassetteSession struct {
+	RecordedAt   time.Time     `json:"recorded_at"`
+	Interactions []interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  recordedRequest   `json:"request"`
+	Response *recordedResponse `json:"response,omitempty"`
+	Error    string            `json:"error,omitempty"`
+}
+
+type recordedRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+type recordedResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    string            `json:"body,omitempty"`
+}
+
+func loadCassette(path string) (*cassette, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var c cassette
+	if err := json.Unmarshal(b, &c); err != nil {
+		return nil, fmt.Errorf("invalid cassette %s: %w", path, err)
+	}
+	if c.Version != cassetteVersion {
+		return nil, fmt.Errorf("cassette %s has version %d, expected %d", path, c.Version, cassetteVersion)
+	}
+	return &c, nil
+}
+
+// save replaces the file in one rename, so an interrupted apply still leaves
+// a readable cassette.
+func (c *cassette) save(path string) error {
+	b, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(append(b, '\n')); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// scrubBody returns the body as stored in a cassette: JSON with the same keys
+// redacted as in the debug logs, or a placeholder for any other content,
+// such as kubeconfig YAML, that may hold credentials.
+func scrubBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	var value any
+	if (mediaType != "" && mediaType != "application/json" && !strings.HasSuffix(mediaType, "+json")) ||
+		json.Unmarshal(body, &value) != nil {
+		return redacted
+	}
+
+	out, err := json.Marshal(redactJSON(value))
+	if err != nil {
+		return redacted
+	}
+	return string(out)
+}
+
+// interactionKey identifies a request regardless of the region URL or custom
+// endpoint it was sent to: the path is kept from the service segment on.
+func interactionKey(method, rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return method + " " + rawURL
+	}
+	p := u.Path
+	if _, index := serviceSegment(p); index >= 0 {
+		p = p[index:]
+	}
+	return method + " " + p + "?" + u.RawQuery
+}
+
+// RecordRoundTripper saves every request and response to a cassette file,
+// with credentials and secrets scrubbed, so the exchange can be replayed by
+// ReplayRoundTripper.
+type RecordRoundTripper struct {
+	next http.RoundTripper
+	path string
+
+	mu       sync.Mutex
+	cassette *cassette
+	session  int
+}
+
+// NewRecordRoundTripper appends a new session to the cassette at path,
+// creating the file if needed. The file is rewritten after each interaction.
+func NewRecordRoundTripper(next http.RoundTripper, path string) (http.RoundTripper, error) {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	c, err := loadCassette(path)
+	if errors.Is(err, os.ErrNotExist) {
+		c, err = &cassette{Version: cassetteVersion}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &RecordRoundTripper{
+		next:     next,
+		path:     path,
+		cassette: c,
+		session:  -1,
+	}, nil
+}
+
+func (rt *RecordRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, req, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+
+	recorded := interaction{
+		Request: recordedRequest{
+			Method:  req.Method,
+			URL:     req.URL.String(),
+			Headers: redactHeaders(req.Header),
+			Body:    scrubBody(req.Header.Get("Content-Type"), reqBody),
+		},
+	}
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		recorded.Error = err.Error()
+	} else {
+		respBody, peekErr := peekResponseBody(resp)
+		if peekErr != nil {
+			return nil, peekErr
+		}
+		recorded.Response = &recordedResponse{
+			Status:  resp.StatusCode,
+			Headers: redactHeaders(resp.Header),
+			Body:    scrubBody(resp.Header.Get("Content-Type"), respBody),
+		}
+	}
+
+	if saveErr := rt.append(recorded); saveErr != nil {
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return nil, fmt.Errorf("recording API interaction to %s: %w", rt.path, saveErr)
+	}
+	return resp, err
+}
+
+func (rt *RecordRoundTripper) append(recorded interaction) error {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if rt.session < 0 {
+		rt.cassette.Sessions = append(rt.cassette.Sessions, cassetteSession{RecordedAt: time.Now().UTC()})
+		rt.session = len(rt.cassette.Sessions) - 1
+	}
+	session := &rt.cassette.Sessions[rt.session]
+	session.Interactions = append(session.Interactions, recorded)
+	return rt.cassette.save(rt.path)
+}
+
+// ReplayRoundTripper answers requests from a cassette written by
+// RecordRoundTripper without sending them. A request gets the first unused
+// interaction with the same method, path and query, preferring one with the
+// same body, so polling replays the recorded sequence of statuses. Sessions
+// are used in order: a process starts in the first session holding its first
+// request and moves on when a request is only found in a later session.
+type ReplayRoundTripper struct {
+	path string
+
+	mu       sync.Mutex
+	sessions []cassetteSession
+	used     [][]bool
+	current  int
+}
+
+func NewReplayRoundTripper(path string) (http.RoundTripper, error) {
+	c, err := loadCassette(path)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make([][]bool, len(c.Sessions))
+	for i, session := range c.Sessions {
+		used[i] = make([]bool, len(session.Interactions))
+	}
+	return &ReplayRoundTripper{
+		path:     path,
+		sessions: c.Sessions,
+		used:     used,
+	}, nil
+}
+
+func (rt *ReplayRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqBody, req, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	recorded, ok := rt.take(interactionKey(req.Method, req.URL.String()), scrubBody(req.Header.Get("Content-Type"), reqBody))
+	if !ok {
+		return nil, fmt.Errorf("no interaction left in cassette %s for %s %s", rt.path, req.Method, req.URL)
+	}
+	if recorded.Response == nil {
+		return nil, errors.New(recorded.Error)
+	}
+
+	header := http.Header{}
+	for name, value := range recorded.Response.Headers {
+		header.Set(name, value)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", recorded.Response.Status, http.StatusText(recorded.Response.Status)),
+		StatusCode:    recorded.Response.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader([]byte(recorded.Response.Body))),
+		ContentLength: int64(len(recorded.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (rt *ReplayRoundTripper) take(key, body string) (interaction, bool) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	for s := rt.current; s < len(rt.sessions); s++ {
+		match := -1
+		for i, recorded := range rt.sessions[s].Interactions {
+			if rt.used[s][i] || interactionKey(recorded.Request.Method, recorded.Request.URL) != key {
+				continue
+			}
+			if recorded.Request.Body == body {
+				match = i
+				break
+			}
+			if match < 0 {
+				match = i
+			}
+		}
+		if match >= 0 {
+			rt.current = s
+			rt.used[s][match] = true
+			return rt.sessions[s].Interactions[match], true
+		}
+	}
+	return interaction{}, false
+}
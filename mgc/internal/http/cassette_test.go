@@ -0,0 +1,178 @@
+package http
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func doRequest(t *testing.T, rt http.RoundTripper, method, url, body string) (*http.Response, string) {
+	t.Helper()
+	var reader io.Reader
+	if body != "" {
+		reader = strings.NewReader(body)
+	}
+	req, err := http.NewRequest(method, url, reader)
+	require.NoError(t, err)
+	req.Header.Set("X-Api-Key", "my-key")
+	if body != "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := (&http.Client{Transport: rt}).Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	b, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return resp, string(b)
+}
+
+func TestRecordRoundTripper_ScrubsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/kubeconfig") {
+			w.Header().Set("Content-Type", "application/yaml")
+			w.Write([]byte("users:\n- user:\n    token: abc\n"))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Set-Cookie", "session=1")
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":"db-1","password":"p"}`))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	rt, err := NewRecordRoundTripper(http.DefaultTransport, path)
+	require.NoError(t, err)
+
+	_, body := doRequest(t, rt, http.MethodPost, server.URL+"/br-se1/database/v2/instances", `{"name":"db","password":"hunter2"}`)
+	assert.Equal(t, `{"id":"db-1","password":"p"}`, body, "the caller must receive the original body")
+	_, body = doRequest(t, rt, http.MethodGet, server.URL+"/br-se1/kubernetes/v0/clusters/c-1/kubeconfig", "")
+	assert.Contains(t, body, "token: abc")
+
+	c, err := loadCassette(path)
+	require.NoError(t, err)
+	require.Len(t, c.Sessions, 1)
+	require.Len(t, c.Sessions[0].Interactions, 2)
+
+	created := c.Sessions[0].Interactions[0]
+	assert.Equal(t, redacted, created.Request.Headers["X-Api-Key"])
+	assert.Equal(t, `{"name":"db","password":"[REDACTED]"}`, created.Request.Body)
+	assert.Equal(t, http.StatusCreated, created.Response.Status)
+	assert.Equal(t, redacted, created.Response.Headers["Set-Cookie"])
+	assert.Equal(t, `{"id":"db-1","password":"[REDACTED]"}`, created.Response.Body)
+	assert.Equal(t, redacted, c.Sessions[0].Interactions[1].Response.Body)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	for _, secret := range []string{"my-key", "hunter2", "session=1", "token: abc"} {
+		assert.NotContains(t, string(raw), secret)
+	}
+
+	rt, err = NewRecordRoundTripper(http.DefaultTransport, path)
+	require.NoError(t, err)
+	doRequest(t, rt, http.MethodGet, server.URL+"/br-se1/database/v2/instances/db-1", "")
+	c, err = loadCassette(path)
+	require.NoError(t, err)
+	assert.Len(t, c.Sessions, 2, "a new run appends its own session")
+}
+
+func TestReplayRoundTripper_ReplaysInOrder(t *testing.T) {
+	statuses := []string{"creating", "active"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "req-1")
+		switch r.Method {
+		case http.MethodPost:
+			body, _ := io.ReadAll(r.Body)
+			w.Write([]byte(`{"id":"` + strings.TrimSuffix(strings.TrimPrefix(string(body), `{"name":"`), `"}`) + `"}`))
+		case http.MethodGet:
+			w.Write([]byte(`{"status":"` + statuses[0] + `"}`))
+			statuses = statuses[1:]
+		}
+	}))
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	record, err := NewRecordRoundTripper(http.DefaultTransport, path)
+	require.NoError(t, err)
+	doRequest(t, record, http.MethodPost, server.URL+"/br-se1/compute/v1/instances", `{"name":"a"}`)
+	doRequest(t, record, http.MethodPost, server.URL+"/br-se1/compute/v1/instances", `{"name":"b"}`)
+	doRequest(t, record, http.MethodGet, server.URL+"/br-se1/compute/v1/instances/a", "")
+	doRequest(t, record, http.MethodGet, server.URL+"/br-se1/compute/v1/instances/a", "")
+	server.Close()
+
+	replay, err := NewReplayRoundTripper(path)
+	require.NoError(t, err)
+
+	// Another endpoint and request order: matching ignores the host and the
+	// path prefix, and bodies pick between requests to the same URL.
+	resp, body := doRequest(t, replay, http.MethodPost, "https://api.example.com/compute/v1/instances", `{"name":"b"}`)
+	assert.Equal(t, `{"id":"b"}`, body)
+	assert.Equal(t, "req-1", resp.Header.Get("X-Request-Id"))
+	_, body = doRequest(t, replay, http.MethodPost, "https://api.example.com/compute/v1/instances", `{"name":"a"}`)
+	assert.Equal(t, `{"id":"a"}`, body)
+
+	_, body = doRequest(t, replay, http.MethodGet, "https://api.example.com/compute/v1/instances/a", "")
+	assert.Equal(t, `{"status":"creating"}`, body)
+	_, body = doRequest(t, replay, http.MethodGet, "https://api.example.com/compute/v1/instances/a", "")
+	assert.Equal(t, `{"status":"active"}`, body)
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.example.com/compute/v1/instances/a", nil)
+	require.NoError(t, err)
+	_, err = replay.RoundTrip(req)
+	assert.ErrorContains(t, err, "no interaction left in cassette")
+}
+
+func TestReplayRoundTripper_Sessions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	get := func(body string) interaction {
+		return interaction{
+			Request:  recordedRequest{Method: http.MethodGet, URL: "https://api.magalu.cloud/br-se1/network/v0/vpcs/v-1"},
+			Response: &recordedResponse{Status: http.StatusOK, Body: body},
+		}
+	}
+	c := &cassette{Version: cassetteVersion, Sessions: []cassetteSession{
+		{Interactions: []interaction{get(`{"name":"plan"}`)}},
+		{Interactions: []interaction{
+			{
+				Request:  recordedRequest{Method: http.MethodPatch, URL: "https://api.magalu.cloud/br-se1/network/v0/vpcs/v-1"},
+				Response: &recordedResponse{Status: http.StatusNoContent},
+			},
+			get(`{"name":"apply"}`),
+		}},
+	}}
+	require.NoError(t, c.save(path))
+
+	// The apply process starts with the update, in the second session, and
+	// must not replay the read recorded by the plan process.
+	replay, err := NewReplayRoundTripper(path)
+	require.NoError(t, err)
+	resp, _ := doRequest(t, replay, http.MethodPatch, "https://api.magalu.cloud/br-se1/network/v0/vpcs/v-1", `{}`)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	_, body := doRequest(t, replay, http.MethodGet, "https://api.magalu.cloud/br-se1/network/v0/vpcs/v-1", "")
+	assert.Equal(t, `{"name":"apply"}`, body)
+
+	replay, err = NewReplayRoundTripper(path)
+	require.NoError(t, err)
+	_, body = doRequest(t, replay, http.MethodGet, "https://api.magalu.cloud/br-se1/network/v0/vpcs/v-1", "")
+	assert.Equal(t, `{"name":"plan"}`, body)
+}
+
+func TestReplayRoundTripper_InvalidCassette(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"version":2}`), 0o600))
+
+	_, err := NewReplayRoundTripper(path)
+	assert.ErrorContains(t, err, "version 2")
+	_, err = NewRecordRoundTripper(nil, path)
+	assert.Error(t, err)
+	_, err = NewReplayRoundTripper(filepath.Join(t.TempDir(), "missing.json"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,219 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	redacted = "[REDACTED]"
+
+	// maxDebugBodySize bounds the logged part of a body; the whole body is
+	// still sent and returned.
+	maxDebugBodySize = 64 * 1024
+)
+
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Api-Key",
+}
+
+// sensitiveKeyParts match JSON keys once lowercased and stripped of "_" and
+// "-", so api_key, apiKey and X-Api-Key are all redacted.
+var sensitiveKeyParts = []string{
+	"apikey",
+	"password",
+	"secret",
+	"privatekey",
+	"token",
+	"userdata",
+	"accesskey",
+}
+
+// DebugRoundTripper logs the method, headers and JSON body of every request
+// and response at TRACE level. Credentials, passwords, private keys and
+// user_data are redacted, and non JSON bodies are only described.
+type DebugRoundTripper struct {
+	next http.RoundTripper
+	log  func(ctx context.Context, msg string, fields map[string]any)
+}
+
+func NewDebugRoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &DebugRoundTripper{
+		next: next,
+		log: func(ctx context.Context, msg string, fields map[string]any) {
+			tflog.Trace(ctx, msg, fields)
+		},
+	}
+}
+
+func (rt *DebugRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	reqBody, req, err := peekRequestBody(req)
+	if err != nil {
+		return nil, err
+	}
+	rt.log(ctx, "API request", map[string]any{
+		"method":  req.Method,
+		"url":     req.URL.String(),
+		"headers": redactHeaders(req.Header),
+		"body":    describeBody(req.Header.Get("Content-Type"), reqBody),
+	})
+
+	start := time.Now()
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil {
+		rt.log(ctx, "API request failed", map[string]any{
+			"method":   req.Method,
+			"url":      req.URL.String(),
+			"error":    err.Error(),
+			"duration": time.Since(start).String(),
+		})
+		return resp, err
+	}
+
+	respBody, err := peekResponseBody(resp)
+	if err != nil {
+		return nil, err
+	}
+	rt.log(ctx, "API response", map[string]any{
+		"method":   req.Method,
+		"url":      req.URL.String(),
+		"status":   resp.StatusCode,
+		"duration": time.Since(start).String(),
+		"headers":  redactHeaders(resp.Header),
+		"body":     describeBody(resp.Header.Get("Content-Type"), respBody),
+	})
+
+	return resp, nil
+}
+
+// peekRequestBody returns the request body and a request that can still send
+// it, preferring GetBody so the original request is not consumed.
+func peekRequestBody(req *http.Request) ([]byte, *http.Request, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, req, nil
+	}
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, req, err
+		}
+		defer body.Close()
+		b, err := io.ReadAll(body)
+		return b, req, err
+	}
+
+	b, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, req, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Body = io.NopCloser(bytes.NewReader(b))
+	return b, clone, nil
+}
+
+func peekResponseBody(resp *http.Response) ([]byte, error) {
+	if resp.Body == nil || resp.Body == http.NoBody {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(b))
+	return b, nil
+}
+
+func redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+	for name, values := range header {
+		value := strings.Join(values, ", ")
+		for _, sensitive := range sensitiveHeaders {
+			if strings.EqualFold(name, sensitive) {
+				value = redacted
+				break
+			}
+		}
+		out[name] = value
+	}
+	return out
+}
+
+// describeBody renders JSON bodies with secrets redacted. Other bodies, such
+// as kubeconfig YAML, may hold credentials that cannot be found reliably, so
+// only their type and size are logged.
+func describeBody(contentType string, body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	isJSON := mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+
+	var value any
+	if (!isJSON && mediaType != "") || json.Unmarshal(body, &value) != nil {
+		if mediaType == "" {
+			mediaType = "unknown content type"
+		}
+		return fmt.Sprintf("<%s, %d bytes not logged>", mediaType, len(body))
+	}
+
+	out, err := json.Marshal(redactJSON(value))
+	if err != nil {
+		return fmt.Sprintf("<%d bytes not logged>", len(body))
+	}
+	if len(out) > maxDebugBodySize {
+		return string(out[:maxDebugBodySize]) + "...(truncated)"
+	}
+	return string(out)
+}
+
+func redactJSON(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, item := range v {
+			if isSensitiveKey(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJSON(item)
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+	}
+	return value
+}
+
+func isSensitiveKey(key string) bool {
+	normalized := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(normalized, part) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type loggedMessage struct {
+	msg    string
+	fields map[string]any
+}
+
+func newTestDebugRoundTripper(logged *[]loggedMessage) http.RoundTripper {
+	rt := NewDebugRoundTripper(http.DefaultTransport).(*DebugRoundTripper)
+	rt.log = func(ctx context.Context, msg string, fields map[string]any) {
+		*logged = append(*logged, loggedMessage{msg: msg, fields: fields})
+	}
+	return rt
+}
+
+func TestDebugRoundTripper_LogsAndRedacts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"vm","user_data":"c2VjcmV0","admin":{"Password":"hunter2"}}`, string(body))
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"message":"invalid","credentials":[{"username":"u","password":"p"}],"private_key":"-----BEGIN"}`))
+	}))
+	defer server.Close()
+
+	var logged []loggedMessage
+	client := &http.Client{Transport: newTestDebugRoundTripper(&logged)}
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/compute/v1/instances",
+		strings.NewReader(`{"name":"vm","user_data":"c2VjcmV0","admin":{"Password":"hunter2"}}`))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Api-Key", "my-key")
+
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"password":"p"`, "the caller must receive the original body")
+
+	require.Len(t, logged, 2)
+
+	request := logged[0].fields
+	assert.Equal(t, http.MethodPost, request["method"])
+	assert.Equal(t, redacted, request["headers"].(map[string]string)["X-Api-Key"])
+	assert.Equal(t, `{"admin":{"Password":"[REDACTED]"},"name":"vm","user_data":"[REDACTED]"}`, request["body"])
+
+	response := logged[1].fields
+	assert.Equal(t, http.StatusUnprocessableEntity, response["status"])
+	assert.Equal(t, `{"credentials":[{"password":"[REDACTED]","username":"u"}],"message":"invalid","private_key":"[REDACTED]"}`, response["body"])
+}
+
+func TestDebugRoundTripper_NonJSONBodyNotLogged(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		w.Write([]byte("users:\n- user:\n    client-key-data: c2VjcmV0\n"))
+	}))
+	defer server.Close()
+
+	var logged []loggedMessage
+	client := &http.Client{Transport: newTestDebugRoundTripper(&logged)}
+
+	resp, err := client.Get(server.URL + "/kubernetes/v0/clusters/1/kubeconfig")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Len(t, logged, 2)
+	assert.Equal(t, "<application/yaml, 45 bytes not logged>", logged[1].fields["body"])
+	assert.NotContains(t, logged[1].fields["body"], "c2VjcmV0")
+}
+
+func TestIsSensitiveKey(t *testing.T) {
+	for _, key := range []string{"api_key", "apiKey", "password", "new_password", "private_key", "user_data", "access_secret", "access_key", "token"} {
+		assert.True(t, isSensitiveKey(key), key)
+	}
+	for _, key := range []string{"name", "username", "public_key", "key_type", "machine_type"} {
+		assert.False(t, isSensitiveKey(key), key)
+	}
+}
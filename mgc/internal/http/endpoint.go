@@ -0,0 +1,93 @@
+package http
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func EndpointServices() []string {
+	return []string{
+		ServiceCompute, ServiceNetwork, ServiceDbaas, ServiceKubernetes, ServiceLbaas,
+		ServiceBlockStorage, ServiceContainerRegistry, ServiceProfile,
+	}
+}
+
+// EndpointConfig redirects API requests away from the region URLs. Endpoints
+// replace everything before the service path segment, so a request to
+// https://api.magalu.cloud/br-se1/compute/v1/instances sent to the endpoint
+// http://localhost:8080 becomes http://localhost:8080/compute/v1/instances.
+type EndpointConfig struct {
+	// Default is used by every service without an entry in Services. Empty
+	// keeps the URL built by the SDK.
+	Default  string
+	Services map[string]string
+}
+
+type EndpointRoundTripper struct {
+	next     http.RoundTripper
+	fallback *url.URL
+	services map[string]*url.URL
+}
+
+// NewEndpointRoundTripper expects endpoints already checked by utils.ValidateEndpointURL;
+// unparsable ones are ignored.
+func NewEndpointRoundTripper(next http.RoundTripper, config EndpointConfig) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	rt := &EndpointRoundTripper{
+		next:     next,
+		services: map[string]*url.URL{},
+	}
+	if u, err := url.Parse(config.Default); err == nil && config.Default != "" {
+		rt.fallback = u
+	}
+	for service, endpoint := range config.Services {
+		if u, err := url.Parse(endpoint); err == nil && endpoint != "" {
+			rt.services[service] = u
+		}
+	}
+
+	return rt
+}
+
+func (rt *EndpointRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	service, index := serviceSegment(req.URL.Path)
+	if index < 0 {
+		return rt.next.RoundTrip(req)
+	}
+
+	endpoint, ok := rt.services[service]
+	if !ok {
+		endpoint = rt.fallback
+	}
+	if endpoint == nil {
+		return rt.next.RoundTrip(req)
+	}
+
+	target := *req.URL
+	target.Scheme = endpoint.Scheme
+	target.Host = endpoint.Host
+	target.User = endpoint.User
+	target.Path = strings.TrimSuffix(endpoint.Path, "/") + req.URL.Path[index:]
+	target.RawPath = ""
+
+	if target.String() == req.URL.String() {
+		return rt.next.RoundTrip(req)
+	}
+
+	tflog.Trace(req.Context(), "Redirecting API request to custom endpoint", map[string]any{
+		"service": service,
+		"from":    req.URL.String(),
+		"to":      target.String(),
+	})
+
+	outReq := req.Clone(req.Context())
+	outReq.URL = &target
+	outReq.Host = ""
+	return rt.next.RoundTrip(outReq)
+}
@@ -0,0 +1,56 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpointRoundTripper(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.RequestURI())
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewEndpointRoundTripper(http.DefaultTransport, EndpointConfig{
+		Default:  server.URL + "/mock",
+		Services: map[string]string{ServiceNetwork: server.URL + "/net/"},
+	})}
+
+	for _, u := range []string{
+		"https://api.magalu.cloud/br-se1/compute/v1/instances?_limit=10",
+		"https://api.magalu.cloud/br-se1/network/v0/vpcs",
+		"https://api.magalu.cloud/profile/v0/ssh-keys",
+	} {
+		resp, err := client.Get(u)
+		require.NoError(t, err)
+		resp.Body.Close()
+	}
+
+	assert.Equal(t, []string{
+		"/mock/compute/v1/instances?_limit=10",
+		"/net/network/v0/vpcs",
+		"/mock/profile/v0/ssh-keys",
+	}, paths)
+}
+
+func TestEndpointRoundTripper_NoEndpoints(t *testing.T) {
+	var called bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		called = true
+		assert.Equal(t, "/br-se1/compute/v1/instances", r.URL.Path)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: NewEndpointRoundTripper(http.DefaultTransport, EndpointConfig{})}
+
+	resp, err := client.Get(server.URL + "/br-se1/compute/v1/instances")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.True(t, called)
+}
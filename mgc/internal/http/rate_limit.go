@@ -0,0 +1,165 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/time/rate"
+)
+
+func RateLimitedServices() []string {
+	return []string{ServiceCompute, ServiceNetwork, ServiceDbaas, ServiceKubernetes, ServiceLbaas}
+}
+
+// RateLimit caps requests per second and concurrent requests. Zero values mean unlimited.
+type RateLimit struct {
+	RequestsPerSecond float64
+	MaxInFlight       int
+}
+
+func (l RateLimit) enabled() bool {
+	return l.RequestsPerSecond > 0 || l.MaxInFlight > 0
+}
+
+type RateLimitConfig struct {
+	Global   RateLimit
+	Services map[string]RateLimit
+}
+
+type limiter struct {
+	name  string
+	rate  *rate.Limiter
+	slots chan struct{}
+}
+
+func newLimiter(name string, l RateLimit) *limiter {
+	out := &limiter{name: name}
+	if l.RequestsPerSecond > 0 {
+		out.rate = rate.NewLimiter(rate.Limit(l.RequestsPerSecond), max(1, int(l.RequestsPerSecond)))
+	}
+	if l.MaxInFlight > 0 {
+		out.slots = make(chan struct{}, l.MaxInFlight)
+	}
+	return out
+}
+
+func (l *limiter) acquire(ctx context.Context) error {
+	if l.slots != nil {
+		select {
+		case l.slots <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if l.rate != nil {
+		if err := l.rate.Wait(ctx); err != nil {
+			l.release()
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *limiter) release() {
+	if l.slots != nil {
+		<-l.slots
+	}
+}
+
+type RateLimitRoundTripper struct {
+	next     http.RoundTripper
+	global   *limiter
+	services map[string]*limiter
+}
+
+func NewRateLimitRoundTripper(next http.RoundTripper, config RateLimitConfig) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	rt := &RateLimitRoundTripper{
+		next:     next,
+		services: map[string]*limiter{},
+	}
+	if config.Global.enabled() {
+		rt.global = newLimiter("global", config.Global)
+	}
+	for service, l := range config.Services {
+		if l.enabled() {
+			rt.services[service] = newLimiter(service, l)
+		}
+	}
+
+	return rt
+}
+
+func (rt *RateLimitRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	limiters := []*limiter{}
+	if rt.global != nil {
+		limiters = append(limiters, rt.global)
+	}
+	service, _ := serviceSegment(req.URL.Path)
+	if l, ok := rt.services[service]; ok {
+		limiters = append(limiters, l)
+	}
+	if len(limiters) == 0 {
+		return rt.next.RoundTrip(req)
+	}
+
+	start := time.Now()
+	acquired := make([]*limiter, 0, len(limiters))
+	releaseAll := func() {
+		for _, l := range acquired {
+			l.release()
+		}
+	}
+	for _, l := range limiters {
+		if err := l.acquire(ctx); err != nil {
+			releaseAll()
+			return nil, err
+		}
+		acquired = append(acquired, l)
+	}
+
+	if wait := time.Since(start); wait >= time.Millisecond {
+		names := make([]string, 0, len(acquired))
+		for _, l := range acquired {
+			names = append(names, l.name)
+		}
+		tflog.Debug(ctx, "API request delayed by client-side rate limit", map[string]any{
+			"method":   req.Method,
+			"url":      req.URL.String(),
+			"limiters": strings.Join(names, ","),
+			"wait":     wait.String(),
+		})
+	}
+
+	resp, err := rt.next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		releaseAll()
+		return resp, err
+	}
+
+	// In-flight slots are held until the response body is consumed.
+	resp.Body = &releaseOnClose{ReadCloser: resp.Body, release: releaseAll}
+	return resp, nil
+}
+
+type releaseOnClose struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releaseOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
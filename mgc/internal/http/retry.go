@@ -0,0 +1,198 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultRetryMaxAttempts = 3
+	DefaultRetryMinBackoff  = 1 * time.Second
+	DefaultRetryMaxBackoff  = 30 * time.Second
+)
+
+var DefaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// unhandledStatusCodes are answered before the API handles the request, so
+// they are retried for every method. Gateway errors may come after the API
+// created an object, and are only retried for idempotent requests.
+var unhandledStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusServiceUnavailable,
+}
+
+// IdempotencyKeyHeader makes a request with a non-idempotent method safe to
+// retry, as the API handles it at most once.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+var idempotentMethods = []string{
+	http.MethodGet,
+	http.MethodHead,
+	http.MethodOptions,
+	http.MethodPut,
+	http.MethodDelete,
+}
+
+type RetryConfig struct {
+	MaxAttempts          int
+	MinBackoff           time.Duration
+	MaxBackoff           time.Duration
+	RetryableStatusCodes []int
+	RespectRetryAfter    bool
+}
+
+func DefaultRetryConfig() RetryConfig {
+	return RetryConfig{
+		MaxAttempts:          DefaultRetryMaxAttempts,
+		MinBackoff:           DefaultRetryMinBackoff,
+		MaxBackoff:           DefaultRetryMaxBackoff,
+		RetryableStatusCodes: slices.Clone(DefaultRetryableStatusCodes),
+		RespectRetryAfter:    true,
+	}
+}
+
+type RetryRoundTripper struct {
+	next   http.RoundTripper
+	config RetryConfig
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+func NewRetryRoundTripper(next http.RoundTripper, config RetryConfig) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if config.MaxAttempts < 1 {
+		config.MaxAttempts = 1
+	}
+
+	return &RetryRoundTripper{
+		next:   next,
+		config: config,
+		sleep:  sleepContext,
+	}
+}
+
+func (rt *RetryRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	var body []byte
+	if req.Body != nil && req.Body != http.NoBody {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for attempt := 1; ; attempt++ {
+		attemptReq := req
+		if body != nil {
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		resp, err := rt.next.RoundTrip(attemptReq)
+		if attempt >= rt.config.MaxAttempts || !rt.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := rt.backoff(attempt, resp)
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		tflog.Warn(ctx, "Retrying API request", map[string]any{
+			"method":       req.Method,
+			"url":          req.URL.String(),
+			"reason":       reason,
+			"attempt":      attempt,
+			"max_attempts": rt.config.MaxAttempts,
+			"wait":         wait.String(),
+		})
+
+		if err := rt.sleep(ctx, wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry retries transport errors and the configured status codes for
+// idempotent requests. Other requests, such as creates, may have reached the
+// API before the connection failed or the gateway timed out, and a retry could
+// create a duplicate object, so they are only retried on the configured codes
+// that are among unhandledStatusCodes.
+func (rt *RetryRoundTripper) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(req)
+	}
+	if !slices.Contains(rt.config.RetryableStatusCodes, resp.StatusCode) {
+		return false
+	}
+	return isIdempotent(req) || slices.Contains(unhandledStatusCodes, resp.StatusCode)
+}
+
+func isIdempotent(req *http.Request) bool {
+	return slices.Contains(idempotentMethods, req.Method) || req.Header.Get(IdempotencyKeyHeader) != ""
+}
+
+func (rt *RetryRoundTripper) backoff(attempt int, resp *http.Response) time.Duration {
+	if rt.config.RespectRetryAfter && resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(wait, rt.config.MaxBackoff)
+		}
+	}
+
+	backoff := float64(rt.config.MinBackoff) * math.Pow(2, float64(attempt-1))
+	backoff = min(backoff, float64(rt.config.MaxBackoff))
+	jitter := rand.Float64() * backoff / 2
+	return time.Duration(backoff/2 + jitter)
+}
+
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("request cancelled while waiting to retry: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
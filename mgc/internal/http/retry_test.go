@@ -0,0 +1,177 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRetryRoundTripper(config RetryConfig, waits *[]time.Duration) http.RoundTripper {
+	rt := NewRetryRoundTripper(http.DefaultTransport, config).(*RetryRoundTripper)
+	rt.sleep = func(ctx context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+	return rt
+}
+
+func TestRetryRoundTripper_RetriesUntilSuccess(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		assert.Equal(t, `{"name":"vm"}`, string(body))
+		switch calls.Add(1) {
+		case 1:
+			w.WriteHeader(http.StatusBadGateway)
+		case 2:
+			w.WriteHeader(http.StatusGatewayTimeout)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	client := &http.Client{Transport: newTestRetryRoundTripper(DefaultRetryConfig(), &waits)}
+
+	req, err := http.NewRequest(http.MethodPut, server.URL, strings.NewReader(`{"name":"vm"}`))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, int32(3), calls.Load())
+	require.Len(t, waits, 2)
+	for _, wait := range waits {
+		assert.LessOrEqual(t, wait, DefaultRetryMaxBackoff)
+	}
+}
+
+func TestRetryRoundTripper_NonIdempotentRequests(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		idempotencyKey string
+		wantCalls      int32
+	}{
+		{name: "gateway timeout is not retried", status: http.StatusGatewayTimeout, wantCalls: 1},
+		{name: "bad gateway is not retried", status: http.StatusBadGateway, wantCalls: 1},
+		{name: "service unavailable is retried", status: http.StatusServiceUnavailable, wantCalls: 2},
+		{name: "too many requests is retried", status: http.StatusTooManyRequests, wantCalls: 2},
+		{name: "gateway timeout with idempotency key is retried", status: http.StatusGatewayTimeout, idempotencyKey: "create-vm-1", wantCalls: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if calls.Add(1) == 1 {
+					w.WriteHeader(tt.status)
+					return
+				}
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			var waits []time.Duration
+			client := &http.Client{Transport: newTestRetryRoundTripper(DefaultRetryConfig(), &waits)}
+
+			req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{"name":"vm"}`))
+			require.NoError(t, err)
+			if tt.idempotencyKey != "" {
+				req.Header.Set(IdempotencyKeyHeader, tt.idempotencyKey)
+			}
+			resp, err := client.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			assert.Equal(t, tt.wantCalls, calls.Load())
+			assert.Len(t, waits, int(tt.wantCalls)-1)
+		})
+	}
+}
+
+func TestRetryRoundTripper_StopsAtMaxAttempts(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	config := DefaultRetryConfig()
+	config.MaxAttempts = 4
+	client := &http.Client{Transport: newTestRetryRoundTripper(config, &waits)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, int32(4), calls.Load())
+	assert.Len(t, waits, 3)
+}
+
+func TestRetryRoundTripper_DoesNotRetryOtherStatusCodes(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	client := &http.Client{Transport: newTestRetryRoundTripper(DefaultRetryConfig(), &waits)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, int32(1), calls.Load())
+	assert.Empty(t, waits)
+}
+
+func TestRetryRoundTripper_HonorsRetryAfter(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var waits []time.Duration
+	client := &http.Client{Transport: newTestRetryRoundTripper(DefaultRetryConfig(), &waits)}
+
+	resp, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	assert.Equal(t, []time.Duration{7 * time.Second}, waits)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	wait, ok := parseRetryAfter("12")
+	assert.True(t, ok)
+	assert.Equal(t, 12*time.Second, wait)
+
+	wait, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Equal(t, time.Duration(0), wait)
+
+	_, ok = parseRetryAfter("soon")
+	assert.False(t, ok)
+}
@@ -0,0 +1,40 @@
+package http
+
+import "strings"
+
+const (
+	ServiceCompute           = "compute"
+	ServiceNetwork           = "network"
+	ServiceDbaas             = "dbaas"
+	ServiceKubernetes        = "kubernetes"
+	ServiceLbaas             = "lbaas"
+	ServiceBlockStorage      = "block_storage"
+	ServiceContainerRegistry = "container_registry"
+	ServiceProfile           = "profile"
+)
+
+// servicePaths maps every service to the API path segment of its SDK client.
+// The profile service serves the global SSH keys and availability zones APIs.
+var servicePaths = map[string]string{
+	ServiceCompute:           "/compute/",
+	ServiceNetwork:           "/network/",
+	ServiceDbaas:             "/database/",
+	ServiceKubernetes:        "/kubernetes/",
+	ServiceLbaas:             "/load-balancer/",
+	ServiceBlockStorage:      "/volume/",
+	ServiceContainerRegistry: "/container-registry/",
+	ServiceProfile:           "/profile/",
+}
+
+// serviceSegment returns the service whose path segment appears first in p and
+// the index where it starts. Later segments, such as a resource named after
+// another service, are ignored.
+func serviceSegment(p string) (string, int) {
+	service, index := "", -1
+	for name, segment := range servicePaths {
+		if i := strings.Index(p, segment); i >= 0 && (index < 0 || i < index) {
+			service, index = name, i
+		}
+	}
+	return service, index
+}
@@ -0,0 +1,36 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceSegment(t *testing.T) {
+	service, index := serviceSegment("/br-se1/compute/v1/instances")
+	assert.Equal(t, ServiceCompute, service)
+	assert.Equal(t, len("/br-se1"), index)
+
+	service, _ = serviceSegment("/br-se1/volume/v1/volumes")
+	assert.Equal(t, ServiceBlockStorage, service)
+
+	service, index = serviceSegment("/profile/v0/ssh-keys")
+	assert.Equal(t, ServiceProfile, service)
+	assert.Equal(t, 0, index)
+
+	service, _ = serviceSegment("/br-se1/database/v2/instances")
+	assert.Equal(t, ServiceDbaas, service)
+
+	service, _ = serviceSegment("/br-se1/load-balancer/v0beta1/network-load-balancers")
+	assert.Equal(t, ServiceLbaas, service)
+
+	// Map iteration order varies, the earliest segment must win every time.
+	for range 20 {
+		service, index = serviceSegment("/br-se1/network/v0/vpcs/compute/volume/")
+		assert.Equal(t, ServiceNetwork, service)
+		assert.Equal(t, len("/br-se1"), index)
+	}
+
+	_, index = serviceSegment("/br-se1/unknown/v1")
+	assert.Equal(t, -1, index)
+}
@@ -0,0 +1,33 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+)
+
+// TracingRoundTripper records every request attempt as an OpenTelemetry span,
+// nested under the span of the operation that made it. Without an exporter
+// configured spans are not recorded.
+type TracingRoundTripper struct {
+	next http.RoundTripper
+}
+
+func NewTracingRoundTripper(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &TracingRoundTripper{
+		next: next,
+	}
+}
+
+func (rt *TracingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	service, _ := serviceSegment(req.URL.Path)
+	ctx, span := tracing.StartRequest(req, service)
+
+	resp, err := rt.next.RoundTrip(req.WithContext(ctx))
+	span.End(resp, err)
+	return resp, err
+}
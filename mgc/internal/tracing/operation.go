@@ -0,0 +1,160 @@
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	OperationCreate = "create"
+	OperationRead   = "read"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+	OperationList   = "list"
+	OperationOpen   = "open"
+	OperationInvoke = "invoke"
+)
+
+const (
+	attributeProcessID    = attribute.Key("process.pid")
+	attributeResourceType = attribute.Key("terraform.resource.type")
+	attributeDataSource   = attribute.Key("terraform.data_source.type")
+	attributeListResource = attribute.Key("terraform.list_resource.type")
+	attributeEphemeral    = attribute.Key("terraform.ephemeral_resource.type")
+	attributeAction       = attribute.Key("terraform.action.type")
+	attributeOperation    = attribute.Key("terraform.operation")
+	attributeResourceID   = attribute.Key("mgc.resource.id")
+	attributeWaitTarget   = attribute.Key("mgc.wait.target")
+	attributeWaitState    = attribute.Key("mgc.wait.last_state")
+	attributeWaitRefresh  = attribute.Key("mgc.wait.refreshes")
+)
+
+// operationSpan covers a single CRUD call of a resource or data source.
+type operationSpan struct {
+	ctx  context.Context
+	span trace.Span
+}
+
+// Resource starts the span of a resource operation and returns the func that
+// ends it. ctx is replaced by the span context so API calls and waits are
+// nested under it, and the returned func must be deferred:
+//
+//	defer tracing.Resource(&ctx, sshKeysTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+//
+// The id attribute of state, if any, and the error diagnostics are recorded
+// when the span ends, so pointers to the response fields are passed.
+func Resource(ctx *context.Context, typeName, operation string, state *tfsdk.State, diags *diag.Diagnostics) func() {
+	return start(ctx, typeName+" "+operation, state, diags,
+		attributeResourceType.String(typeName),
+		attributeOperation.String(operation),
+	)
+}
+
+// DataSource starts the span of a data source read, see Resource.
+func DataSource(ctx *context.Context, typeName string, state *tfsdk.State, diags *diag.Diagnostics) func() {
+	return start(ctx, typeName+" "+OperationRead, state, diags,
+		attributeDataSource.String(typeName),
+		attributeOperation.String(OperationRead),
+	)
+}
+
+// List starts the span of a list resource call, see Resource.
+func List(ctx *context.Context, typeName string, diags *diag.Diagnostics) func() {
+	return start(ctx, typeName+" "+OperationList, nil, diags,
+		attributeListResource.String(typeName),
+		attributeOperation.String(OperationList),
+	)
+}
+
+// Ephemeral starts the span of an ephemeral resource open, see Resource. No
+// id is recorded, as the result is never stored.
+func Ephemeral(ctx *context.Context, typeName string, diags *diag.Diagnostics) func() {
+	return start(ctx, typeName+" "+OperationOpen, nil, diags,
+		attributeEphemeral.String(typeName),
+		attributeOperation.String(OperationOpen),
+	)
+}
+
+// Action starts the span of an action invocation, see Resource.
+func Action(ctx *context.Context, typeName string, diags *diag.Diagnostics) func() {
+	return start(ctx, typeName+" "+OperationInvoke, nil, diags,
+		attributeAction.String(typeName),
+		attributeOperation.String(OperationInvoke),
+	)
+}
+
+func start(ctx *context.Context, name string, state *tfsdk.State, diags *diag.Diagnostics, attributes ...attribute.KeyValue) func() {
+	spanCtx, span := tracer().Start(withRoot(*ctx), name, trace.WithAttributes(attributes...))
+	if span.IsRecording() {
+		spanCtx = tflog.SetField(spanCtx, "otel_trace_id", span.SpanContext().TraceID().String())
+	}
+	*ctx = spanCtx
+	s := &operationSpan{ctx: spanCtx, span: span}
+	return func() { s.end(state, diags) }
+}
+
+// end records the id attribute of state, if any, and the error diagnostics
+// before ending the span.
+func (s *operationSpan) end(state *tfsdk.State, diags *diag.Diagnostics) {
+	if s.span.IsRecording() {
+		if state != nil && !state.Raw.IsNull() {
+			var id types.String
+			if !state.GetAttribute(s.ctx, path.Root("id"), &id).HasError() && !id.IsNull() && !id.IsUnknown() {
+				s.span.SetAttributes(attributeResourceID.String(id.ValueString()))
+			}
+		}
+		if diags != nil && diags.HasError() {
+			errs := diags.Errors()
+			for _, d := range errs {
+				s.span.AddEvent("error", trace.WithAttributes(
+					attribute.String("summary", d.Summary()),
+					attribute.String("detail", d.Detail()),
+				))
+			}
+			s.span.SetStatus(codes.Error, errs[0].Summary())
+		}
+	}
+	s.span.End()
+}
+
+// WaitSpan covers a state waiter, from the first refresh to the final state.
+type WaitSpan struct {
+	span      trace.Span
+	refreshes int
+}
+
+func StartWait(ctx context.Context, description string, target []string) (context.Context, *WaitSpan) {
+	ctx, span := tracer().Start(withRoot(ctx), "wait for "+description,
+		trace.WithAttributes(attributeWaitTarget.StringSlice(target)))
+	return ctx, &WaitSpan{span: span}
+}
+
+// Refreshed records a refresh that returned state.
+func (s *WaitSpan) Refreshed(state string, elapsed time.Duration) {
+	s.refreshes++
+	s.span.AddEvent("refresh", trace.WithAttributes(
+		attribute.String("state", state),
+		attribute.String("elapsed", elapsed.String()),
+	))
+}
+
+func (s *WaitSpan) End(lastState string, err error) {
+	s.span.SetAttributes(
+		attributeWaitState.String(lastState),
+		attributeWaitRefresh.Int(s.refreshes),
+	)
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
@@ -0,0 +1,65 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	attributeService   = attribute.Key("mgc.service")
+	attributeRequestID = attribute.Key("mgc.request_id")
+	attributeTraceID   = attribute.Key("mgc.trace_id")
+)
+
+// RequestSpan covers a single HTTP request to the API.
+type RequestSpan struct {
+	span trace.Span
+}
+
+// StartRequest starts a client span for req, named after its method and the
+// service it calls so the spans stay readable without templated paths.
+func StartRequest(req *http.Request, service string) (context.Context, *RequestSpan) {
+	name := req.Method
+	if service != "" {
+		name = fmt.Sprintf("%s %s", req.Method, service)
+	}
+
+	ctx, span := tracer().Start(withRoot(req.Context()), name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLFull(req.URL.Redacted()),
+			semconv.ServerAddress(req.URL.Hostname()),
+			attributeService.String(service),
+		),
+	)
+	return ctx, &RequestSpan{span: span}
+}
+
+// End records the response status and the request and trace IDs returned by
+// the API, which identify the request in Magalu Cloud support tickets.
+func (s *RequestSpan) End(resp *http.Response, err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	if resp != nil {
+		s.span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+		if id := resp.Header.Get("X-Request-Id"); id != "" {
+			s.span.SetAttributes(attributeRequestID.String(id))
+		}
+		if id := resp.Header.Get("X-Mgc-Trace-Id"); id != "" {
+			s.span.SetAttributes(attributeTraceID.String(id))
+		}
+		if resp.StatusCode >= http.StatusBadRequest {
+			s.span.SetStatus(codes.Error, resp.Status)
+		}
+	}
+	s.span.End()
+}
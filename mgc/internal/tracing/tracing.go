@@ -0,0 +1,143 @@
+// Package tracing exports OpenTelemetry spans for provider operations, state
+// waiters and API requests. It is disabled unless MGC_OTEL_EXPORTER is set, in
+// which case the global tracer provider is replaced.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	EnvExporter    = "MGC_OTEL_EXPORTER"
+	EnvFile        = "MGC_OTEL_FILE"
+	EnvTraceParent = "TRACEPARENT"
+
+	// ExporterOTLP sends spans over OTLP/HTTP, configured with the standard
+	// OTEL_EXPORTER_OTLP_* environment variables.
+	ExporterOTLP = "otlp"
+	// ExporterFile appends one JSON document per span to the file in MGC_OTEL_FILE.
+	ExporterFile = "file"
+
+	serviceName = "terraform-provider-mgc"
+	tracerName  = "github.com/MagaluCloud/terraform-provider-mgc"
+)
+
+// root is the span covering the provider process. Terraform starts a provider
+// process per command, so every operation of a plan or apply shares its trace.
+var root atomic.Pointer[trace.SpanContext]
+
+type Config struct {
+	Exporter string
+	File     string
+	// TraceParent is a W3C traceparent used as parent of the process span, so
+	// a CI job can nest the provider spans under its own trace.
+	TraceParent string
+}
+
+func ConfigFromEnv() Config {
+	return Config{
+		Exporter:    strings.ToLower(strings.TrimSpace(os.Getenv(EnvExporter))),
+		File:        strings.TrimSpace(os.Getenv(EnvFile)),
+		TraceParent: strings.TrimSpace(os.Getenv(EnvTraceParent)),
+	}
+}
+
+// Setup installs the tracer provider described by config. The returned
+// function ends the process span and flushes pending spans; it must be called
+// before the process exits.
+func Setup(ctx context.Context, config Config, version string) (func(context.Context) error, error) {
+	noop := func(context.Context) error { return nil }
+
+	var processor sdktrace.SpanProcessor
+	var closeExporter func() error
+	switch config.Exporter {
+	case "":
+		return noop, nil
+	case ExporterOTLP:
+		exporter, err := otlptracehttp.New(ctx)
+		if err != nil {
+			return noop, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
+		}
+		processor = sdktrace.NewBatchSpanProcessor(exporter)
+	case ExporterFile:
+		if config.File == "" {
+			return noop, fmt.Errorf("%s must be set when %s is %q", EnvFile, EnvExporter, ExporterFile)
+		}
+		file, err := os.OpenFile(config.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+		if err != nil {
+			return noop, fmt.Errorf("failed to open trace file: %w", err)
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(file))
+		if err != nil {
+			file.Close()
+			return noop, fmt.Errorf("failed to create file trace exporter: %w", err)
+		}
+		// Spans are written as they end, so they are kept even if Terraform
+		// kills the provider before it shuts down.
+		processor = sdktrace.NewSimpleSpanProcessor(exporter)
+		closeExporter = file.Close
+	default:
+		return noop, fmt.Errorf("invalid %s %q, expected %q or %q", EnvExporter, config.Exporter, ExporterOTLP, ExporterFile)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(serviceName),
+			semconv.ServiceVersion(version),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+
+	parent := ctx
+	if config.TraceParent != "" {
+		parent = propagation.TraceContext{}.Extract(ctx, propagation.MapCarrier{"traceparent": config.TraceParent})
+	}
+	_, span := tracer().Start(parent, serviceName, trace.WithAttributes(attributeProcessID.Int(os.Getpid())))
+	spanContext := span.SpanContext()
+	root.Store(&spanContext)
+
+	return func(ctx context.Context) error {
+		span.End()
+		root.Store(nil)
+
+		ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+		defer cancel()
+		err := provider.Shutdown(ctx)
+		if closeExporter != nil {
+			err = errors.Join(err, closeExporter())
+		}
+		return err
+	}, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// withRoot parents spans started from a Terraform RPC context, which carries
+// no span, on the process span.
+func withRoot(ctx context.Context) context.Context {
+	if trace.SpanContextFromContext(ctx).IsValid() {
+		return ctx
+	}
+	if spanContext := root.Load(); spanContext != nil {
+		return trace.ContextWithSpanContext(ctx, *spanContext)
+	}
+	return ctx
+}
@@ -0,0 +1,146 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func useRecorder(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	return recorder
+}
+
+func attributes(span sdktrace.ReadOnlySpan) map[string]string {
+	out := map[string]string{}
+	for _, kv := range span.Attributes() {
+		out[string(kv.Key)] = kv.Value.Emit()
+	}
+	return out
+}
+
+func TestOperationSpan_NestsRequestsAndRecordsID(t *testing.T) {
+	recorder := useRecorder(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Mgc-Trace-Id", "mgc-trace")
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	state := tfsdk.State{
+		Schema: schema.Schema{Attributes: map[string]schema.Attribute{"id": schema.StringAttribute{Computed: true}}},
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}},
+			map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, "vm-1")}),
+	}
+	var diags diag.Diagnostics
+
+	ctx := context.Background()
+	end := Resource(&ctx, "mgc_virtual_machine_instances", OperationCreate, &state, &diags)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/br-se1/compute/v1/instances", nil)
+	require.NoError(t, err)
+	_, requestSpan := StartRequest(req, "compute")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	requestSpan.End(resp, nil)
+	end()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+	request, operation := spans[0], spans[1]
+
+	assert.Equal(t, "POST compute", request.Name())
+	assert.Equal(t, operation.SpanContext().SpanID(), request.Parent().SpanID())
+	assert.Equal(t, "202", attributes(request)["http.response.status_code"])
+	assert.Equal(t, "mgc-trace", attributes(request)["mgc.trace_id"])
+
+	assert.Equal(t, "mgc_virtual_machine_instances create", operation.Name())
+	assert.Equal(t, "vm-1", attributes(operation)["mgc.resource.id"])
+	assert.Equal(t, codes.Unset, operation.Status().Code)
+}
+
+func TestOperationSpan_RecordsErrors(t *testing.T) {
+	recorder := useRecorder(t)
+
+	var diags diag.Diagnostics
+	ctx := context.Background()
+	end := DataSource(&ctx, "mgc_ssh_keys", nil, &diags)
+	diags.AddError("Failed to list SSH keys", "boom")
+	end()
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "Failed to list SSH keys", spans[0].Status().Description)
+	assert.Equal(t, "mgc_ssh_keys", attributes(spans[0])["terraform.data_source.type"])
+}
+
+func TestSetup_FileExporter(t *testing.T) {
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	file := filepath.Join(t.TempDir(), "trace.json")
+	shutdown, err := Setup(context.Background(), Config{
+		Exporter:    ExporterFile,
+		File:        file,
+		TraceParent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	}, "1.2.3")
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	Resource(&ctx, "mgc_ssh_keys", OperationDelete, nil, nil)()
+	require.NoError(t, shutdown(context.Background()))
+
+	b, err := os.ReadFile(file)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	require.Len(t, lines, 2)
+
+	type exportedSpan struct {
+		Name        string
+		SpanContext struct{ TraceID string }
+	}
+	var exported []exportedSpan
+	for _, line := range lines {
+		var s exportedSpan
+		require.NoError(t, json.Unmarshal([]byte(line), &s))
+		exported = append(exported, s)
+	}
+	assert.Equal(t, "mgc_ssh_keys delete", exported[0].Name)
+	assert.Equal(t, "terraform-provider-mgc", exported[1].Name)
+	for _, s := range exported {
+		assert.Equal(t, "4bf92f3577b34da6a3ce929d0e0e4736", s.SpanContext.TraceID)
+	}
+}
+
+func TestSetup(t *testing.T) {
+	shutdown, err := Setup(context.Background(), Config{}, "dev")
+	require.NoError(t, err)
+	assert.NoError(t, shutdown(context.Background()))
+
+	_, err = Setup(context.Background(), Config{Exporter: ExporterFile}, "dev")
+	assert.ErrorContains(t, err, EnvFile)
+
+	_, err = Setup(context.Background(), Config{Exporter: "jaeger"}, "dev")
+	assert.ErrorContains(t, err, "invalid "+EnvExporter)
+}
@@ -0,0 +1,142 @@
+package kubernetes_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	k8sSDK "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/acctest"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/kubernetes"
+)
+
+func testAccClusterConfig(srv *fakeapi.Server, description, allowedCIDR string) string {
+	return acctest.Config(srv, fmt.Sprintf(`
+resource "mgc_kubernetes_cluster" "test" {
+  name          = "acc-cluster"
+  version       = %q
+  description   = %q
+  allowed_cidrs = [%q]
+}
+`, fakeapi.KubernetesVersion, description, allowedCIDR))
+}
+
+func TestAccKubernetesCluster(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_kubernetes_cluster"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig(srv, "acceptance test", "10.0.0.0/8"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mgc_kubernetes_cluster.test", "id"),
+					resource.TestCheckResourceAttr("mgc_kubernetes_cluster.test", "version", fakeapi.KubernetesVersion),
+					resource.TestCheckResourceAttrSet("mgc_kubernetes_cluster.test", "cluster_ipv4_cidr"),
+					resource.TestCheckResourceAttrSet("mgc_kubernetes_cluster.test", "created_at"),
+				),
+			},
+			{
+				ResourceName:            "mgc_kubernetes_cluster.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccClusterConfig(srv, "updated", "192.168.0.0/16"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mgc_kubernetes_cluster.test", "description", "updated"),
+					resource.TestCheckResourceAttr("mgc_kubernetes_cluster.test", "allowed_cidrs.0", "192.168.0.0/16"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesClusterKubeConfig_Ephemeral(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.EchoProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_kubernetes_cluster"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig(srv, "acceptance test", "10.0.0.0/8") + `
+ephemeral "mgc_kubernetes_cluster_kubeconfig" "test" {
+  cluster_id = mgc_kubernetes_cluster.test.id
+}
+
+provider "echo" {
+  data = ephemeral.mgc_kubernetes_cluster_kubeconfig.test
+}
+
+resource "echo" "kubeconfig" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("echo.kubeconfig", "data.cluster_id", "mgc_kubernetes_cluster.test", "id"),
+					resource.TestCheckResourceAttr("echo.kubeconfig", "data.region", fakeapi.Region),
+					resource.TestMatchResourceAttr("echo.kubeconfig", "data.host", regexp.MustCompile(`^https://.+\.k8s\.fake\.magalu\.cloud$`)),
+					resource.TestMatchResourceAttr("echo.kubeconfig", "data.cluster_ca_certificate", regexp.MustCompile(`^ca-`)),
+					resource.TestMatchResourceAttr("echo.kubeconfig", "data.client_certificate", regexp.MustCompile(`^cert-`)),
+					resource.TestMatchResourceAttr("echo.kubeconfig", "data.client_key", regexp.MustCompile(`^key-`)),
+					resource.TestMatchResourceAttr("echo.kubeconfig", "data.kubeconfig", regexp.MustCompile(`current-context: acc-cluster`)),
+				),
+			},
+		},
+	})
+}
+
+// Not parallel, as it shortens the node pool polling interval of the package.
+func TestAccKubernetesNodePoolScaleAction(t *testing.T) {
+	interval := kubernetes.NodepoolInterval
+	kubernetes.NodepoolInterval = 10 * time.Millisecond
+	t.Cleanup(func() { kubernetes.NodepoolInterval = interval })
+
+	ctx := context.Background()
+	srv := acctest.NewServer(t)
+	client := k8sSDK.New(acctest.Client(srv))
+	version := fakeapi.KubernetesVersion
+	cluster, err := client.Clusters().Create(ctx, k8sSDK.ClusterRequest{Name: "acc-cluster", Version: &version})
+	require.NoError(t, err)
+	nodePool, err := client.Nodepools().Create(ctx, cluster.ID, k8sSDK.CreateNodePoolRequest{
+		Name:     "acc-pool",
+		Flavor:   "cloud-k8s.gp1.small",
+		Replicas: 1,
+	})
+	require.NoError(t, err)
+
+	config := map[string]any{
+		"cluster_id":   cluster.ID,
+		"node_pool_id": nodePool.ID,
+		"replicas":     3,
+	}
+	progress, err := acctest.InvokeAction(t, srv, "mgc_kubernetes_nodepool_scale", config)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"Scaling node pool acc-pool from 1 to 3 replicas",
+		"Node pool acc-pool is running with 3 replicas",
+	}, progress)
+
+	scaled, err := client.Nodepools().Get(ctx, cluster.ID, nodePool.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 3, scaled.Replicas)
+	assert.Equal(t, kubernetes.NodepoolRunningState, scaled.Status.State)
+
+	progress, err = acctest.InvokeAction(t, srv, "mgc_kubernetes_nodepool_scale", config)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Node pool acc-pool already has 3 replicas"}, progress)
+
+	config["replicas"] = -1
+	_, err = acctest.InvokeAction(t, srv, "mgc_kubernetes_nodepool_scale", config)
+	assert.ErrorContains(t, err, "value must be at least 0")
+}
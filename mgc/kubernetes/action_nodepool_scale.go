@@ -0,0 +1,122 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	k8sSDK "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const kubernetesNodepoolScaleTypeName = "mgc_kubernetes_nodepool_scale"
+
+var _ action.ActionWithConfigure = &NodePoolScaleAction{}
+
+func NewNodePoolScaleAction() action.Action {
+	return &NodePoolScaleAction{}
+}
+
+// NodePoolScaleAction sets the number of nodes of a node pool, which the
+// mgc_kubernetes_nodepool resource only sets at creation.
+type NodePoolScaleAction struct {
+	regional    utils.RegionalService
+	sdkNodepool k8sSDK.NodePoolService
+}
+
+type NodePoolScaleActionModel struct {
+	Region     types.String `tfsdk:"region"`
+	ClusterID  types.String `tfsdk:"cluster_id"`
+	NodePoolID types.String `tfsdk:"node_pool_id"`
+	Replicas   types.Int64  `tfsdk:"replicas"`
+}
+
+func (a *NodePoolScaleAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = kubernetesNodepoolScaleTypeName
+}
+
+func (a *NodePoolScaleAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	a.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		a.sdkNodepool = k8sSDK.New(&cfg.CoreConfig).Nodepools()
+	})
+}
+
+func (a *NodePoolScaleAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Scales a node pool to a number of nodes and waits until it is running again. " +
+			"Node pools with autoscaling keep scaling between min_replicas and max_replicas afterwards.",
+		Attributes: map[string]schema.Attribute{
+			"region": utils.ActionRegionAttribute(),
+			"cluster_id": schema.StringAttribute{
+				Description: "UUID of the Kubernetes cluster.",
+				Required:    true,
+			},
+			"node_pool_id": schema.StringAttribute{
+				Description: "ID of the node pool.",
+				Required:    true,
+			},
+			"replicas": schema.Int64Attribute{
+				Description: "Number of nodes the node pool is scaled to.",
+				Required:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+		},
+	}
+}
+
+func (a *NodePoolScaleAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tracing.Action(&ctx, kubernetesNodepoolScaleTypeName, &resp.Diagnostics)()
+
+	var data NodePoolScaleActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(a.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterID, nodePoolID := data.ClusterID.ValueString(), data.NodePoolID.ValueString()
+	replicas := int(data.Replicas.ValueInt64())
+	nodepool, err := a.sdkNodepool.Get(ctx, clusterID, nodePoolID)
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	if nodepool.Replicas == replicas {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Node pool %s already has %d replicas", nodepool.Name, replicas)})
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Scaling node pool %s from %d to %d replicas", nodepool.Name, nodepool.Replicas, replicas)})
+	_, err = a.sdkNodepool.Update(ctx, clusterID, nodePoolID, k8sSDK.PatchNodePoolRequest{Replicas: &replicas})
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+
+	err = waitNodePoolState(ctx, a.sdkNodepool, nodePoolID, clusterID, NodepoolRunningState, NodepoolTimeout, NodepoolInterval)
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Node pool %s is running with %d replicas", nodepool.Name, replicas)})
+}
@@ -0,0 +1,21 @@
+package kubernetes
+
+import (
+	"context"
+
+	k8sSDK "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+// catalogFlavors lists node pool and control plane flavors through the
+// provider catalog cache.
+type catalogFlavors struct {
+	k8sSDK.FlavorService
+	catalog utils.Catalog
+}
+
+func (s catalogFlavors) List(ctx context.Context, opts k8sSDK.ListOptions) (*k8sSDK.FlavorsAvailable, error) {
+	return utils.FromCatalog(ctx, s.catalog, utils.CatalogKey("kubernetes/flavors", opts), func(ctx context.Context) (*k8sSDK.FlavorsAvailable, error) {
+		return s.FlavorService.List(ctx, opts)
+	})
+}
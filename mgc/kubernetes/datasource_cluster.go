@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdkK8s "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -64,6 +65,7 @@ func NewDataSourceKubernetesCluster() datasource.DataSource {
 }
 
 type DataSourceKubernetesCluster struct {
+	regional  utils.RegionalService
 	sdkClient sdkK8s.ClusterService
 	region    string
 }
@@ -80,12 +82,14 @@ func (r *DataSourceKubernetesCluster) Configure(ctx context.Context, req datasou
 		return
 	}
 
-	r.sdkClient = sdkK8s.New(&dataConfig.CoreConfig).Clusters()
-	r.region = dataConfig.Region
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.sdkClient = sdkK8s.New(&cfg.CoreConfig).Clusters()
+		r.region = cfg.Region
+	})
 }
 
-func (d *DataSourceKubernetesCluster) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_kubernetes_cluster"
+func (d *DataSourceKubernetesCluster) Metadata(ctx context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = kubernetesClusterTypeName
 }
 
 func (d *DataSourceKubernetesCluster) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -331,10 +335,7 @@ func (d *DataSourceKubernetesCluster) Schema(ctx context.Context, req datasource
 				Computed:    true,
 			},
 			"subnet_ids": DatasourceSubnetIDsAttribute(),
-			"region": schema.StringAttribute{
-				Description: "Identifier of the region where the Kubernetes cluster is located.",
-				Computed:    true,
-			},
+			"region":     utils.DataSourceRegionAttribute(),
 			"message": schema.StringAttribute{
 				Description: "Detailed message about the status of the cluster or node.",
 				Computed:    true,
@@ -368,6 +369,8 @@ func (d *DataSourceKubernetesCluster) Schema(ctx context.Context, req datasource
 }
 
 func (d *DataSourceKubernetesCluster) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, kubernetesClusterTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data KubernetesCluster
 	diags := resp.State.Get(ctx, &data)
 	if diags.HasError() {
@@ -375,12 +378,18 @@ func (d *DataSourceKubernetesCluster) Read(ctx context.Context, req datasource.R
 		return
 	}
 
+	resp.Diagnostics.Append(d.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cluster, err := d.sdkClient.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	converted := convertToKubernetesCluster(cluster, d.region)
+	converted.Region = data.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &converted)...)
 }
 
@@ -4,12 +4,15 @@ import (
 	"context"
 
 	sdkK8s "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const kubernetesClustersTypeName = "mgc_kubernetes_clusters"
+
 type KubernetesClusterReducedModel struct {
 	ID          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
@@ -21,6 +24,7 @@ type KubernetesClusterReducedModel struct {
 
 type KubernetesClusterReducedModelList struct {
 	Clusters []KubernetesClusterReducedModel `tfsdk:"clusters"`
+	Region   types.String                    `tfsdk:"region"`
 }
 
 func NewDataSourceKubernetesClusterList() datasource.DataSource {
@@ -28,6 +32,7 @@ func NewDataSourceKubernetesClusterList() datasource.DataSource {
 }
 
 type DataSourceKubernetesClusters struct {
+	regional  utils.RegionalService
 	sdkClient sdkK8s.ClusterService
 }
 
@@ -43,18 +48,20 @@ func (r *DataSourceKubernetesClusters) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	r.sdkClient = sdkK8s.New(&dataConfig.CoreConfig).Clusters()
-
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.sdkClient = sdkK8s.New(&cfg.CoreConfig).Clusters()
+	})
 }
 
-func (d *DataSourceKubernetesClusters) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_kubernetes_clusters"
+func (d *DataSourceKubernetesClusters) Metadata(ctx context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = kubernetesClustersTypeName
 }
 
 func (d *DataSourceKubernetesClusters) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "This data source provides a list of cluster.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"clusters": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of available VM machine-types.",
@@ -92,6 +99,8 @@ func (d *DataSourceKubernetesClusters) Schema(ctx context.Context, req datasourc
 }
 
 func (d *DataSourceKubernetesClusters) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, kubernetesClustersTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data KubernetesClusterReducedModelList
 	diags := resp.State.Get(ctx, &data)
 	if diags.HasError() {
@@ -99,9 +108,14 @@ func (d *DataSourceKubernetesClusters) Read(ctx context.Context, req datasource.
 		return
 	}
 
+	resp.Diagnostics.Append(d.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cluster, err := d.sdkClient.List(ctx, sdkK8s.ListOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
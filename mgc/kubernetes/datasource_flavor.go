@@ -4,15 +4,19 @@ import (
 	"context"
 
 	sdkK8s "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const kubernetesFlavorTypeName = "mgc_kubernetes_flavor"
+
 type ListResultResultsItem struct {
 	Controlplane []ListResultResultsItemBastionItem `tfsdk:"controlplane"`
 	Nodepool     []ListResultResultsItemBastionItem `tfsdk:"nodepool"`
+	Region       types.String                       `tfsdk:"region"`
 }
 
 type ListResultResultsItemBastionItem struct {
@@ -24,6 +28,7 @@ type ListResultResultsItemBastionItem struct {
 }
 
 type DataSourceKubernetesFlavor struct {
+	regional  utils.RegionalService
 	sdkClient sdkK8s.FlavorService
 }
 
@@ -31,8 +36,8 @@ func NewDataSourceKubernetesFlavor() datasource.DataSource {
 	return &DataSourceKubernetesFlavor{}
 }
 
-func (r *DataSourceKubernetesFlavor) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_kubernetes_flavor"
+func (r *DataSourceKubernetesFlavor) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = kubernetesFlavorTypeName
 }
 
 func (r *DataSourceKubernetesFlavor) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,13 +52,16 @@ func (r *DataSourceKubernetesFlavor) Configure(ctx context.Context, req datasour
 		return
 	}
 
-	r.sdkClient = sdkK8s.New(&dataConfig.CoreConfig).Flavors()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.sdkClient = catalogFlavors{sdkK8s.New(&cfg.CoreConfig).Flavors(), cfg.Catalog()}
+	})
 }
 
 func (r *DataSourceKubernetesFlavor) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Available flavors for Kubernetes clusters.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"controlplane": schema.ListNestedAttribute{
 				Description:  "Control plane configuration.",
 				Computed:     true,
@@ -96,9 +104,22 @@ func resourceListResultResultsItemBastionItemSchema() schema.NestedAttributeObje
 }
 
 func (r *DataSourceKubernetesFlavor) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, kubernetesFlavorTypeName, &resp.State, &resp.Diagnostics)()
+
+	var data ListResultResultsItem
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := r.sdkClient.List(ctx, sdkK8s.ListOptions{ /*todo*/ })
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -108,9 +129,10 @@ func (r *DataSourceKubernetesFlavor) Read(ctx context.Context, req datasource.Re
 	output := &ListResultResultsItem{
 		Controlplane: controlplane,
 		Nodepool:     nodepool,
+		Region:       data.Region,
 	}
 
-	resp.Diagnostics = resp.State.Set(ctx, &output)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &output)...)
 }
 
 func resourceListResultResultsItemBastionItem(items []sdkK8s.Flavor) []ListResultResultsItemBastionItem {
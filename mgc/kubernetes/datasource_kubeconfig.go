@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdkK8s "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -11,6 +12,8 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
+const kubernetesClusterKubeconfigTypeName = "mgc_kubernetes_cluster_kubeconfig"
+
 var _ datasource.DataSource = &DataSourceKubernetesClusterKubeConfig{}
 
 func NewDataSourceKubernetesClusterKubeConfig() datasource.DataSource {
@@ -18,21 +21,24 @@ func NewDataSourceKubernetesClusterKubeConfig() datasource.DataSource {
 }
 
 type DataSourceKubernetesClusterKubeConfig struct {
+	regional  utils.RegionalService
 	sdkClient sdkK8s.ClusterService
 }
 
 type DataSourceKubernetesClusterKubeConfigModel struct {
 	ClusterID types.String `tfsdk:"cluster_id"`
 	RawConfig types.String `tfsdk:"kubeconfig"`
+	Region    types.String `tfsdk:"region"`
 }
 
-func (d *DataSourceKubernetesClusterKubeConfig) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_kubernetes_cluster_kubeconfig"
+func (d *DataSourceKubernetesClusterKubeConfig) Metadata(ctx context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = kubernetesClusterKubeconfigTypeName
 }
 
 func (d *DataSourceKubernetesClusterKubeConfig) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"cluster_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The unique identifier of the Kubernetes cluster.",
@@ -43,23 +49,34 @@ func (d *DataSourceKubernetesClusterKubeConfig) Schema(ctx context.Context, req
 			},
 		},
 	}
-	resp.Schema.Description = "Get the kubeconfig of a Kubernetes cluster by cluster_id."
+	resp.Schema.Description = "Get the kubeconfig of a Kubernetes cluster by cluster_id. " +
+		"The kubeconfig is stored in the state, use the ephemeral resource of the same name to keep it out."
 }
 
 func (d *DataSourceKubernetesClusterKubeConfig) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, kubernetesClusterKubeconfigTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data DataSourceKubernetesClusterKubeConfigModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	sdkOuput, err := d.sdkClient.GetKubeConfig(ctx, data.ClusterID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	rawConfig, err := yaml.Marshal(sdkOuput)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -79,6 +96,8 @@ func (r *DataSourceKubernetesClusterKubeConfig) Configure(ctx context.Context, r
 		return
 	}
 
-	r.sdkClient = sdkK8s.New(&dataConfig.CoreConfig).Clusters()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.sdkClient = sdkK8s.New(&cfg.CoreConfig).Clusters()
+	})
 
 }
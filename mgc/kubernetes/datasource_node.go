@@ -5,16 +5,20 @@ import (
 	"time"
 
 	sdkK8s "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const kubernetesNodeTypeName = "mgc_kubernetes_node"
+
 type NodesDataSourceModel struct {
 	ClusterID  types.String      `tfsdk:"cluster_id"`
 	NodepoolID types.String      `tfsdk:"nodepool_id"`
 	Nodes      []NodesResultFlat `tfsdk:"nodes"`
+	Region     types.String      `tfsdk:"region"`
 }
 
 type NodesResultFlat struct {
@@ -56,6 +60,7 @@ type AddressItem struct {
 }
 
 type DataSourceKubernetesNode struct {
+	regional  utils.RegionalService
 	sdkClient sdkK8s.NodePoolService
 }
 
@@ -63,14 +68,15 @@ func NewDataSourceKubernetesNode() datasource.DataSource {
 	return &DataSourceKubernetesNode{}
 }
 
-func (d *DataSourceKubernetesNode) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_kubernetes_node"
+func (d *DataSourceKubernetesNode) Metadata(ctx context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = kubernetesNodeTypeName
 }
 
 func (d *DataSourceKubernetesNode) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Data source for Kubernetes cluster in MGC",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"cluster_id": schema.StringAttribute{
 				Description: "ID of the cluster.",
 				Required:    true,
@@ -251,18 +257,30 @@ func (d *DataSourceKubernetesNode) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	d.sdkClient = sdkK8s.New(&dataConfig.CoreConfig).Nodepools()
+	d.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		d.sdkClient = sdkK8s.New(&cfg.CoreConfig).Nodepools()
+	})
 
 }
 
 func (d *DataSourceKubernetesNode) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, kubernetesNodeTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data NodesDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	nodes, err := d.sdkClient.Nodes(ctx, data.ClusterID.ValueString(), data.NodepoolID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
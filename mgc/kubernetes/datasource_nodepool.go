@@ -5,6 +5,7 @@ import (
 
 	sdkK8s "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -38,9 +39,11 @@ type FlattenedGetResult struct {
 	AvailabilityZones          types.Set      `tfsdk:"availability_zones"`
 	SubnetIDs                  types.Set      `tfsdk:"subnet_ids"`
 	Version                    types.String   `tfsdk:"version"`
+	Region                     types.String   `tfsdk:"region"`
 }
 
 type DataSourceKubernetesNodepool struct {
+	regional  utils.RegionalService
 	sdkClient sdkK8s.NodePoolService
 	region    string
 }
@@ -49,8 +52,8 @@ func NewDataSourceKubernetesNodepool() datasource.DataSource {
 	return &DataSourceKubernetesNodepool{}
 }
 
-func (r *DataSourceKubernetesNodepool) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_kubernetes_nodepool"
+func (r *DataSourceKubernetesNodepool) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = kubernetesNodepoolTypeName
 }
 
 func (r *DataSourceKubernetesNodepool) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -65,14 +68,17 @@ func (r *DataSourceKubernetesNodepool) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	r.region = dataConfig.Region
-	r.sdkClient = sdkK8s.New(&dataConfig.CoreConfig).Nodepools()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.region = cfg.Region
+		r.sdkClient = sdkK8s.New(&cfg.CoreConfig).Nodepools()
+	})
 }
 
 func (d *DataSourceKubernetesNodepool) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Data source for Kubernetes Nodepool",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Nodepool UUID.",
 				Required:    true,
@@ -195,13 +201,23 @@ func (d *DataSourceKubernetesNodepool) Schema(ctx context.Context, req datasourc
 }
 
 func (r *DataSourceKubernetesNodepool) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, kubernetesNodepoolTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data FlattenedGetResult
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	sdkOutput, err := r.sdkClient.Get(ctx, data.ClusterID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -210,6 +226,7 @@ func (r *DataSourceKubernetesNodepool) Read(ctx context.Context, req datasource.
 		resp.Diagnostics.AddError("Failed to convert nodepool", err.Error())
 		return
 	}
+	flattened.Region = data.Region
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &flattened)...)
 }
@@ -5,15 +5,19 @@ import (
 
 	sdkK8s "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const kubernetesVersionTypeName = "mgc_kubernetes_version"
+
 type VersionsModel struct {
 	IncludeDeprecated types.Bool     `tfsdk:"include_deprecated"`
 	Versions          []VersionModel `tfsdk:"versions"`
+	Region            types.String   `tfsdk:"region"`
 }
 
 type VersionModel struct {
@@ -22,6 +26,7 @@ type VersionModel struct {
 }
 
 type DataSourceKubernetesVersion struct {
+	regional  utils.RegionalService
 	sdkClient sdkK8s.VersionService
 }
 
@@ -29,8 +34,8 @@ func NewDataSourceKubernetesVersion() datasource.DataSource {
 	return &DataSourceKubernetesVersion{}
 }
 
-func (r *DataSourceKubernetesVersion) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_kubernetes_version"
+func (r *DataSourceKubernetesVersion) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = kubernetesVersionTypeName
 }
 
 func (r *DataSourceKubernetesVersion) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -45,12 +50,15 @@ func (r *DataSourceKubernetesVersion) Configure(ctx context.Context, req datasou
 		return
 	}
 
-	r.sdkClient = sdkK8s.New(&dataConfig.CoreConfig).Versions()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.sdkClient = sdkK8s.New(&cfg.CoreConfig).Versions()
+	})
 }
 
 func (r *DataSourceKubernetesVersion) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"include_deprecated": schema.BoolAttribute{
 				Description: "Include deprecated versions.",
 				Optional:    true,
@@ -77,8 +85,18 @@ func (r *DataSourceKubernetesVersion) Schema(_ context.Context, req datasource.S
 }
 
 func (r *DataSourceKubernetesVersion) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, kubernetesVersionTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data VersionsModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	includeDeprecated := false
 	if !data.IncludeDeprecated.IsNull() && !data.IncludeDeprecated.IsUnknown() && *data.IncludeDeprecated.ValueBoolPointer() {
@@ -91,7 +109,7 @@ func (r *DataSourceKubernetesVersion) Read(ctx context.Context, req datasource.R
 		IncludeDeprecated: includeDeprecated,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -0,0 +1,184 @@
+package kubernetes
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	sdkK8s "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"gopkg.in/yaml.v3"
+)
+
+var _ ephemeral.EphemeralResourceWithConfigure = &EphemeralKubernetesClusterKubeConfig{}
+
+func NewEphemeralKubernetesClusterKubeConfig() ephemeral.EphemeralResource {
+	return &EphemeralKubernetesClusterKubeConfig{}
+}
+
+// EphemeralKubernetesClusterKubeConfig is the mgc_kubernetes_cluster_kubeconfig
+// data source without state: the admin credentials are fetched on each run and
+// only passed to the configurations that reference them.
+type EphemeralKubernetesClusterKubeConfig struct {
+	regional  utils.RegionalService
+	sdkClient sdkK8s.ClusterService
+}
+
+type EphemeralKubernetesClusterKubeConfigModel struct {
+	ClusterID            types.String `tfsdk:"cluster_id"`
+	Region               types.String `tfsdk:"region"`
+	RawConfig            types.String `tfsdk:"kubeconfig"`
+	Host                 types.String `tfsdk:"host"`
+	ClusterCACertificate types.String `tfsdk:"cluster_ca_certificate"`
+	ClientCertificate    types.String `tfsdk:"client_certificate"`
+	ClientKey            types.String `tfsdk:"client_key"`
+}
+
+func (e *EphemeralKubernetesClusterKubeConfig) Metadata(ctx context.Context, _ ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = kubernetesClusterKubeconfigTypeName
+}
+
+func (e *EphemeralKubernetesClusterKubeConfig) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Get the kubeconfig of a Kubernetes cluster by cluster_id, without storing it in the state or plan. " +
+			"The credentials can configure the kubernetes and helm providers.",
+		Attributes: map[string]schema.Attribute{
+			"region": utils.EphemeralRegionAttribute(),
+			"cluster_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The unique identifier of the Kubernetes cluster.",
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The full contents of the Kubernetes cluster's kubeconfig yaml file.",
+			},
+			"host": schema.StringAttribute{
+				Computed:    true,
+				Description: "URL of the Kubernetes API server of the current context.",
+			},
+			"cluster_ca_certificate": schema.StringAttribute{
+				Computed:    true,
+				Description: "PEM encoded certificate authority of the Kubernetes API server.",
+			},
+			"client_certificate": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded client certificate of the cluster admin user.",
+			},
+			"client_key": schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "PEM encoded client key of the cluster admin user.",
+			},
+		},
+	}
+}
+
+func (e *EphemeralKubernetesClusterKubeConfig) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	defer tracing.Ephemeral(&ctx, kubernetesClusterKubeconfigTypeName, &resp.Diagnostics)()
+
+	var data EphemeralKubernetesClusterKubeConfigModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(e.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kubeConfig, err := e.sdkClient.GetKubeConfig(ctx, data.ClusterID.ValueString())
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+
+	rawConfig, err := yaml.Marshal(kubeConfig)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to encode kubeconfig", err.Error())
+		return
+	}
+	data.RawConfig = types.StringValue(string(rawConfig))
+
+	if err := setKubeConfigCredentials(&data, kubeConfig); err != nil {
+		resp.Diagnostics.AddError("Invalid kubeconfig", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// setKubeConfigCredentials sets the server and credentials of the current
+// context, decoded from base64 as the kubernetes and helm providers take them.
+func setKubeConfigCredentials(data *EphemeralKubernetesClusterKubeConfigModel, kubeConfig *sdkK8s.KubeConfig) error {
+	var clusterName, userName string
+	for _, c := range kubeConfig.Contexts {
+		if c.Name == kubeConfig.CurrentContext {
+			clusterName, userName = c.Context.Cluster, c.Context.User
+		}
+	}
+	if clusterName == "" {
+		return fmt.Errorf("current context %q not found", kubeConfig.CurrentContext)
+	}
+
+	found := false
+	for _, c := range kubeConfig.Clusters {
+		if c.Name != clusterName {
+			continue
+		}
+		ca, err := base64.StdEncoding.DecodeString(c.Cluster.CertificateAuthorityData)
+		if err != nil {
+			return fmt.Errorf("certificate authority of cluster %q: %w", clusterName, err)
+		}
+		data.Host = types.StringValue(c.Cluster.Server)
+		data.ClusterCACertificate = types.StringValue(string(ca))
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("cluster %q of the current context not found", clusterName)
+	}
+
+	found = false
+	for _, u := range kubeConfig.Users {
+		if u.Name != userName {
+			continue
+		}
+		cert, err := base64.StdEncoding.DecodeString(u.User.ClientCertificateData)
+		if err != nil {
+			return fmt.Errorf("client certificate of user %q: %w", userName, err)
+		}
+		key, err := base64.StdEncoding.DecodeString(u.User.ClientKeyData)
+		if err != nil {
+			return fmt.Errorf("client key of user %q: %w", userName, err)
+		}
+		data.ClientCertificate = types.StringValue(string(cert))
+		data.ClientKey = types.StringValue(string(key))
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("user %q of the current context not found", userName)
+	}
+	return nil
+}
+
+func (e *EphemeralKubernetesClusterKubeConfig) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure ephemeral resource", "Invalid provider data")
+		return
+	}
+
+	e.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		e.sdkClient = sdkK8s.New(&cfg.CoreConfig).Clusters()
+	})
+}
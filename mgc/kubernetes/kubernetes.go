@@ -1,7 +1,10 @@
 package kubernetes
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -23,3 +26,22 @@ func GetResources() []func() resource.Resource {
 		NewNewNodePoolResource,
 	}
 }
+
+func GetListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewKubernetesClustersList,
+		NewKubernetesNodePoolsList,
+	}
+}
+
+func GetEphemeralResources() []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewEphemeralKubernetesClusterKubeConfig,
+	}
+}
+
+func GetActions() []func() action.Action {
+	return []func() action.Action{
+		NewNodePoolScaleAction,
+	}
+}
@@ -0,0 +1,97 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	k8sSDK "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+var (
+	_ list.ListResource              = &k8sClustersList{}
+	_ list.ListResourceWithConfigure = &k8sClustersList{}
+)
+
+type k8sClustersList struct {
+	regional   utils.RegionalService
+	k8sCluster k8sSDK.ClusterService
+}
+
+type k8sClustersListModel struct {
+	utils.ListConfig
+	Version types.String `tfsdk:"version"`
+}
+
+func NewKubernetesClustersList() list.ListResource {
+	return &k8sClustersList{}
+}
+
+func (r *k8sClustersList) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = kubernetesClusterTypeName
+}
+
+func (r *k8sClustersList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.k8sCluster = k8sSDK.New(&cfg.CoreConfig).Clusters()
+	})
+}
+
+func (r *k8sClustersList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists Kubernetes clusters.",
+		Attributes: map[string]listschema.Attribute{
+			"region":     utils.ListRegionAttribute(),
+			"name_regex": utils.ListNameRegexAttribute(),
+			"version": listschema.StringAttribute{
+				Description: "Only list clusters running this Kubernetes version, e.g. `v1.30.2`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *k8sClustersList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	utils.List(ctx, req, stream, utils.Lister[k8sClustersListModel, k8sSDK.ClusterList]{
+		TypeName: kubernetesClusterTypeName,
+		Identity: clusterIdentity,
+		Use:      r.regional.Use,
+		Fetch: func(ctx context.Context, _ k8sClustersListModel) ([]k8sSDK.ClusterList, error) {
+			return r.k8sCluster.List(ctx, k8sSDK.ListOptions{})
+		},
+		Describe: func(config k8sClustersListModel, cluster k8sSDK.ClusterList) (string, map[string]string, bool) {
+			if !config.Version.IsNull() && types.StringPointerValue(cluster.Version).ValueString() != config.Version.ValueString() {
+				return "", nil, false
+			}
+			return cluster.Name, map[string]string{"id": cluster.ID}, true
+		},
+		// The listed clusters lack the attributes of the resource.
+		Resource: func(ctx context.Context, config k8sClustersListModel, item k8sSDK.ClusterList) (any, diag.Diagnostics) {
+			var diags diag.Diagnostics
+			cluster, err := r.k8sCluster.Get(ctx, item.ID)
+			if err != nil {
+				diags.Append(utils.SDKErrorDiagnostic(err))
+				return nil, diags
+			}
+			data := convertSDKCreateResultToTerraformCreateClusterModel(cluster)
+			data.Timeouts = utils.NullTimeouts(clusterTimeoutsOpts)
+			data.Region = config.Region
+			return data, diags
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package kubernetes
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	k8sSDK "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+var (
+	_ list.ListResource              = &nodePoolsList{}
+	_ list.ListResourceWithConfigure = &nodePoolsList{}
+)
+
+type nodePoolsList struct {
+	regional    utils.RegionalService
+	sdkNodepool k8sSDK.NodePoolService
+}
+
+type nodePoolsListModel struct {
+	utils.ListConfig
+	ClusterID types.String `tfsdk:"cluster_id"`
+}
+
+func NewKubernetesNodePoolsList() list.ListResource {
+	return &nodePoolsList{}
+}
+
+func (r *nodePoolsList) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = kubernetesNodepoolTypeName
+}
+
+func (r *nodePoolsList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.sdkNodepool = k8sSDK.New(&cfg.CoreConfig).Nodepools()
+	})
+}
+
+func (r *nodePoolsList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the node pools of a Kubernetes cluster.",
+		Attributes: map[string]listschema.Attribute{
+			"region":     utils.ListRegionAttribute(),
+			"name_regex": utils.ListNameRegexAttribute(),
+			"cluster_id": listschema.StringAttribute{
+				Description: "ID of the cluster to list node pools from.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *nodePoolsList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	utils.List(ctx, req, stream, utils.Lister[nodePoolsListModel, k8sSDK.NodePool]{
+		TypeName: kubernetesNodepoolTypeName,
+		Identity: nodepoolIdentity,
+		Use:      r.regional.Use,
+		Fetch: func(ctx context.Context, config nodePoolsListModel) ([]k8sSDK.NodePool, error) {
+			return r.sdkNodepool.List(ctx, config.ClusterID.ValueString(), k8sSDK.ListOptions{})
+		},
+		Describe: func(config nodePoolsListModel, nodepool k8sSDK.NodePool) (string, map[string]string, bool) {
+			return nodepool.Name, map[string]string{
+				"cluster_id":   config.ClusterID.ValueString(),
+				"node_pool_id": nodepool.ID,
+			}, true
+		},
+		Resource: func(_ context.Context, config nodePoolsListModel, nodepool k8sSDK.NodePool) (any, diag.Diagnostics) {
+			return NodePoolResourceModel{
+				ClusterID: config.ClusterID,
+				Region:    config.Region,
+				Timeouts:  utils.NullTimeouts(nodepoolTimeoutsOpts),
+				NodePool:  ConvertToNodePoolToTFModel(&nodepool, config.Region.ValueString()),
+			}, nil
+		},
+	})
+}
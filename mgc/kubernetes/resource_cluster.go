@@ -2,29 +2,27 @@ package kubernetes
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"net/http"
 	"regexp"
-	"slices"
 	"strings"
 	"time"
 
-	clientSDK "github.com/MagaluCloud/mgc-sdk-go/client"
 	k8sSDK "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const kubernetesClusterTypeName = "mgc_kubernetes_cluster"
+
 const (
 	ClusterPoolingTimeout = 100 * time.Minute
 )
@@ -44,18 +42,26 @@ type KubernetesClusterCreateResourceModel struct {
 	MachineTypesSource types.String   `tfsdk:"machine_types_source"`
 	PlatformVersion    types.String   `tfsdk:"platform_version"`
 	SubnetIDs          types.Set      `tfsdk:"subnet_ids"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
 
+var clusterTimeoutsOpts = timeouts.Opts{Create: true, Update: true, Delete: true}
+
 type k8sClusterResource struct {
+	regional   utils.RegionalService
 	k8sCluster k8sSDK.ClusterService
 }
 
+var clusterIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the Kubernetes cluster."),
+)
+
 func NewK8sClusterResource() resource.Resource {
 	return &k8sClusterResource{}
 }
 
-func (r *k8sClusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_kubernetes_cluster"
+func (r *k8sClusterResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = kubernetesClusterTypeName
 }
 
 func (r *k8sClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -68,10 +74,12 @@ func (r *k8sClusterResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	r.k8sCluster = k8sSDK.New(&dataConfig.CoreConfig).Clusters()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.k8sCluster = k8sSDK.New(&cfg.CoreConfig).Clusters()
+	})
 }
 
-func (r *k8sClusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *k8sClusterResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	nameRule := regexp.MustCompile(`^[a-z]([-a-z0-9]{0,61}[a-z0-9])?$`)
 	resp.Schema = schema.Schema{
 		Description: "Kubernetes cluster resource in MGC",
@@ -123,13 +131,7 @@ func (r *k8sClusterResource) Schema(_ context.Context, _ resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"region": schema.StringAttribute{
-				Description: "Region where the Kubernetes cluster is located.",
-				Computed:    true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.UseStateForUnknown(),
-				},
-			},
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "Cluster's UUID.",
 				Computed:    true,
@@ -175,33 +177,64 @@ func (r *k8sClusterResource) Schema(_ context.Context, _ resource.SchemaRequest,
 							The subnets must belong to the same VPC.
 							This field cannot be changed after the node pool is created`),
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, clusterTimeoutsOpts),
+		},
 	}
 }
 
 func (r *k8sClusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, kubernetesClusterTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer clusterIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data KubernetesClusterCreateResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	cluster, err := r.k8sCluster.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	out := convertSDKCreateResultToTerraformCreateClusterModel(cluster)
+	out.Timeouts = data.Timeouts
+	out.Region = data.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &out)...)
 }
 
 func (r *k8sClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, kubernetesClusterTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer clusterIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data KubernetesClusterCreateResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, ClusterPoolingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if data.EnabledServerGroup.IsNull() {
 		data.EnabledServerGroup = types.BoolValue(true)
 	}
@@ -217,114 +250,135 @@ func (r *k8sClusterResource) Create(ctx context.Context, req resource.CreateRequ
 		Network:            CreateKubernetesSDKNetworkRequest(data.SubnetIDs),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	data.EnabledServerGroup = types.BoolNull()
 
-	createdCluster, err := r.GetClusterPooling(ctx, cluster.ID, "running", "provisioned")
+	createdCluster, err := r.GetClusterPooling(ctx, cluster.ID, createTimeout, "running", "provisioned")
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
-		data.ID = types.StringValue(cluster.ID)
-		resp.State.Set(ctx, &data)
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		clusterIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": data.Region.ValueString(), "id": cluster.ID}, &resp.Diagnostics)
 		return
 	}
 
 	newState := convertSDKCreateResultToTerraformCreateClusterModel(&createdCluster)
+	newState.Timeouts = data.Timeouts
+	newState.Region = data.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
 
-func (r *k8sClusterResource) GetClusterPooling(ctx context.Context, clusterId string, states ...string) (k8sSDK.Cluster, error) {
-	var result *k8sSDK.Cluster
-	var err error
-	for startTime := time.Now(); time.Since(startTime) < ClusterPoolingTimeout; {
-		time.Sleep(1 * time.Minute)
-		result, err = r.k8sCluster.Get(ctx, clusterId)
-		if err != nil {
-			return k8sSDK.Cluster{}, err
-		}
-		state := strings.ToLower(result.Status.State)
-
-		if slices.Contains(states, state) {
-			return *result, nil
-		}
-		if state == "failed" {
-			return *result, errors.New("cluster failed to provision")
-		}
-
-		tflog.Debug(ctx, fmt.Sprintf("current cluster state: [%s]", state))
+func (r *k8sClusterResource) GetClusterPooling(ctx context.Context, clusterId string, timeout time.Duration, states ...string) (k8sSDK.Cluster, error) {
+	result, err := utils.StateWaiter[*k8sSDK.Cluster]{
+		Description: fmt.Sprintf("cluster %s", clusterId),
+		Target:      states,
+		Error:       []string{"failed"},
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		MinInterval: 10 * time.Second,
+		MaxInterval: time.Minute,
+		Refresh: func(ctx context.Context) (*k8sSDK.Cluster, string, error) {
+			cluster, err := r.k8sCluster.Get(ctx, clusterId)
+			if err != nil {
+				return nil, "", err
+			}
+			return cluster, strings.ToLower(cluster.Status.State), nil
+		},
+	}.Wait(ctx)
+	if result == nil {
+		return k8sSDK.Cluster{}, err
 	}
-
-	return *result, errors.New("timeout waiting for cluster to provision")
+	return *result, err
 }
 
 func (r *k8sClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, kubernetesClusterTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer clusterIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var plan KubernetesClusterCreateResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state KubernetesClusterCreateResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, ClusterPoolingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	patch := buildPatchClusterRequest(state, plan)
 
 	_, err := r.k8sCluster.Update(ctx, state.ID.ValueString(), patch)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	state.AllowedCidrs = plan.AllowedCidrs
 	state.Description = plan.Description
 
-	upgraded, err := r.GetClusterPooling(ctx, state.ID.ValueString(), "running")
+	upgraded, err := r.GetClusterPooling(ctx, state.ID.ValueString(), updateTimeout, "running")
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	newState := convertSDKCreateResultToTerraformCreateClusterModel(&upgraded)
+	newState.Timeouts = plan.Timeouts
+	newState.Region = plan.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, newState)...)
 }
 
 func (r *k8sClusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, kubernetesClusterTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data KubernetesClusterCreateResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, ClusterPoolingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.k8sCluster.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	if _, err := r.GetClusterPooling(ctx, data.ID.ValueString(), "deleted"); err != nil {
-		switch e := err.(type) {
-		case *clientSDK.HTTPError:
-			if e.StatusCode == http.StatusNotFound {
-				return
-			}
-		default:
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
-			return
-		}
+	if _, err := r.GetClusterPooling(ctx, data.ID.ValueString(), deleteTimeout, "deleted"); err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
 	}
 }
 
-func (r *k8sClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if req.ID == "" {
-		resp.Diagnostics.AddError("Invalid import ID", "The ID must be provided")
-		return
-	}
+func (r *k8sClusterResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = clusterIdentity.Schema()
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+func (r *k8sClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterIdentity.ImportState(ctx, req, resp)
 }
 
 func createAllowedCidrs(data []types.String) *[]string {
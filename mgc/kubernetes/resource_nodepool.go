@@ -3,15 +3,14 @@ package kubernetes
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"regexp"
-	"strings"
 	"time"
 
-	clientSDK "github.com/MagaluCloud/mgc-sdk-go/client"
 	k8sSDK "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
@@ -27,9 +26,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const kubernetesNodepoolTypeName = "mgc_kubernetes_nodepool"
+
 const (
 	NodepoolRunningState = "Running"
 	NodepoolDeletedState = "Deleted"
@@ -41,21 +41,35 @@ var (
 )
 
 type NodePoolResourceModel struct {
-	ClusterID types.String `tfsdk:"cluster_id"`
+	ClusterID types.String   `tfsdk:"cluster_id"`
+	Region    types.String   `tfsdk:"region"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
 	NodePool
 }
 
+var nodepoolTimeoutsOpts = timeouts.Opts{Create: true, Update: true, Delete: true}
+
 type NewNodePoolResource struct {
+	regional    utils.RegionalService
 	sdkNodepool k8sSDK.NodePoolService
 	region      string
 }
 
+// nodepoolSchemaVersion 1 replaced the auto_scale object of older releases by
+// min_replicas and max_replicas.
+const nodepoolSchemaVersion = 1
+
+var nodepoolIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "cluster_id", Description: "ID of the Kubernetes cluster."},
+	utils.IdentityAttribute{Name: "node_pool_id", Description: "ID of the node pool.", StateAttribute: "id"},
+)
+
 func NewNewNodePoolResource() resource.Resource {
 	return &NewNodePoolResource{}
 }
 
-func (r *NewNodePoolResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_kubernetes_nodepool"
+func (r *NewNodePoolResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = kubernetesNodepoolTypeName
 }
 
 func (r *NewNodePoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -68,8 +82,10 @@ func (r *NewNodePoolResource) Configure(ctx context.Context, req resource.Config
 		return
 	}
 
-	r.region = dataConfig.Region
-	r.sdkNodepool = k8sSDK.New(&dataConfig.CoreConfig).Nodepools()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.region = cfg.Region
+		r.sdkNodepool = k8sSDK.New(&cfg.CoreConfig).Nodepools()
+	})
 }
 
 func (r *NewNodePoolResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
@@ -81,11 +97,13 @@ func (r *NewNodePoolResource) ConfigValidators(_ context.Context) []resource.Con
 	}
 }
 
-func (r *NewNodePoolResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NewNodePoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	azRegex := regexp.MustCompile(`^[a-z]{2}-[a-z]+[0-9]+-[a-z]$`)
 	resp.Schema = schema.Schema{
+		Version:     nodepoolSchemaVersion,
 		Description: "An array representing a set of nodes within a Kubernetes cluster.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"flavor_name": schema.StringAttribute{
 				Description: "Definition of the CPU, RAM, and storage capacity of the nodes.",
 				Required:    true,
@@ -106,7 +124,7 @@ func (r *NewNodePoolResource) Schema(_ context.Context, req resource.SchemaReque
 				},
 			},
 			"replicas": schema.Int64Attribute{
-				Description: "Initial number of replicas of the nodes in the node pool. Required at creation; after creation, changes to this value are ignored because the replica count is managed by the API (e.g. via autoscaling between min_replicas and max_replicas).",
+				Description: "Initial number of replicas of the nodes in the node pool. Required at creation; after creation, changes to this value are ignored because the replica count is managed by the API (e.g. via autoscaling between min_replicas and max_replicas). Use the mgc_kubernetes_nodepool_scale action to change it.",
 				Required:    true,
 				Validators: []validator.Int64{
 					int64validator.AtLeast(0),
@@ -230,19 +248,34 @@ func (r *NewNodePoolResource) Schema(_ context.Context, req resource.SchemaReque
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, nodepoolTimeoutsOpts),
+		},
 	}
 }
 
 func (r *NewNodePoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, kubernetesNodepoolTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer nodepoolIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NodePoolResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	nodepool, err := r.sdkNodepool.Get(ctx, data.ClusterID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -251,12 +284,26 @@ func (r *NewNodePoolResource) Read(ctx context.Context, req resource.ReadRequest
 }
 
 func (r *NewNodePoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, kubernetesNodepoolTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer nodepoolIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NodePoolResourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, NodepoolTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createParams := k8sSDK.CreateNodePoolRequest{
 		Flavor:         data.Flavor.ValueString(),
 		Name:           data.Name.ValueString(),
@@ -300,7 +347,7 @@ func (r *NewNodePoolResource) Create(ctx context.Context, req resource.CreateReq
 
 	nodepool, err := r.sdkNodepool.Create(ctx, data.ClusterID.ValueString(), createParams)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -310,44 +357,58 @@ func (r *NewNodePoolResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	err = r.waitNodePoolState(ctx, nodepool.ID, data.ClusterID.ValueString(), NodepoolRunningState, NodepoolTimeout, NodepoolInterval)
+	err = waitNodePoolState(ctx, r.sdkNodepool, nodepool.ID, data.ClusterID.ValueString(), NodepoolRunningState, createTimeout, NodepoolInterval)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
 func (r *NewNodePoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, kubernetesNodepoolTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer nodepoolIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NodePoolResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var state NodePoolResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	updateTimeout, diags := data.Timeouts.Update(ctx, NodepoolTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	updateParam := buildPatchNodePoolRequest(data)
 
 	nodepool, err := r.sdkNodepool.Update(ctx, data.ClusterID.ValueString(), data.ID.ValueString(), updateParam)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	data.NodePool = ConvertToNodePoolToTFModel(nodepool, r.region)
-	err = r.waitNodePoolState(ctx, data.ID.ValueString(), data.ClusterID.ValueString(), NodepoolRunningState, NodepoolTimeout, NodepoolInterval)
+	err = waitNodePoolState(ctx, r.sdkNodepool, data.ID.ValueString(), data.ClusterID.ValueString(), NodepoolRunningState, updateTimeout, NodepoolInterval)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	upgraded, err := r.sdkNodepool.Get(ctx, data.ClusterID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -375,45 +436,54 @@ func buildPatchNodePoolRequest(plan NodePoolResourceModel) k8sSDK.PatchNodePoolR
 }
 
 func (r *NewNodePoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, kubernetesNodepoolTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data NodePoolResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, NodepoolTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.sdkNodepool.Delete(ctx, data.ClusterID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	if err := r.waitNodePoolState(ctx, data.ID.ValueString(), data.ClusterID.ValueString(), NodepoolDeletedState, NodepoolTimeout, NodepoolInterval); err != nil {
-		switch e := err.(type) {
-		case *clientSDK.HTTPError:
-			if e.StatusCode == http.StatusNotFound {
-				return
-			}
-		default:
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
-			return
-		}
+	if err := waitNodePoolState(ctx, r.sdkNodepool, data.ID.ValueString(), data.ClusterID.ValueString(), NodepoolDeletedState, deleteTimeout, NodepoolInterval); err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
 	}
 }
 
-func (r *NewNodePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	ids := strings.Split(req.ID, ",")
-	if len(ids) != 2 {
-		resp.Diagnostics.AddError("Invalid import ID", "Expected format: cluster_id,node_pool_id")
-		return
+func (r *NewNodePoolResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: utils.UpgradeState(
+			utils.LiftAttributes("auto_scale", map[string]string{
+				"min_replicas": "min_replicas",
+				"max_replicas": "max_replicas",
+			}),
+		),
 	}
+}
 
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("cluster_id"), ids[0])...,
-	)
+func (r *NewNodePoolResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = nodepoolIdentity.Schema()
+}
 
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("id"), ids[1])...,
-	)
+func (r *NewNodePoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	nodepoolIdentity.ImportState(ctx, req, resp)
 }
 
 func convertTaintsNP(taints *[]Taint) *[]k8sSDK.Taint {
@@ -464,19 +534,21 @@ func convertStringSetTFToSliceString(ctx context.Context, set types.Set) (*[]str
 	return &result, nil
 }
 
-func (r *NewNodePoolResource) waitNodePoolState(ctx context.Context, nodepoolid, clusterId, state string, timeout, interval time.Duration) error {
-	for startTime := time.Now(); time.Since(startTime) < timeout; {
-		time.Sleep(interval)
-
-		nodepool, err := r.sdkNodepool.Get(ctx, clusterId, nodepoolid)
-		if err != nil {
-			return err
-		}
-		if nodepool.Status.State == state {
-			return nil
-		}
-
-		tflog.Debug(ctx, fmt.Sprintf("Node pool %s is in state %s", nodepoolid, nodepool.Status.State))
-	}
-	return fmt.Errorf("timeout waiting for node pool creation")
+func waitNodePoolState(ctx context.Context, nodepools k8sSDK.NodePoolService, nodepoolid, clusterId, state string, timeout, interval time.Duration) error {
+	_, err := utils.StateWaiter[*k8sSDK.NodePool]{
+		Description: fmt.Sprintf("node pool %s", nodepoolid),
+		Target:      []string{state},
+		Timeout:     timeout,
+		Delay:       interval,
+		MinInterval: interval,
+		MaxInterval: 2 * interval,
+		Refresh: func(ctx context.Context) (*k8sSDK.NodePool, string, error) {
+			nodepool, err := nodepools.Get(ctx, clusterId, nodepoolid)
+			if err != nil {
+				return nil, "", err
+			}
+			return nodepool, nodepool.Status.State, nil
+		},
+	}.Wait(ctx)
+	return err
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	k8sSDK "github.com/MagaluCloud/mgc-sdk-go/kubernetes"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 )
@@ -44,9 +46,7 @@ func TestWaitNodePoolState(t *testing.T) {
 				}, nil
 			},
 		}
-		r := &NewNodePoolResource{sdkNodepool: mockSvc}
-
-		err := r.waitNodePoolState(ctx, "np-id", "cluster-id", NodepoolRunningState, testTimeout, testInterval)
+		err := waitNodePoolState(ctx, mockSvc, "np-id", "cluster-id", NodepoolRunningState, testTimeout, testInterval)
 		assert.NoError(t, err)
 	})
 
@@ -65,9 +65,7 @@ func TestWaitNodePoolState(t *testing.T) {
 				}, nil
 			},
 		}
-		r := &NewNodePoolResource{sdkNodepool: mockSvc}
-
-		err := r.waitNodePoolState(ctx, "np-id", "cluster-id", NodepoolRunningState, testTimeout, testInterval)
+		err := waitNodePoolState(ctx, mockSvc, "np-id", "cluster-id", NodepoolRunningState, testTimeout, testInterval)
 		assert.NoError(t, err)
 	})
 
@@ -79,11 +77,10 @@ func TestWaitNodePoolState(t *testing.T) {
 				}, nil
 			},
 		}
-		r := &NewNodePoolResource{sdkNodepool: mockSvc}
-
-		err := r.waitNodePoolState(ctx, "np-id", "cluster-id", NodepoolRunningState, testTimeout, testInterval)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "timeout waiting for node pool creation")
+		err := waitNodePoolState(ctx, mockSvc, "np-id", "cluster-id", NodepoolRunningState, testTimeout, testInterval)
+		var timeoutErr *utils.WaitTimeoutError
+		assert.ErrorAs(t, err, &timeoutErr)
+		assert.Contains(t, err.Error(), "timeout after")
 	})
 
 	t.Run("should return error from the SDK get call", func(t *testing.T) {
@@ -93,9 +90,7 @@ func TestWaitNodePoolState(t *testing.T) {
 				return nil, expectedErr
 			},
 		}
-		r := &NewNodePoolResource{sdkNodepool: mockSvc}
-
-		err := r.waitNodePoolState(ctx, "np-id", "cluster-id", NodepoolRunningState, testTimeout, testInterval)
+		err := waitNodePoolState(ctx, mockSvc, "np-id", "cluster-id", NodepoolRunningState, testTimeout, testInterval)
 		assert.Error(t, err)
 		assert.Equal(t, expectedErr, err)
 	})
@@ -335,3 +330,40 @@ func TestNodePoolSchemaMaxPodsPerNodePlanModifiers(t *testing.T) {
 		assert.True(t, hasUseStateForUnknown)
 	})
 }
+
+func TestNodePoolUpgradeState(t *testing.T) {
+	ctx := context.Background()
+	r := &NewNodePoolResource{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	assert.Equal(t, int64(nodepoolSchemaVersion), schemaResp.Schema.Version)
+
+	prior := `{
+		"id": "np-id",
+		"cluster_id": "cluster-id",
+		"name": "pool",
+		"flavor_name": "cloud-k8s.gp1.small",
+		"replicas": 2,
+		"auto_scale": {"min_replicas": 1, "max_replicas": 5},
+		"taints": [{"key": "dedicated", "value": "gpu", "effect": "NoSchedule"}]
+	}`
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	r.UpgradeState(ctx)[0].StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(prior)}}, resp)
+	if !assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics) {
+		return
+	}
+
+	raw, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	assert.NoError(t, err)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+
+	var minReplicas, maxReplicas types.Int64
+	state.GetAttribute(ctx, path.Root("min_replicas"), &minReplicas)
+	state.GetAttribute(ctx, path.Root("max_replicas"), &maxReplicas)
+	assert.Equal(t, types.Int64Value(1), minReplicas)
+	assert.Equal(t, types.Int64Value(5), maxReplicas)
+
+	var taintKey types.String
+	state.GetAttribute(ctx, path.Root("taints").AtListIndex(0).AtName("key"), &taintKey)
+	assert.Equal(t, types.StringValue("dedicated"), taintKey)
+}
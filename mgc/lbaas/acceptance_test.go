@@ -0,0 +1,130 @@
+package lbaas_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/acctest"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+)
+
+func testAccLoadBalancerConfig(srv *fakeapi.Server, name, remoteIPPrefix string, intervalSeconds int) string {
+	return acctest.Config(srv, fmt.Sprintf(`
+resource "mgc_network_vpcs" "test" {
+  name = "acc-lb-vpc"
+}
+
+resource "mgc_lbaas_network" "test" {
+  name        = %[1]q
+  description = "acceptance test"
+  type        = "proxy"
+  visibility  = "internal"
+  vpc_id      = mgc_network_vpcs.test.id
+
+  acls = [{
+    name             = "allow-office"
+    action           = "ALLOW"
+    ethertype        = "IPv4"
+    protocol         = "tcp"
+    remote_ip_prefix = %[2]q
+  }]
+
+  health_checks = [{
+    name                      = "hc"
+    protocol                  = "tcp"
+    port                      = 80
+    healthy_status_code       = 200
+    healthy_threshold_count   = 8
+    initial_delay_seconds     = 20
+    interval_seconds          = %[3]d
+    timeout_seconds           = 10
+    unhealthy_threshold_count = 3
+  }]
+
+  backends = [{
+    name                                     = "backend"
+    balance_algorithm                        = "round_robin"
+    health_check_name                        = "hc"
+    panic_threshold                          = 50
+    close_connections_on_host_health_failure = true
+    targets_type                             = "raw"
+    targets = [{
+      ip_address = "10.0.0.10"
+      port       = 80
+    }]
+  }]
+
+  listeners = [{
+    name         = "listener"
+    backend_name = "backend"
+    protocol     = "tcp"
+    port         = 80
+  }]
+}
+`, name, remoteIPPrefix, intervalSeconds))
+}
+
+func TestAccLoadBalancerNetwork(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_lbaas_network", "mgc_network_vpcs"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLoadBalancerConfig(srv, "acc-lb", "10.0.0.0/8", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mgc_lbaas_network.test", "id"),
+					resource.TestCheckResourceAttrPair("mgc_lbaas_network.test", "vpc_id", "mgc_network_vpcs.test", "id"),
+					resource.TestCheckResourceAttr("mgc_lbaas_network.test", "backends.#", "1"),
+					resource.TestCheckResourceAttr("mgc_lbaas_network.test", "listeners.#", "1"),
+				),
+			},
+			{
+				ResourceName:            "mgc_lbaas_network.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccLoadBalancerConfig(srv, "acc-lb-renamed", "192.168.0.0/16", 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mgc_lbaas_network.test", "name", "acc-lb-renamed"),
+					resource.TestCheckResourceAttr("mgc_lbaas_network.test", "acls.0.remote_ip_prefix", "192.168.0.0/16"),
+					resource.TestCheckResourceAttr("mgc_lbaas_network.test", "health_checks.0.interval_seconds", "60"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLoadBalancerNetwork_ProvisioningFailure(t *testing.T) {
+	srv := acctest.NewServer(t)
+	srv.FailProvisioning("acc-lb-failed")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_lbaas_network", "mgc_network_vpcs"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLoadBalancerConfig(srv, "acc-lb-failed", "10.0.0.0/24", 30),
+				ExpectError: regexp.MustCompile(`is in error state`),
+			},
+			{
+				Config: testAccLoadBalancerConfig(srv, "acc-lb", "10.0.0.0/24", 30),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mgc_lbaas_network.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("mgc_lbaas_network.test", "name", "acc-lb"),
+			},
+		},
+	})
+}
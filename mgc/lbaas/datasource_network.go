@@ -9,10 +9,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
 type DataSourceLbaasNetwork struct {
+	regional    utils.RegionalService
 	lbNetworkLB lbSDK.NetworkLoadBalancerService
 }
 
@@ -20,8 +22,8 @@ func NewDataSourceLbaasNetwork() datasource.DataSource {
 	return &DataSourceLbaasNetwork{}
 }
 
-func (r *DataSourceLbaasNetwork) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network"
+func (r *DataSourceLbaasNetwork) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkTypeName
 }
 
 func (r *DataSourceLbaasNetwork) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -34,14 +36,17 @@ func (r *DataSourceLbaasNetwork) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	lbaasClient := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkLB = lbaasClient.NetworkLoadBalancers()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		lbaasClient := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkLB = lbaasClient.NetworkLoadBalancers()
+	})
 }
 
 func (r *DataSourceLbaasNetwork) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "Get the details of a network load balancer.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "The unique identifier of the load balancer.",
@@ -127,15 +132,23 @@ func (r *DataSourceLbaasNetwork) Schema(_ context.Context, _ datasource.SchemaRe
 }
 
 func (r *DataSourceLbaasNetwork) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var id types.String
+	defer tracing.DataSource(&ctx, lbaasNetworkTypeName, &resp.State, &resp.Diagnostics)()
+
+	var id, region types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &id)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&region)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	lb, err := r.lbNetworkLB.Get(ctx, id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -202,4 +215,5 @@ func (r *DataSourceLbaasNetwork) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
 }
@@ -7,10 +7,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworkBackendTypeName = "mgc_lbaas_network_backend"
+
 type DataSourceLbaasNetworkBackend struct {
+	regional         utils.RegionalService
 	lbNetworkBackend lbSDK.NetworkBackendService
 }
 
@@ -18,8 +22,8 @@ func NewDataSourceLbaasNetworkBackend() datasource.DataSource {
 	return &DataSourceLbaasNetworkBackend{}
 }
 
-func (r *DataSourceLbaasNetworkBackend) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network_backend"
+func (r *DataSourceLbaasNetworkBackend) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkBackendTypeName
 }
 
 func (r *DataSourceLbaasNetworkBackend) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -31,14 +35,17 @@ func (r *DataSourceLbaasNetworkBackend) Configure(_ context.Context, req datasou
 		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
 		return
 	}
-	client := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkBackend = client.NetworkBackends()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkBackend = client.NetworkBackends()
+	})
 }
 
 func (r *DataSourceLbaasNetworkBackend) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "Get a specific Network Load Balancer Backend by Load Balancer ID and Backend ID. Includes targets.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"lb_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The Network Load Balancer ID.",
@@ -100,20 +107,28 @@ func (r *DataSourceLbaasNetworkBackend) Schema(_ context.Context, _ datasource.S
 }
 
 func (r *DataSourceLbaasNetworkBackend) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, lbaasNetworkBackendTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data networkBackendItemModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	lb, err := r.lbNetworkBackend.Get(ctx, data.LBID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	result := networkBackendItemModel{
 		LBID:             data.LBID,
+		Region:           data.Region,
 		backendItemModel: backendItemModel{}.fromSDKBackend(lb),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &result)...)
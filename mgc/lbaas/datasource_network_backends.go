@@ -8,10 +8,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworkBackendsTypeName = "mgc_lbaas_network_backends"
+
 type DataSourceLbaasNetworkBackends struct {
+	regional         utils.RegionalService
 	lbNetworkBackend lbSDK.NetworkBackendService
 }
 
@@ -19,8 +23,8 @@ func NewDataSourceLbaasNetworkBackends() datasource.DataSource {
 	return &DataSourceLbaasNetworkBackends{}
 }
 
-func (r *DataSourceLbaasNetworkBackends) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network_backends"
+func (r *DataSourceLbaasNetworkBackends) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkBackendsTypeName
 }
 
 func (r *DataSourceLbaasNetworkBackends) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -32,14 +36,17 @@ func (r *DataSourceLbaasNetworkBackends) Configure(_ context.Context, req dataso
 		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
 		return
 	}
-	client := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkBackend = client.NetworkBackends()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkBackend = client.NetworkBackends()
+	})
 }
 
 func (r *DataSourceLbaasNetworkBackends) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "List Network Load Balancer Backends. Includes targets for each backend.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"lb_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The Network Load Balancer ID.",
@@ -111,18 +118,26 @@ func (r *DataSourceLbaasNetworkBackends) Schema(_ context.Context, _ datasource.
 type backendListState struct {
 	LBID     types.String       `tfsdk:"lb_id"`
 	Backends []backendItemModel `tfsdk:"backends"`
+	Region   types.String       `tfsdk:"region"`
 }
 
 func (r *DataSourceLbaasNetworkBackends) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, lbaasNetworkBackendsTypeName, &resp.State, &resp.Diagnostics)()
+
 	var backendList backendListState
 	resp.Diagnostics.Append(req.Config.Get(ctx, &backendList)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&backendList.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	lb, err := r.lbNetworkBackend.ListAll(ctx, backendList.LBID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
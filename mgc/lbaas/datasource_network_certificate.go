@@ -9,10 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworkCertificateTypeName = "mgc_lbaas_network_certificate"
+
 type DataSourceLbaasNetworkCertificate struct {
+	regional     utils.RegionalService
 	lbNetworkTLS lbSDK.NetworkCertificateService
 }
 
@@ -20,8 +24,8 @@ func NewDataSourceLbaasNetworkCertificate() datasource.DataSource {
 	return &DataSourceLbaasNetworkCertificate{}
 }
 
-func (r *DataSourceLbaasNetworkCertificate) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network_certificate"
+func (r *DataSourceLbaasNetworkCertificate) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkCertificateTypeName
 }
 
 func (r *DataSourceLbaasNetworkCertificate) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -35,14 +39,17 @@ func (r *DataSourceLbaasNetworkCertificate) Configure(_ context.Context, req dat
 		return
 	}
 
-	client := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkTLS = client.NetworkCertificates()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkTLS = client.NetworkCertificates()
+	})
 }
 
 func (r *DataSourceLbaasNetworkCertificate) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "Get a specific Network Load Balancer TLS Certificate by Load Balancer ID and Certificate ID.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"lb_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The Network Load Balancer ID.",
@@ -80,16 +87,24 @@ type certificateItemState struct {
 }
 
 func (r *DataSourceLbaasNetworkCertificate) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var lbID, certID types.String
+	defer tracing.DataSource(&ctx, lbaasNetworkCertificateTypeName, &resp.State, &resp.Diagnostics)()
+
+	var lbID, certID, region types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lb_id"), &lbID)...)
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &certID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&region)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	lb, err := r.lbNetworkTLS.Get(ctx, lbID.ValueString(), certID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	item := certificateItemState{
@@ -102,4 +117,5 @@ func (r *DataSourceLbaasNetworkCertificate) Read(ctx context.Context, req dataso
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &item)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
 }
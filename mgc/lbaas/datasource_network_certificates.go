@@ -9,10 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworkCertificatesTypeName = "mgc_lbaas_network_certificates"
+
 type DataSourceLbaasNetworkCertificates struct {
+	regional     utils.RegionalService
 	lbNetworkTLS lbSDK.NetworkCertificateService
 }
 
@@ -20,8 +24,8 @@ func NewDataSourceLbaasNetworkCertificates() datasource.DataSource {
 	return &DataSourceLbaasNetworkCertificates{}
 }
 
-func (r *DataSourceLbaasNetworkCertificates) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network_certificates"
+func (r *DataSourceLbaasNetworkCertificates) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkCertificatesTypeName
 }
 
 func (r *DataSourceLbaasNetworkCertificates) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -35,14 +39,17 @@ func (r *DataSourceLbaasNetworkCertificates) Configure(_ context.Context, req da
 		return
 	}
 
-	client := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkTLS = client.NetworkCertificates()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkTLS = client.NetworkCertificates()
+	})
 }
 
 func (r *DataSourceLbaasNetworkCertificates) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "List TLS Certificates for a Network Load Balancer.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"lb_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The Network Load Balancer ID.",
@@ -81,15 +88,23 @@ type certificateListState struct {
 }
 
 func (r *DataSourceLbaasNetworkCertificates) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var lbID types.String
+	defer tracing.DataSource(&ctx, lbaasNetworkCertificatesTypeName, &resp.State, &resp.Diagnostics)()
+
+	var lbID, region types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lb_id"), &lbID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&region)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	lb, err := r.lbNetworkTLS.ListAll(ctx, lbID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -112,4 +127,5 @@ func (r *DataSourceLbaasNetworkCertificates) Read(ctx context.Context, req datas
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
 }
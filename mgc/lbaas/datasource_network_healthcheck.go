@@ -9,10 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworkHealthcheckTypeName = "mgc_lbaas_network_healthcheck"
+
 type DataSourceLbaasNetworkHealthCheck struct {
+	regional            utils.RegionalService
 	lbNetworkHeathCheck lbSDK.NetworkHealthCheckService
 }
 
@@ -20,8 +24,8 @@ func NewDataSourceLbaasNetworkHealthCheck() datasource.DataSource {
 	return &DataSourceLbaasNetworkHealthCheck{}
 }
 
-func (r *DataSourceLbaasNetworkHealthCheck) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network_healthcheck"
+func (r *DataSourceLbaasNetworkHealthCheck) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkHealthcheckTypeName
 }
 
 func (r *DataSourceLbaasNetworkHealthCheck) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -35,14 +39,17 @@ func (r *DataSourceLbaasNetworkHealthCheck) Configure(_ context.Context, req dat
 		return
 	}
 
-	client := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkHeathCheck = client.NetworkHealthChecks()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkHeathCheck = client.NetworkHealthChecks()
+	})
 }
 
 func (r *DataSourceLbaasNetworkHealthCheck) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "Get a specific Network Load Balancer Health Check by Load Balancer ID and Health Check ID.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"lb_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The Network Load Balancer ID.",
@@ -105,16 +112,24 @@ type healthCheckItemModel struct {
 }
 
 func (r *DataSourceLbaasNetworkHealthCheck) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var lbID, hcID types.String
+	defer tracing.DataSource(&ctx, lbaasNetworkHealthcheckTypeName, &resp.State, &resp.Diagnostics)()
+
+	var lbID, hcID, region types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lb_id"), &lbID)...)
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &hcID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&region)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	lb, err := r.lbNetworkHeathCheck.Get(ctx, lbID.ValueString(), hcID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -136,4 +151,5 @@ func (r *DataSourceLbaasNetworkHealthCheck) Read(ctx context.Context, req dataso
 		},
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &item)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
 }
@@ -9,10 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworkHealthchecksTypeName = "mgc_lbaas_network_healthchecks"
+
 type DataSourceLbaasNetworkHealthChecks struct {
+	regional            utils.RegionalService
 	lbNetworkHeathCheck lbSDK.NetworkHealthCheckService
 }
 
@@ -20,8 +24,8 @@ func NewDataSourceLbaasNetworkHealthChecks() datasource.DataSource {
 	return &DataSourceLbaasNetworkHealthChecks{}
 }
 
-func (r *DataSourceLbaasNetworkHealthChecks) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network_healthchecks"
+func (r *DataSourceLbaasNetworkHealthChecks) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkHealthchecksTypeName
 }
 
 func (r *DataSourceLbaasNetworkHealthChecks) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -35,14 +39,17 @@ func (r *DataSourceLbaasNetworkHealthChecks) Configure(_ context.Context, req da
 		return
 	}
 
-	client := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkHeathCheck = client.NetworkHealthChecks()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkHeathCheck = client.NetworkHealthChecks()
+	})
 }
 
 func (r *DataSourceLbaasNetworkHealthChecks) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "List Health Checks for a Network Load Balancer.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"lb_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The Network Load Balancer ID.",
@@ -113,15 +120,23 @@ type healthCheckListState struct {
 }
 
 func (r *DataSourceLbaasNetworkHealthChecks) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var lbID types.String
+	defer tracing.DataSource(&ctx, lbaasNetworkHealthchecksTypeName, &resp.State, &resp.Diagnostics)()
+
+	var lbID, region types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lb_id"), &lbID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&region)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	lb, err := r.lbNetworkHeathCheck.ListAll(ctx, lbID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -146,4 +161,5 @@ func (r *DataSourceLbaasNetworkHealthChecks) Read(ctx context.Context, req datas
 		})
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
 }
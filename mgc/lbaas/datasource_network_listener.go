@@ -9,10 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworkListenerTypeName = "mgc_lbaas_network_listener"
+
 type DataSourceLbaasNetworkListener struct {
+	regional          utils.RegionalService
 	lbNetworkListener lbSDK.NetworkListenerService
 }
 
@@ -20,8 +24,8 @@ func NewDataSourceLbaasNetworkListener() datasource.DataSource {
 	return &DataSourceLbaasNetworkListener{}
 }
 
-func (r *DataSourceLbaasNetworkListener) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network_listener"
+func (r *DataSourceLbaasNetworkListener) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkListenerTypeName
 }
 
 func (r *DataSourceLbaasNetworkListener) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -33,14 +37,17 @@ func (r *DataSourceLbaasNetworkListener) Configure(_ context.Context, req dataso
 		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
 		return
 	}
-	client := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkListener = client.NetworkListeners()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkListener = client.NetworkListeners()
+	})
 }
 
 func (r *DataSourceLbaasNetworkListener) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "Get a specific Network Load Balancer Listener by Load Balancer ID and Listener ID.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"lb_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The Network Load Balancer ID.",
@@ -93,16 +100,24 @@ type networkListenerItemModel struct {
 }
 
 func (r *DataSourceLbaasNetworkListener) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var lbID, listenerID types.String
+	defer tracing.DataSource(&ctx, lbaasNetworkListenerTypeName, &resp.State, &resp.Diagnostics)()
+
+	var lbID, listenerID, region types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lb_id"), &lbID)...)
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("id"), &listenerID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&region)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	lb, err := r.lbNetworkListener.Get(ctx, lbID.ValueString(), listenerID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -119,4 +134,5 @@ func (r *DataSourceLbaasNetworkListener) Read(ctx context.Context, req datasourc
 		},
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &item)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
 }
@@ -9,10 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworkListenersTypeName = "mgc_lbaas_network_listeners"
+
 type DataSourceLbaasNetworkListeners struct {
+	regional          utils.RegionalService
 	lbNetworkListener lbSDK.NetworkListenerService
 }
 
@@ -20,8 +24,8 @@ func NewDataSourceLbaasNetworkListeners() datasource.DataSource {
 	return &DataSourceLbaasNetworkListeners{}
 }
 
-func (r *DataSourceLbaasNetworkListeners) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network_listeners"
+func (r *DataSourceLbaasNetworkListeners) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkListenersTypeName
 }
 
 func (r *DataSourceLbaasNetworkListeners) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -33,14 +37,17 @@ func (r *DataSourceLbaasNetworkListeners) Configure(_ context.Context, req datas
 		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
 		return
 	}
-	client := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkListener = client.NetworkListeners()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		client := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkListener = client.NetworkListeners()
+	})
 }
 
 func (r *DataSourceLbaasNetworkListeners) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "List Listeners for a Network Load Balancer.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"lb_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The Network Load Balancer ID.",
@@ -91,15 +98,23 @@ type listenerListState struct {
 }
 
 func (r *DataSourceLbaasNetworkListeners) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var lbID types.String
+	defer tracing.DataSource(&ctx, lbaasNetworkListenersTypeName, &resp.State, &resp.Diagnostics)()
+
+	var lbID, region types.String
 	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("lb_id"), &lbID)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("region"), &region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&region)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	lb, err := r.lbNetworkListener.ListAll(ctx, lbID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -120,4 +135,5 @@ func (r *DataSourceLbaasNetworkListeners) Read(ctx context.Context, req datasour
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("region"), region)...)
 }
@@ -8,10 +8,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworksTypeName = "mgc_lbaas_networks"
+
 type DataSourceLbaasNetworks struct {
+	regional    utils.RegionalService
 	lbNetworkLB lbSDK.NetworkLoadBalancerService
 }
 
@@ -19,8 +23,8 @@ func NewDataSourceLbaasNetworks() datasource.DataSource {
 	return &DataSourceLbaasNetworks{}
 }
 
-func (r *DataSourceLbaasNetworks) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_networks"
+func (r *DataSourceLbaasNetworks) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = lbaasNetworksTypeName
 }
 
 func (r *DataSourceLbaasNetworks) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -33,14 +37,17 @@ func (r *DataSourceLbaasNetworks) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	lbaasClient := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkLB = lbaasClient.NetworkLoadBalancers()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		lbaasClient := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkLB = lbaasClient.NetworkLoadBalancers()
+	})
 }
 
 func (r *DataSourceLbaasNetworks) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "List network load balancers.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"load_balancers": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of network load balancers.",
@@ -134,15 +141,22 @@ func (r *DataSourceLbaasNetworks) Schema(_ context.Context, _ datasource.SchemaR
 }
 
 func (r *DataSourceLbaasNetworks) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, lbaasNetworksTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data LbaasNetworksListModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkList, err := r.lbNetworkLB.ListAll(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
@@ -25,6 +26,8 @@ type LoadBalancerModel struct {
 	HealthChecks    *[]HealthCheckModel    `tfsdk:"health_checks"`
 	Listeners       []ListenerModel        `tfsdk:"listeners"`
 	TLSCertificates *[]TLSCertificateModel `tfsdk:"tls_certificates"`
+	Region          types.String           `tfsdk:"region"`
+	Timeouts        timeouts.Value         `tfsdk:"timeouts"`
 }
 
 type ACLModel struct {
@@ -316,6 +319,8 @@ func (lb *LoadBalancerModel) ToTerraformNetworkResource(ctx context.Context, lbR
 		HealthChecks:    &healthCheckModels,
 		Listeners:       listenerModels,
 		TLSCertificates: &tlsCertificates,
+		Region:          lb.Region,
+		Timeouts:        lb.Timeouts,
 	}
 
 	if lbResponse.PublicIP != nil && lbResponse.PublicIP.ExternalID != "" {
@@ -380,6 +385,7 @@ func (plan *LoadBalancerModel) hasACLChanges(state LoadBalancerModel) bool {
 
 type LbaasNetworksListModel struct {
 	LoadBalancers []lbNetworkItemModel `tfsdk:"load_balancers"`
+	Region        types.String         `tfsdk:"region"`
 }
 
 type lbNetworkItemModel struct {
@@ -411,7 +417,8 @@ type backendItemModel struct {
 }
 
 type networkBackendItemModel struct {
-	LBID types.String `tfsdk:"lb_id"`
+	LBID   types.String `tfsdk:"lb_id"`
+	Region types.String `tfsdk:"region"`
 	backendItemModel
 }
 
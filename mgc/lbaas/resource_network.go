@@ -4,15 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"slices"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
@@ -23,15 +22,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	clientSDK "github.com/MagaluCloud/mgc-sdk-go/client"
 	lbSDK "github.com/MagaluCloud/mgc-sdk-go/lbaas"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const lbaasNetworkTypeName = "mgc_lbaas_network"
+
 const LoadBalancerTimeout = 90 * time.Minute
 
 type LoadBalancerResource struct {
+	regional                utils.RegionalService
 	lbNetworkBackend        lbSDK.NetworkBackendService
 	lbNetworkACL            lbSDK.NetworkACLService
 	lbNetworkHealthCheck    lbSDK.NetworkHealthCheckService
@@ -41,12 +43,16 @@ type LoadBalancerResource struct {
 	lbNetworkLB             lbSDK.NetworkLoadBalancerService
 }
 
+var loadBalancerIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the load balancer."),
+)
+
 func NewLoadBalancerResource() resource.Resource {
 	return &LoadBalancerResource{}
 }
 
-func (r *LoadBalancerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_lbaas_network"
+func (r *LoadBalancerResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = lbaasNetworkTypeName
 }
 
 func (r *LoadBalancerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -60,20 +66,23 @@ func (r *LoadBalancerResource) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	lbaasClient := lbSDK.New(&dataConfig.CoreConfig)
-	r.lbNetworkBackend = lbaasClient.NetworkBackends()
-	r.lbNetworkACL = lbaasClient.NetworkACLs()
-	r.lbNetworkHealthCheck = lbaasClient.NetworkHealthChecks()
-	r.lbNetworkListener = lbaasClient.NetworkListeners()
-	r.lbNetworkTLSCertificate = lbaasClient.NetworkCertificates()
-	r.lbNetworkTarget = lbaasClient.NetworkBackendTargets()
-	r.lbNetworkLB = lbaasClient.NetworkLoadBalancers()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		lbaasClient := lbSDK.New(&cfg.CoreConfig)
+		r.lbNetworkBackend = lbaasClient.NetworkBackends()
+		r.lbNetworkACL = lbaasClient.NetworkACLs()
+		r.lbNetworkHealthCheck = lbaasClient.NetworkHealthChecks()
+		r.lbNetworkListener = lbaasClient.NetworkListeners()
+		r.lbNetworkTLSCertificate = lbaasClient.NetworkCertificates()
+		r.lbNetworkTarget = lbaasClient.NetworkBackendTargets()
+		r.lbNetworkLB = lbaasClient.NetworkLoadBalancers()
+	})
 }
 
-func (r *LoadBalancerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *LoadBalancerResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: experimentalWarning + "Manages network load balancers in Magalu Cloud.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the load balancer.",
 				Computed:    true,
@@ -492,16 +501,33 @@ func (r *LoadBalancerResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),
+		},
 	}
 }
 
 func (r *LoadBalancerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, lbaasNetworkTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer loadBalancerIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data LoadBalancerModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, LoadBalancerTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createdLB, err := r.lbNetworkLB.Create(ctx, lbSDK.CreateNetworkLoadBalancerRequest{
 		Description:     data.Description.ValueStringPointer(),
 		Name:            data.Name.ValueString(),
@@ -517,14 +543,15 @@ func (r *LoadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 		TLSCertificates: data.ConvertTLSCertificatesToSDK(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	data.ID = types.StringValue(createdLB)
-	getLB, err := r.waitLoadBalancerState(ctx, createdLB, lbSDK.LoadBalancerStatusRunning)
+	getLB, err := r.waitLoadBalancerState(ctx, createdLB, lbSDK.LoadBalancerStatusRunning, createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		loadBalancerIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": data.Region.ValueString(), "id": createdLB}, &resp.Diagnostics)
 		return
 	}
 	if getLB == nil {
@@ -537,15 +564,27 @@ func (r *LoadBalancerResource) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *LoadBalancerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, lbaasNetworkTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer loadBalancerIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data LoadBalancerModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	lb, err := r.lbNetworkLB.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -554,6 +593,9 @@ func (r *LoadBalancerResource) Read(ctx context.Context, req resource.ReadReques
 }
 
 func (r *LoadBalancerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, lbaasNetworkTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer loadBalancerIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var planData LoadBalancerModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
 	if resp.Diagnostics.HasError() {
@@ -566,40 +608,52 @@ func (r *LoadBalancerResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	if err := r.updateLBNameDescription(ctx, &planData, &stateData); err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+	resp.Diagnostics.Append(r.regional.Use(&stateData.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := planData.Timeouts.Update(ctx, LoadBalancerTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	stateData.Timeouts = planData.Timeouts
 
-	if err := r.replaceACLsIfChanged(ctx, &planData, &stateData); err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+	if err := r.updateLBNameDescription(ctx, &planData, &stateData, updateTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	if err := r.updateHealthChecks(ctx, &planData, &stateData); err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+	if err := r.replaceACLsIfChanged(ctx, &planData, &stateData, updateTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	if err := r.updateBackendsFields(ctx, &planData, &stateData); err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+	if err := r.updateHealthChecks(ctx, &planData, &stateData, updateTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	if err := r.replaceBackendTargets(ctx, &planData, &stateData); err != nil {
+	if err := r.updateBackendsFields(ctx, &planData, &stateData, updateTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+
+	if err := r.replaceBackendTargets(ctx, &planData, &stateData, updateTimeout); err != nil {
 		var nf healthCheckNotFoundError
 		if errors.As(err, &nf) {
 			resp.Diagnostics.AddError("Health Check Not Found", nf.Error())
 			return
 		}
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
 }
 
-func (r *LoadBalancerResource) updateLBNameDescription(ctx context.Context, plan, state *LoadBalancerModel) error {
+func (r *LoadBalancerResource) updateLBNameDescription(ctx context.Context, plan, state *LoadBalancerModel, timeout time.Duration) error {
 	if !plan.Description.Equal(state.Description) || !plan.Name.Equal(state.Name) {
 		state.Description = plan.Description
 		state.Name = plan.Name
@@ -610,7 +664,7 @@ func (r *LoadBalancerResource) updateLBNameDescription(ctx context.Context, plan
 		if err != nil {
 			return err
 		}
-		_, err = r.waitLoadBalancerState(ctx, plan.ID.ValueString(), lbSDK.LoadBalancerStatusRunning)
+		_, err = r.waitLoadBalancerState(ctx, plan.ID.ValueString(), lbSDK.LoadBalancerStatusRunning, timeout)
 		if err != nil {
 			return err
 		}
@@ -618,7 +672,7 @@ func (r *LoadBalancerResource) updateLBNameDescription(ctx context.Context, plan
 	return nil
 }
 
-func (r *LoadBalancerResource) replaceACLsIfChanged(ctx context.Context, plan, state *LoadBalancerModel) error {
+func (r *LoadBalancerResource) replaceACLsIfChanged(ctx context.Context, plan, state *LoadBalancerModel, timeout time.Duration) error {
 	if plan.hasACLChanges(*state) {
 		state.ACLs = plan.ACLs
 		updatedACL := plan.ConvertACLsToSDK()
@@ -631,7 +685,7 @@ func (r *LoadBalancerResource) replaceACLsIfChanged(ctx context.Context, plan, s
 		if err != nil {
 			return err
 		}
-		_, err = r.waitLoadBalancerState(ctx, state.ID.ValueString(), lbSDK.LoadBalancerStatusRunning)
+		_, err = r.waitLoadBalancerState(ctx, state.ID.ValueString(), lbSDK.LoadBalancerStatusRunning, timeout)
 		if err != nil {
 			return err
 		}
@@ -639,7 +693,7 @@ func (r *LoadBalancerResource) replaceACLsIfChanged(ctx context.Context, plan, s
 	return nil
 }
 
-func (r *LoadBalancerResource) updateHealthChecks(ctx context.Context, plan, state *LoadBalancerModel) error {
+func (r *LoadBalancerResource) updateHealthChecks(ctx context.Context, plan, state *LoadBalancerModel, timeout time.Duration) error {
 	if hasChange, updatedHealthChecks := plan.healthChecksToUpdate(*state); hasChange {
 		state.HealthChecks = plan.HealthChecks
 		for _, hc := range updatedHealthChecks {
@@ -658,7 +712,7 @@ func (r *LoadBalancerResource) updateHealthChecks(ctx context.Context, plan, sta
 				return err
 			}
 
-			_, err = r.waitLoadBalancerState(ctx, plan.ID.ValueString(), lbSDK.LoadBalancerStatusRunning)
+			_, err = r.waitLoadBalancerState(ctx, plan.ID.ValueString(), lbSDK.LoadBalancerStatusRunning, timeout)
 			if err != nil {
 				return err
 			}
@@ -667,7 +721,7 @@ func (r *LoadBalancerResource) updateHealthChecks(ctx context.Context, plan, sta
 	return nil
 }
 
-func (r *LoadBalancerResource) updateBackendsFields(ctx context.Context, plan, state *LoadBalancerModel) error {
+func (r *LoadBalancerResource) updateBackendsFields(ctx context.Context, plan, state *LoadBalancerModel, timeout time.Duration) error {
 	backendFieldUpdates, _ := plan.backendsToUpdate(*state)
 	if len(backendFieldUpdates) == 0 {
 		return nil
@@ -687,7 +741,7 @@ func (r *LoadBalancerResource) updateBackendsFields(ctx context.Context, plan, s
 		if err != nil {
 			return err
 		}
-		_, err = r.waitLoadBalancerState(ctx, plan.ID.ValueString(), lbSDK.LoadBalancerStatusRunning)
+		_, err = r.waitLoadBalancerState(ctx, plan.ID.ValueString(), lbSDK.LoadBalancerStatusRunning, timeout)
 		if err != nil {
 			return err
 		}
@@ -695,7 +749,7 @@ func (r *LoadBalancerResource) updateBackendsFields(ctx context.Context, plan, s
 	return nil
 }
 
-func (r *LoadBalancerResource) replaceBackendTargets(ctx context.Context, plan, state *LoadBalancerModel) error {
+func (r *LoadBalancerResource) replaceBackendTargets(ctx context.Context, plan, state *LoadBalancerModel, timeout time.Duration) error {
 	_, backendTargetUpdates := plan.backendsToUpdate(*state)
 	if len(backendTargetUpdates) == 0 {
 		return nil
@@ -742,7 +796,7 @@ func (r *LoadBalancerResource) replaceBackendTargets(ctx context.Context, plan,
 		if err != nil {
 			return err
 		}
-		_, err = r.waitLoadBalancerState(ctx, plan.ID.ValueString(), lbSDK.LoadBalancerStatusRunning)
+		_, err = r.waitLoadBalancerState(ctx, plan.ID.ValueString(), lbSDK.LoadBalancerStatusRunning, timeout)
 		if err != nil {
 			return err
 		}
@@ -752,57 +806,69 @@ func (r *LoadBalancerResource) replaceBackendTargets(ctx context.Context, plan,
 }
 
 func (r *LoadBalancerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, lbaasNetworkTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data LoadBalancerModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, LoadBalancerTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	deletePublicIP := false
 	err := r.lbNetworkLB.Delete(ctx, data.ID.ValueString(), lbSDK.DeleteNetworkLoadBalancerRequest{
 		DeletePublicIP: &deletePublicIP,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
-	_, err = r.waitLoadBalancerState(ctx, data.ID.ValueString(), lbSDK.LoadBalancerStatusDeleted)
-	if err != nil {
-		switch e := err.(type) {
-		case *clientSDK.HTTPError:
-			if e.StatusCode == http.StatusNotFound {
-				return
-			}
-		default:
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
-			return
-		}
+	_, err = r.waitLoadBalancerState(ctx, data.ID.ValueString(), lbSDK.LoadBalancerStatusDeleted, deleteTimeout)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
 	}
 
 }
 
+func (r *LoadBalancerResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = loadBalancerIdentity.Schema()
+}
+
 func (r *LoadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	loadBalancerIdentity.ImportState(ctx, req, resp)
 }
 
-func (r *LoadBalancerResource) waitLoadBalancerState(ctx context.Context, lbID string, desiredState lbSDK.LoadBalancerStatus) (*lbSDK.NetworkLoadBalancerResponse, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, LoadBalancerTimeout)
-	defer cancel()
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for load balancer %s to reach status %s", lbID, desiredState)
-		case <-time.After(10 * time.Second):
+func (r *LoadBalancerResource) waitLoadBalancerState(ctx context.Context, lbID string, desiredState lbSDK.LoadBalancerStatus, timeout time.Duration) (*lbSDK.NetworkLoadBalancerResponse, error) {
+	return utils.StateWaiter[*lbSDK.NetworkLoadBalancerResponse]{
+		Description: fmt.Sprintf("load balancer %s", lbID),
+		Target:      []string{string(desiredState)},
+		Timeout:     timeout,
+		Delay:       10 * time.Second,
+		MinInterval: 10 * time.Second,
+		Refresh: func(ctx context.Context) (*lbSDK.NetworkLoadBalancerResponse, string, error) {
 			lb, err := r.lbNetworkLB.Get(ctx, lbID)
 			if err != nil {
-				return nil, err
+				return nil, "", err
 			}
 			if lb.Status == lbSDK.LoadBalancerStatusFailed {
-				return nil, fmt.Errorf("load balancer %s is in error state. %s", lbID, *lb.LastOperationStatus)
-			}
-			if lb.Status == desiredState {
-				return &lb, nil
+				lastOperation := ""
+				if lb.LastOperationStatus != nil {
+					lastOperation = *lb.LastOperationStatus
+				}
+				return nil, "", fmt.Errorf("load balancer %s is in error state. %s", lbID, lastOperation)
 			}
-		}
-	}
+			return &lb, string(lb.Status), nil
+		},
+	}.Wait(ctx)
 }
@@ -106,7 +106,7 @@ func Test_updateLBNameDescription_noChange(t *testing.T) {
 	}
 	plan := state
 
-	err := r.updateLBNameDescription(context.Background(), &plan, &state)
+	err := r.updateLBNameDescription(context.Background(), &plan, &state, LoadBalancerTimeout)
 	assert.NoError(t, err)
 	assert.Equal(t, "name", state.Name.ValueString())
 	assert.Equal(t, "desc", state.Description.ValueString())
@@ -139,7 +139,7 @@ func Test_updateLBNameDescription_callsUpdate_and_waitError(t *testing.T) {
 		Description: types.StringValue("new-desc"),
 	}
 
-	err := r.updateLBNameDescription(canceledCtx(), &plan, &state)
+	err := r.updateLBNameDescription(canceledCtx(), &plan, &state, LoadBalancerTimeout)
 	assert.Error(t, err)
 	assert.Equal(t, 1, calls)
 	assert.Equal(t, "new", state.Name.ValueString())
@@ -167,7 +167,7 @@ func Test_replaceACLsIfChanged_noChange(t *testing.T) {
 	state := LoadBalancerModel{ID: types.StringValue("lb-1"), ACLs: &acls}
 	plan := LoadBalancerModel{ID: types.StringValue("lb-1"), ACLs: &acls}
 
-	err := r.replaceACLsIfChanged(context.Background(), &plan, &state)
+	err := r.replaceACLsIfChanged(context.Background(), &plan, &state, LoadBalancerTimeout)
 	assert.NoError(t, err)
 	assert.Equal(t, &acls, state.ACLs)
 }
@@ -198,7 +198,7 @@ func Test_replaceACLsIfChanged_callsReplace_and_waitError(t *testing.T) {
 	}
 	plan := LoadBalancerModel{ID: types.StringValue("lb-1"), ACLs: &planACLs}
 
-	err := r.replaceACLsIfChanged(canceledCtx(), &plan, &state)
+	err := r.replaceACLsIfChanged(canceledCtx(), &plan, &state, LoadBalancerTimeout)
 	assert.Error(t, err)
 	assert.Equal(t, 1, replaceCalls)
 	assert.Equal(t, &planACLs, state.ACLs)
@@ -218,7 +218,7 @@ func Test_updateHealthChecks_nil_noop(t *testing.T) {
 	state := LoadBalancerModel{ID: types.StringValue("lb-1"), HealthChecks: nil}
 	plan := LoadBalancerModel{ID: types.StringValue("lb-1"), HealthChecks: nil}
 
-	err := r.updateHealthChecks(context.Background(), &plan, &state)
+	err := r.updateHealthChecks(context.Background(), &plan, &state, LoadBalancerTimeout)
 	assert.NoError(t, err)
 	assert.Equal(t, 0, updateCalls)
 }
@@ -253,7 +253,7 @@ func Test_updateHealthChecks_equal_notCallUpdate(t *testing.T) {
 	state := LoadBalancerModel{ID: types.StringValue("lb-1"), HealthChecks: &stateHCs}
 	plan := LoadBalancerModel{ID: types.StringValue("lb-1"), HealthChecks: &planHCs}
 
-	err := r.updateHealthChecks(canceledCtx(), &plan, &state)
+	err := r.updateHealthChecks(canceledCtx(), &plan, &state, LoadBalancerTimeout)
 	assert.NoError(t, err)
 	assert.Equal(t, 0, updateCalls)
 }
@@ -295,7 +295,7 @@ func Test_updateHealthChecks_updates_and_waitError(t *testing.T) {
 	state := LoadBalancerModel{ID: types.StringValue("lb-1"), HealthChecks: &stateHCs}
 	plan := LoadBalancerModel{ID: types.StringValue("lb-1"), HealthChecks: &planHCs}
 
-	err := r.updateHealthChecks(canceledCtx(), &plan, &state)
+	err := r.updateHealthChecks(canceledCtx(), &plan, &state, LoadBalancerTimeout)
 	assert.Error(t, err)
 	assert.Equal(t, 1, updateCalls)
 	assert.Equal(t, "lb-1", gotLBID)
@@ -329,7 +329,7 @@ func Test_updateBackendsFields_noop(t *testing.T) {
 	}
 	plan := state
 
-	err := r.updateBackendsFields(context.Background(), &plan, &state)
+	err := r.updateBackendsFields(context.Background(), &plan, &state, LoadBalancerTimeout)
 	assert.NoError(t, err)
 	assert.Equal(t, 0, updateCalls)
 }
@@ -372,7 +372,7 @@ func Test_updateBackendsFields_updates_and_waitError(t *testing.T) {
 		},
 	}
 
-	err := r.updateBackendsFields(canceledCtx(), &plan, &state)
+	err := r.updateBackendsFields(canceledCtx(), &plan, &state, LoadBalancerTimeout)
 	assert.Error(t, err)
 	assert.Equal(t, 1, updateCalls)
 	assert.NotNil(t, gotReq.PanicThreshold)
@@ -424,7 +424,7 @@ func Test_updateBackendTargets_healthCheckNotFound(t *testing.T) {
 		},
 	}
 
-	err := r.replaceBackendTargets(context.Background(), &plan, &state)
+	err := r.replaceBackendTargets(context.Background(), &plan, &state, LoadBalancerTimeout)
 	var nfErr healthCheckNotFoundError
 	assert.Error(t, err)
 	assert.True(t, errors.As(err, &nfErr))
@@ -480,7 +480,7 @@ func Test_updateBackendTargets_replace_and_waitError(t *testing.T) {
 		},
 	}
 
-	err := r.replaceBackendTargets(canceledCtx(), &plan, &state)
+	err := r.replaceBackendTargets(canceledCtx(), &plan, &state, LoadBalancerTimeout)
 	assert.Error(t, err)
 	assert.Equal(t, 1, replaceCalls)
 	if assert.NotNil(t, gotReq.HealthCheckID) {
@@ -0,0 +1,161 @@
+package network_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/acctest"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+)
+
+func testAccVPCConfig(srv *fakeapi.Server, name string) string {
+	return acctest.Config(srv, fmt.Sprintf(`
+resource "mgc_network_vpcs" "test" {
+  name = %q
+}
+`, name))
+}
+
+func TestAccNetworkVPC(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_network_vpcs"),
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Config(srv, `
+resource "mgc_network_vpcs" "test" {
+  name        = "acc-vpc"
+  description = "acceptance test"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mgc_network_vpcs.test", "id"),
+					resource.TestCheckResourceAttr("mgc_network_vpcs.test", "name", "acc-vpc"),
+					resource.TestCheckResourceAttr("mgc_network_vpcs.test", "description", "acceptance test"),
+				),
+			},
+			{
+				ResourceName:            "mgc_network_vpcs.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: acctest.Config(srv, `
+resource "mgc_network_vpcs" "test" {
+  name = "acc-vpc-renamed"
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mgc_network_vpcs.test", "name", "acc-vpc-renamed"),
+					resource.TestCheckNoResourceAttr("mgc_network_vpcs.test", "description"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccNetworkVPC_ProvisioningFailure(t *testing.T) {
+	srv := acctest.NewServer(t)
+	srv.FailProvisioning("acc-vpc-failed")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_network_vpcs"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCConfig(srv, "acc-vpc-failed"),
+				ExpectError: regexp.MustCompile(`VPC creation failed`),
+			},
+			{
+				Config: testAccVPCConfig(srv, "acc-vpc"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mgc_network_vpcs.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("mgc_network_vpcs.test", "name", "acc-vpc"),
+			},
+		},
+	})
+}
+
+func TestAccNetworkVPC_AlreadyExists(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_network_vpcs"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCConfig(srv, "acc-vpc") + `
+resource "mgc_network_vpcs" "duplicate" {
+  name       = "acc-vpc"
+  depends_on = [mgc_network_vpcs.test]
+}
+`,
+				ExpectError: regexp.MustCompile(`Resource already exists(.|\n)*vpc acc-vpc already exists`),
+			},
+		},
+	})
+}
+
+func TestAccFunctionCidr(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Config(srv, `
+locals {
+  subnets = provider::mgc::cidr_allocate("172.26.0.0/16", ["172.26.0.0/24"], [24, 26])
+}
+
+output "subnets" {
+  value = join(",", local.subnets)
+}
+
+output "overlaps" {
+  value = provider::mgc::cidr_overlaps("172.26.0.0/16", local.subnets[1])
+}
+
+output "disjoint" {
+  value = provider::mgc::cidr_overlaps("10.0.0.0/8", local.subnets[0])
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("subnets", "172.26.1.0/24,172.26.2.0/26"),
+					resource.TestCheckOutput("overlaps", "true"),
+					resource.TestCheckOutput("disjoint", "false"),
+				),
+			},
+			{
+				Config: acctest.Config(srv, `
+output "subnets" {
+  value = provider::mgc::cidr_allocate("172.26.0.0/24", [], [25, 25, 25])
+}
+`),
+				ExpectError: regexp.MustCompile(`no free /25 block left in\s+172.26.0.0/24`),
+			},
+			{
+				Config: acctest.Config(srv, `
+output "overlaps" {
+  value = provider::mgc::cidr_overlaps("172.26.0.0/16", "172.26.0.0")
+}
+`),
+				ExpectError: regexp.MustCompile(`invalid CIDR "172.26.0.0"`),
+			},
+		},
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -20,6 +21,7 @@ func NewDataSourceNetworkNatGateway() datasource.DataSource {
 }
 
 type natGatewayDataSource struct {
+	regional   utils.RegionalService
 	sdkNetwork network.NatGatewayService
 }
 
@@ -29,16 +31,18 @@ type natGatewayDataSourceModel struct {
 	Description types.String `tfsdk:"description"`
 	VPCID       types.String `tfsdk:"vpc_id"`
 	Zone        types.String `tfsdk:"zone"`
+	Region      types.String `tfsdk:"region"`
 }
 
-func (d *natGatewayDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_nat_gateway"
+func (d *natGatewayDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkNatGatewayTypeName
 }
 
 func (d *natGatewayDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Get information about a NAT Gateway.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the NAT Gateway.",
 				Required:    true,
@@ -74,10 +78,14 @@ func (d *natGatewayDataSource) Configure(_ context.Context, req datasource.Confi
 		return
 	}
 
-	d.sdkNetwork = network.New(&dataConfig.CoreConfig).NatGateways()
+	d.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		d.sdkNetwork = network.New(&cfg.CoreConfig).NatGateways()
+	})
 }
 
 func (d *natGatewayDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkNatGatewayTypeName, &resp.State, &resp.Diagnostics)()
+
 	var state natGatewayDataSourceModel
 
 	diags := req.Config.Get(ctx, &state)
@@ -86,9 +94,14 @@ func (d *natGatewayDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
+	resp.Diagnostics.Append(d.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	natGateway, err := d.sdkNetwork.Get(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -5,12 +5,15 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkPublicIpTypeName = "mgc_network_public_ip"
+
 type NetworkPublicIPDataSourceModel struct {
 	Id          types.String `tfsdk:"id"`
 	Description types.String `tfsdk:"description"`
@@ -19,7 +22,13 @@ type NetworkPublicIPDataSourceModel struct {
 	PortId      types.String `tfsdk:"port_id"`
 }
 
+type NetworkPublicIPRegionalDataSourceModel struct {
+	NetworkPublicIPDataSourceModel
+	Region types.String `tfsdk:"region"`
+}
+
 type NetworkPublicIPDataSource struct {
+	regional   utils.RegionalService
 	networkPIP netSDK.PublicIPService
 }
 
@@ -27,8 +36,8 @@ func NewDataSourceNetworkPublicIP() datasource.DataSource {
 	return &NetworkPublicIPDataSource{}
 }
 
-func (r *NetworkPublicIPDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_public_ip"
+func (r *NetworkPublicIPDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkPublicIpTypeName
 }
 
 func (r *NetworkPublicIPDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -41,13 +50,16 @@ func (r *NetworkPublicIPDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 
-	r.networkPIP = netSDK.New(&dataConfig.CoreConfig).PublicIPs()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkPIP = netSDK.New(&cfg.CoreConfig).PublicIPs()
+	})
 }
 
 func (r *NetworkPublicIPDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Public IP",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the public IP",
 				Required:    true,
@@ -73,12 +85,22 @@ func (r *NetworkPublicIPDataSource) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (r *NetworkPublicIPDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	data := &NetworkPublicIPDataSourceModel{}
+	defer tracing.DataSource(&ctx, networkPublicIpTypeName, &resp.State, &resp.Diagnostics)()
+
+	data := &NetworkPublicIPRegionalDataSourceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	pip, err := r.networkPIP.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
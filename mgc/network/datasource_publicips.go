@@ -5,6 +5,7 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -12,10 +13,12 @@ import (
 )
 
 type NetworkPublicIPsModel struct {
-	IPs []NetworkPublicIPDataSourceModel `tfsdk:"ips"`
+	IPs    []NetworkPublicIPDataSourceModel `tfsdk:"ips"`
+	Region types.String                     `tfsdk:"region"`
 }
 
 type NetworkPublicIPsDataSource struct {
+	regional   utils.RegionalService
 	networkPIP netSDK.PublicIPService
 }
 
@@ -23,8 +26,8 @@ func NewDataSourceNetworkPublicIPs() datasource.DataSource {
 	return &NetworkPublicIPsDataSource{}
 }
 
-func (r *NetworkPublicIPsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_public_ips"
+func (r *NetworkPublicIPsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkPublicIpsTypeName
 }
 
 func (r *NetworkPublicIPsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -37,13 +40,16 @@ func (r *NetworkPublicIPsDataSource) Configure(ctx context.Context, req datasour
 		return
 	}
 
-	r.networkPIP = netSDK.New(&dataConfig.CoreConfig).PublicIPs()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkPIP = netSDK.New(&cfg.CoreConfig).PublicIPs()
+	})
 }
 
 func (r *NetworkPublicIPsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Public IPs",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"ips": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -76,12 +82,22 @@ func (r *NetworkPublicIPsDataSource) Schema(_ context.Context, _ datasource.Sche
 }
 
 func (r *NetworkPublicIPsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkPublicIpsTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := &NetworkPublicIPsModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	pip, err := r.networkPIP.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
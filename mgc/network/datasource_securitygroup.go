@@ -5,12 +5,15 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkSecurityGroupTypeName = "mgc_network_security_group"
+
 type NetworkSecurityGroupModel struct {
 	CreatedAt   types.String                              `tfsdk:"created_at"`
 	Description types.String                              `tfsdk:"description"`
@@ -25,6 +28,7 @@ type NetworkSecurityGroupModel struct {
 	TenantId    types.String                              `tfsdk:"tenant_id"`
 	Updated     types.String                              `tfsdk:"updated"`
 	VpcId       types.String                              `tfsdk:"vpc_id"`
+	Region      types.String                              `tfsdk:"region"`
 }
 
 type NetworkSecurityGroupRuleDataSourceModel struct {
@@ -43,6 +47,7 @@ type NetworkSecurityGroupRuleDataSourceModel struct {
 }
 
 type NetworkSecurityGroupDataSource struct {
+	regional              utils.RegionalService
 	networkSecurityGroups netSDK.SecurityGroupService
 }
 
@@ -54,6 +59,7 @@ func (r *NetworkSecurityGroupDataSource) Schema(_ context.Context, req datasourc
 	resp.Schema = schema.Schema{
 		Description: "Network Security Group",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"created_at": schema.StringAttribute{
 				Description: "The creation timestamp of the security group.",
 				Computed:    true,
@@ -162,8 +168,8 @@ func (r *NetworkSecurityGroupDataSource) Schema(_ context.Context, req datasourc
 	}
 }
 
-func (r *NetworkSecurityGroupDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_security_group"
+func (r *NetworkSecurityGroupDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkSecurityGroupTypeName
 }
 
 func (r *NetworkSecurityGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -176,16 +182,28 @@ func (r *NetworkSecurityGroupDataSource) Configure(ctx context.Context, req data
 		return
 	}
 
-	r.networkSecurityGroups = netSDK.New(&dataConfig.CoreConfig).SecurityGroups()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkSecurityGroups = netSDK.New(&cfg.CoreConfig).SecurityGroups()
+	})
 }
 
 func (r *NetworkSecurityGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkSecurityGroupTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data NetworkSecurityGroupModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	securityGroupFound, err := r.networkSecurityGroups.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
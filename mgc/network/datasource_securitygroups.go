@@ -5,6 +5,7 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -12,7 +13,8 @@ import (
 )
 
 type NetworkSecurityGroupsDataSourceModel struct {
-	Items []NetworkSecurityGroupsDataSourceModelItem `tfsdk:"items"`
+	Items  []NetworkSecurityGroupsDataSourceModelItem `tfsdk:"items"`
+	Region types.String                               `tfsdk:"region"`
 }
 
 type NetworkSecurityGroupsDataSourceModelItem struct {
@@ -26,6 +28,7 @@ type NetworkSecurityGroupsDataSourceModelItem struct {
 }
 
 type NetworkSecurityGroupsDataSource struct {
+	regional              utils.RegionalService
 	networkSecurityGroups netSDK.SecurityGroupService
 }
 
@@ -37,6 +40,7 @@ func (r *NetworkSecurityGroupsDataSource) Schema(_ context.Context, _ datasource
 	resp.Schema = schema.Schema{
 		Description: "Network Security Groups",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"items": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -76,8 +80,8 @@ func (r *NetworkSecurityGroupsDataSource) Schema(_ context.Context, _ datasource
 	}
 }
 
-func (r *NetworkSecurityGroupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_security_groups"
+func (r *NetworkSecurityGroupsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkSecurityGroupsTypeName
 }
 
 func (r *NetworkSecurityGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -90,16 +94,28 @@ func (r *NetworkSecurityGroupsDataSource) Configure(ctx context.Context, req dat
 		return
 	}
 
-	r.networkSecurityGroups = netSDK.New(&dataConfig.CoreConfig).SecurityGroups()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkSecurityGroups = netSDK.New(&cfg.CoreConfig).SecurityGroups()
+	})
 }
 
 func (r *NetworkSecurityGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkSecurityGroupsTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data NetworkSecurityGroupsDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	securityGroupFound, err := r.networkSecurityGroups.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -4,12 +4,15 @@ import (
 	"context"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkSubnetpoolTypeName = "mgc_network_subnetpool"
+
 type mgcNetworkSubnetpoolModel struct {
 	Cidr        types.String `tfsdk:"cidr"`
 	CreatedAt   types.String `tfsdk:"created_at"`
@@ -19,9 +22,11 @@ type mgcNetworkSubnetpoolModel struct {
 	Name        types.String `tfsdk:"name"`
 	TenantId    types.String `tfsdk:"tenant_id"`
 	IsDefault   types.Bool   `tfsdk:"is_default"`
+	Region      types.String `tfsdk:"region"`
 }
 
 type mgcNetworkSubnetpoolDatasource struct {
+	regional           utils.RegionalService
 	networkSubnetpools netSDK.SubnetPoolService
 }
 
@@ -29,14 +34,15 @@ func NewDataSourceNetworkSubnetpool() datasource.DataSource {
 	return &mgcNetworkSubnetpoolDatasource{}
 }
 
-func (r *mgcNetworkSubnetpoolDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_subnetpool"
+func (r *mgcNetworkSubnetpoolDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkSubnetpoolTypeName
 }
 
 func (r *mgcNetworkSubnetpoolDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Subnet Pool",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"cidr": schema.StringAttribute{
 				Description: "The CIDR range associated with the subnetpool",
 				Computed:    true,
@@ -74,12 +80,22 @@ func (r *mgcNetworkSubnetpoolDatasource) Schema(_ context.Context, _ datasource.
 }
 
 func (r *mgcNetworkSubnetpoolDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkSubnetpoolTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := &mgcNetworkSubnetpoolModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	subnetPool, err := r.networkSubnetpools.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -106,5 +122,7 @@ func (r *mgcNetworkSubnetpoolDatasource) Configure(ctx context.Context, req data
 		return
 	}
 
-	r.networkSubnetpools = netSDK.New(&dataConfig.CoreConfig).SubnetPools()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkSubnetpools = netSDK.New(&cfg.CoreConfig).SubnetPools()
+	})
 }
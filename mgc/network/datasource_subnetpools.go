@@ -4,6 +4,7 @@ import (
 	"context"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -11,7 +12,8 @@ import (
 )
 
 type mgcNetworkSubnetpoolsModel struct {
-	Items []mgcNetworkSubnetpoolsModelItem `tfsdk:"items"`
+	Items  []mgcNetworkSubnetpoolsModelItem `tfsdk:"items"`
+	Region types.String                     `tfsdk:"region"`
 }
 
 type mgcNetworkSubnetpoolsModelItem struct {
@@ -23,6 +25,7 @@ type mgcNetworkSubnetpoolsModelItem struct {
 }
 
 type mgcNetworkSubnetpoolsDatasource struct {
+	regional           utils.RegionalService
 	networkSubnetpools netSDK.SubnetPoolService
 }
 
@@ -30,14 +33,15 @@ func NewDataSourceNetworkSubnetpools() datasource.DataSource {
 	return &mgcNetworkSubnetpoolsDatasource{}
 }
 
-func (r *mgcNetworkSubnetpoolsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_subnetpools"
+func (r *mgcNetworkSubnetpoolsDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkSubnetpoolsTypeName
 }
 
 func (r *mgcNetworkSubnetpoolsDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Subnet Pools",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"items": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -70,12 +74,22 @@ func (r *mgcNetworkSubnetpoolsDatasource) Schema(_ context.Context, _ datasource
 }
 
 func (r *mgcNetworkSubnetpoolsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkSubnetpoolsTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := &mgcNetworkSubnetpoolsModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	subnetPool, err := r.networkSubnetpools.List(ctx, netSDK.ListOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -102,5 +116,7 @@ func (r *mgcNetworkSubnetpoolsDatasource) Configure(ctx context.Context, req dat
 		return
 	}
 
-	r.networkSubnetpools = netSDK.New(&dataConfig.CoreConfig).SubnetPools()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkSubnetpools = netSDK.New(&cfg.CoreConfig).SubnetPools()
+	})
 }
@@ -4,19 +4,28 @@ import (
 	"context"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkVpcTypeName = "mgc_network_vpc"
+
 type NetworkVPCDataSourceModel struct {
 	Id          types.String `tfsdk:"id"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
 }
 
+type NetworkVPCRegionalDataSourceModel struct {
+	NetworkVPCDataSourceModel
+	Region types.String `tfsdk:"region"`
+}
+
 type NetworkVPCDatasource struct {
+	regional   utils.RegionalService
 	networkVPC netSDK.VPCService
 }
 
@@ -24,8 +33,8 @@ func NewDataSourceNetworkVPC() datasource.DataSource {
 	return &NetworkVPCDatasource{}
 }
 
-func (r *NetworkVPCDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpc"
+func (r *NetworkVPCDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkVpcTypeName
 }
 
 func (r *NetworkVPCDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -38,13 +47,16 @@ func (r *NetworkVPCDatasource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	r.networkVPC = netSDK.New(&dataConfig.CoreConfig).VPCs()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkVPC = netSDK.New(&cfg.CoreConfig).VPCs()
+	})
 }
 
 func (r *NetworkVPCDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the VPC",
 				Required:    true,
@@ -62,12 +74,22 @@ func (r *NetworkVPCDatasource) Schema(_ context.Context, _ datasource.SchemaRequ
 }
 
 func (r *NetworkVPCDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	data := &NetworkVPCDataSourceModel{}
+	defer tracing.DataSource(&ctx, networkVpcTypeName, &resp.State, &resp.Diagnostics)()
+
+	data := &NetworkVPCRegionalDataSourceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	vpc, err := r.networkVPC.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -11,10 +12,12 @@ import (
 )
 
 type NetworkVPCsModel struct {
-	Items []NetworkVPCDataSourceModel `tfsdk:"items"`
+	Items  []NetworkVPCDataSourceModel `tfsdk:"items"`
+	Region types.String                `tfsdk:"region"`
 }
 
 type NetworkVPCsDatasource struct {
+	regional   utils.RegionalService
 	networkVPC netSDK.VPCService
 }
 
@@ -22,8 +25,8 @@ func NewDataSourceNetworkVPCs() datasource.DataSource {
 	return &NetworkVPCsDatasource{}
 }
 
-func (r *NetworkVPCsDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs"
+func (r *NetworkVPCsDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkVpcsTypeName
 }
 
 func (r *NetworkVPCsDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -36,13 +39,16 @@ func (r *NetworkVPCsDatasource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	r.networkVPC = netSDK.New(&dataConfig.CoreConfig).VPCs()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkVPC = netSDK.New(&cfg.CoreConfig).VPCs()
+	})
 }
 
 func (r *NetworkVPCsDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPCs",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"items": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -67,12 +73,22 @@ func (r *NetworkVPCsDatasource) Schema(_ context.Context, _ datasource.SchemaReq
 }
 
 func (r *NetworkVPCsDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkVpcsTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := &NetworkVPCsModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	vpcs, err := r.networkVPC.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
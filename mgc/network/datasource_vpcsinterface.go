@@ -5,12 +5,15 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkVpcsInterfaceTypeName = "mgc_network_vpcs_interface"
+
 type NetworkVPCInterfaceDataSourceModel struct {
 	CreatedAt             types.String                        `tfsdk:"created_at"`
 	Description           types.String                        `tfsdk:"description"`
@@ -27,6 +30,11 @@ type NetworkVPCInterfaceDataSourceModel struct {
 	AntiSpoofing          types.Bool                          `tfsdk:"anti_spoofing"`
 }
 
+type NetworkVPCInterfaceRegionalDataSourceModel struct {
+	NetworkVPCInterfaceDataSourceModel
+	Region types.String `tfsdk:"region"`
+}
+
 type NetworkVPCInterfaceIpAddressModel struct {
 	Ethertype types.String `tfsdk:"ethertype"`
 	IpAddress types.String `tfsdk:"ip_address"`
@@ -39,6 +47,7 @@ type NetworkVPCInterfacePublicIpModel struct {
 }
 
 type NetworkVPCInterfaceDatasource struct {
+	regional          utils.RegionalService
 	networkInterfaces netSDK.PortService
 }
 
@@ -46,14 +55,15 @@ func NewDataSourceNetworkVPCInterface() datasource.DataSource {
 	return &NetworkVPCInterfaceDatasource{}
 }
 
-func (r *NetworkVPCInterfaceDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs_interface"
+func (r *NetworkVPCInterfaceDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkVpcsInterfaceTypeName
 }
 
 func (r *NetworkVPCInterfaceDatasource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC Interface",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"created_at": schema.StringAttribute{
 				Description: "Creation timestamp of the VPC interface",
 				Computed:    true,
@@ -149,21 +159,33 @@ func (r *NetworkVPCInterfaceDatasource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	r.networkInterfaces = netSDK.New(&dataConfig.CoreConfig).Ports()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkInterfaces = netSDK.New(&cfg.CoreConfig).Ports()
+	})
 }
 
 func (r *NetworkVPCInterfaceDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	data := &NetworkVPCInterfaceDataSourceModel{}
+	defer tracing.DataSource(&ctx, networkVpcsInterfaceTypeName, &resp.State, &resp.Diagnostics)()
+
+	data := &NetworkVPCInterfaceRegionalDataSourceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vpcInterface, err := r.networkInterfaces.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, toNetworkVPCInterfaceDataSourceModel(*vpcInterface))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, NetworkVPCInterfaceRegionalDataSourceModel{
+		NetworkVPCInterfaceDataSourceModel: toNetworkVPCInterfaceDataSourceModel(*vpcInterface),
+		Region:                             data.Region,
+	})...)
 }
@@ -5,6 +5,7 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -12,10 +13,12 @@ import (
 )
 
 type NetworkVPCInterfacesModel struct {
-	Items []NetworkVPCInterfaceDataSourceModel `tfsdk:"items"`
+	Items  []NetworkVPCInterfaceDataSourceModel `tfsdk:"items"`
+	Region types.String                         `tfsdk:"region"`
 }
 
 type NetworkVPCInterfacesDatasource struct {
+	regional          utils.RegionalService
 	networkInterfaces netSDK.PortService
 }
 
@@ -23,14 +26,15 @@ func NewDataSourceNetworkVPCInterfaces() datasource.DataSource {
 	return &NetworkVPCInterfacesDatasource{}
 }
 
-func (r *NetworkVPCInterfacesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs_interfaces"
+func (r *NetworkVPCInterfacesDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkVpcsInterfacesTypeName
 }
 
 func (r *NetworkVPCInterfacesDatasource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC Interfaces",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"items": schema.ListNestedAttribute{
 				Computed: true,
 				NestedObject: schema.NestedAttributeObject{
@@ -133,19 +137,28 @@ func (r *NetworkVPCInterfacesDatasource) Configure(ctx context.Context, req data
 		return
 	}
 
-	r.networkInterfaces = netSDK.New(&dataConfig.CoreConfig).Ports()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkInterfaces = netSDK.New(&cfg.CoreConfig).Ports()
+	})
 }
 
 func (r *NetworkVPCInterfacesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkVpcsInterfacesTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := &NetworkVPCInterfacesModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vpcInterfaces, err := r.networkInterfaces.List(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
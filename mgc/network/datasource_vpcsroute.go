@@ -4,16 +4,20 @@ import (
 	"context"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 type NetworkVpcsRouteDataSourceModel struct {
 	NetworkVpcsRouteModel
+	Region types.String `tfsdk:"region"`
 }
 
 type NetworkVpcsRouteDatasource struct {
+	regional     utils.RegionalService
 	networkRoute netSDK.VpcsRoutesService
 }
 
@@ -21,8 +25,8 @@ func NewDataSourceNetworkVpcsRoute() datasource.DataSource {
 	return &NetworkVpcsRouteDatasource{}
 }
 
-func (r *NetworkVpcsRouteDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs_route"
+func (r *NetworkVpcsRouteDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkVpcsRouteTypeName
 }
 
 func (r *NetworkVpcsRouteDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -35,13 +39,16 @@ func (r *NetworkVpcsRouteDatasource) Configure(ctx context.Context, req datasour
 		return
 	}
 
-	r.networkRoute = netSDK.New(&dataConfig.CoreConfig).VpcsRoutes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkRoute = netSDK.New(&cfg.CoreConfig).VpcsRoutes()
+	})
 }
 
 func (r *NetworkVpcsRouteDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC Route",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the route.",
 				Required:    true,
@@ -79,15 +86,28 @@ func (r *NetworkVpcsRouteDatasource) Schema(_ context.Context, _ datasource.Sche
 }
 
 func (r *NetworkVpcsRouteDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkVpcsRouteTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := &NetworkVpcsRouteDataSourceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	route, err := r.networkRoute.Get(ctx, data.VpcID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	tfResult := convertSDKRouteResultToTerraformNetworkVpcsRouteModel(route)
+	tfResult := NetworkVpcsRouteDataSourceModel{
+		NetworkVpcsRouteModel: *convertSDKRouteResultToTerraformNetworkVpcsRouteModel(route),
+		Region:                data.Region,
+	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &tfResult)...)
 }
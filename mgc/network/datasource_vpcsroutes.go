@@ -4,12 +4,15 @@ import (
 	"context"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkVpcsRoutesTypeName = "mgc_network_vpcs_routes"
+
 type NetworkListVpcsRouteModel struct {
 	ID              types.String `tfsdk:"id"`
 	PortID          types.String `tfsdk:"port_id"`
@@ -23,9 +26,11 @@ type NetworkListVpcsRouteModel struct {
 type NetworkVpcsRoutesDataSourceModel struct {
 	VpcID  types.String                `tfsdk:"vpc_id"`
 	Routes []NetworkListVpcsRouteModel `tfsdk:"routes"`
+	Region types.String                `tfsdk:"region"`
 }
 
 type NetworkVpcsRoutesDatasource struct {
+	regional     utils.RegionalService
 	networkRoute netSDK.VpcsRoutesService
 }
 
@@ -33,8 +38,8 @@ func NewDataSourceNetworkVpcsRoutes() datasource.DataSource {
 	return &NetworkVpcsRoutesDatasource{}
 }
 
-func (r *NetworkVpcsRoutesDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs_routes"
+func (r *NetworkVpcsRoutesDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkVpcsRoutesTypeName
 }
 
 func (r *NetworkVpcsRoutesDatasource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -47,13 +52,16 @@ func (r *NetworkVpcsRoutesDatasource) Configure(ctx context.Context, req datasou
 		return
 	}
 
-	r.networkRoute = netSDK.New(&dataConfig.CoreConfig).VpcsRoutes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkRoute = netSDK.New(&cfg.CoreConfig).VpcsRoutes()
+	})
 }
 
 func (r *NetworkVpcsRoutesDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC Routes",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"vpc_id": schema.StringAttribute{
 				Description: "ID of the VPC where the routes is associated.",
 				Required:    true,
@@ -98,12 +106,22 @@ func (r *NetworkVpcsRoutesDatasource) Schema(_ context.Context, _ datasource.Sch
 }
 
 func (r *NetworkVpcsRoutesDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkVpcsRoutesTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := &NetworkVpcsRoutesDataSourceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	routes, err := r.networkRoute.ListAll(ctx, data.VpcID.ValueString(), &netSDK.ListAllVpcsRoutesOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -4,12 +4,15 @@ import (
 	"context"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkVpcsSubnetTypeName = "mgc_network_vpcs_subnet"
+
 type mgcNetworkVpcsSubnetModel struct {
 	CidrBlock        types.String     `tfsdk:"cidr_block"`
 	Description      types.String     `tfsdk:"description"`
@@ -23,6 +26,7 @@ type mgcNetworkVpcsSubnetModel struct {
 	VpcId            types.String     `tfsdk:"vpc_id"`
 	SubnetpoolId     types.String     `tfsdk:"subnetpool_id"`
 	AvailabilityZone types.String     `tfsdk:"availability_zone"`
+	Region           types.String     `tfsdk:"region"`
 }
 
 type DhcpPoolsModel struct {
@@ -31,6 +35,7 @@ type DhcpPoolsModel struct {
 }
 
 type mgcNetworkVpcsSubnetDatasource struct {
+	regional      utils.RegionalService
 	networkSubnet netSDK.SubnetService
 	region        string
 }
@@ -39,14 +44,15 @@ func NewDataSourceNetworkVpcsSubnet() datasource.DataSource {
 	return &mgcNetworkVpcsSubnetDatasource{}
 }
 
-func (r *mgcNetworkVpcsSubnetDatasource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs_subnet"
+func (r *mgcNetworkVpcsSubnetDatasource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = networkVpcsSubnetTypeName
 }
 
 func (r *mgcNetworkVpcsSubnetDatasource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC Subnet",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"cidr_block": schema.StringAttribute{
 				Description: "The CIDR block of the subnet",
 				Computed:    true,
@@ -122,20 +128,29 @@ func (r *mgcNetworkVpcsSubnetDatasource) Configure(ctx context.Context, req data
 		return
 	}
 
-	r.networkSubnet = netSDK.New(&dataConfig.CoreConfig).Subnets()
-	r.region = dataConfig.Region
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkSubnet = netSDK.New(&cfg.CoreConfig).Subnets()
+		r.region = cfg.Region
+	})
 }
 
 func (r *mgcNetworkVpcsSubnetDatasource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, networkVpcsSubnetTypeName, &resp.State, &resp.Diagnostics)()
+
 	data := &mgcNetworkVpcsSubnetModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subnet, err := r.networkSubnet.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
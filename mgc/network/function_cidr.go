@@ -0,0 +1,153 @@
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ function.Function = &FunctionCidrOverlaps{}
+	_ function.Function = &FunctionCidrAllocate{}
+)
+
+func parseCidr(argument int64, value string) (netip.Prefix, *function.FuncError) {
+	prefix, err := netip.ParsePrefix(value)
+	if err != nil {
+		return netip.Prefix{}, function.NewArgumentFuncError(argument, fmt.Sprintf("invalid CIDR %q: %s", value, err))
+	}
+	return prefix.Masked(), nil
+}
+
+type FunctionCidrOverlaps struct{}
+
+func NewFunctionCidrOverlaps() function.Function {
+	return &FunctionCidrOverlaps{}
+}
+
+func (f *FunctionCidrOverlaps) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_overlaps"
+}
+
+func (f *FunctionCidrOverlaps) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Whether two CIDR blocks overlap",
+		Description: "Returns true when two CIDR blocks share at least one address. Blocks of different IP versions never overlap.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "a",
+				Description: "CIDR block, such as `172.26.0.0/16`.",
+			},
+			function.StringParameter{
+				Name:        "b",
+				Description: "CIDR block, such as `172.26.4.0/24`.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *FunctionCidrOverlaps) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var a, b string
+	resp.Error = req.Arguments.Get(ctx, &a, &b)
+	if resp.Error != nil {
+		return
+	}
+
+	prefixA, funcErr := parseCidr(0, a)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	prefixB, funcErr := parseCidr(1, b)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, prefixA.Overlaps(prefixB))
+}
+
+type FunctionCidrAllocate struct{}
+
+func NewFunctionCidrAllocate() function.Function {
+	return &FunctionCidrAllocate{}
+}
+
+func (f *FunctionCidrAllocate) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "cidr_allocate"
+}
+
+func (f *FunctionCidrAllocate) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Carve non-overlapping subnets out of a CIDR block",
+		Description: "Returns one CIDR block per prefix length, in order, carved out of a pool such as a subnetpool CIDR. " +
+			"Each block is the lowest one that overlaps neither the allocated blocks nor the blocks returned before it, " +
+			"so adding a prefix length at the end of the list does not move the existing subnets. " +
+			"Fails when a block does not fit in the pool.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "pool",
+				Description: "CIDR block to allocate from, such as `172.26.0.0/16`.",
+			},
+			function.ListParameter{
+				Name:        "allocated",
+				Description: "CIDR blocks already in use, such as the subnets created outside the module. Blocks outside the pool are ignored.",
+				ElementType: types.StringType,
+			},
+			function.ListParameter{
+				Name:        "prefix_lengths",
+				Description: "Prefix length of each block to allocate, such as `[24, 24, 26]`.",
+				ElementType: types.Int64Type,
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *FunctionCidrAllocate) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		pool          string
+		allocated     []string
+		prefixLengths []int64
+	)
+	resp.Error = req.Arguments.Get(ctx, &pool, &allocated, &prefixLengths)
+	if resp.Error != nil {
+		return
+	}
+
+	poolPrefix, funcErr := parseCidr(0, pool)
+	if funcErr != nil {
+		resp.Error = funcErr
+		return
+	}
+	allocatedPrefixes := make([]netip.Prefix, 0, len(allocated))
+	for _, cidr := range allocated {
+		prefix, funcErr := parseCidr(1, cidr)
+		if funcErr != nil {
+			resp.Error = funcErr
+			return
+		}
+		allocatedPrefixes = append(allocatedPrefixes, prefix)
+	}
+	lengths := make([]int, 0, len(prefixLengths))
+	for _, l := range prefixLengths {
+		lengths = append(lengths, int(l))
+	}
+
+	blocks, err := utils.AllocateCidrs(poolPrefix, allocatedPrefixes, lengths)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, err.Error())
+		return
+	}
+	result := make([]string, 0, len(blocks))
+	for _, block := range blocks {
+		result = append(result, block.String())
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
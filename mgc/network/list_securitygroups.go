@@ -0,0 +1,94 @@
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+var (
+	_ list.ListResource              = &securityGroupsList{}
+	_ list.ListResourceWithConfigure = &securityGroupsList{}
+)
+
+type securityGroupsList struct {
+	regional              utils.RegionalService
+	networkSecurityGroups netSDK.SecurityGroupService
+}
+
+type securityGroupsListModel struct {
+	utils.ListConfig
+	VPCID types.String `tfsdk:"vpc_id"`
+}
+
+func NewNetworkSecurityGroupsList() list.ListResource {
+	return &securityGroupsList{}
+}
+
+func (r *securityGroupsList) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkSecurityGroupsTypeName
+}
+
+func (r *securityGroupsList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkSecurityGroups = netSDK.New(&cfg.CoreConfig).SecurityGroups()
+	})
+}
+
+func (r *securityGroupsList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists security groups.",
+		Attributes: map[string]listschema.Attribute{
+			"region":     utils.ListRegionAttribute(),
+			"name_regex": utils.ListNameRegexAttribute(),
+			"vpc_id": listschema.StringAttribute{
+				Description: "Only list security groups of this VPC.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *securityGroupsList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	utils.List(ctx, req, stream, utils.Lister[securityGroupsListModel, netSDK.SecurityGroupResponse]{
+		TypeName: networkSecurityGroupsTypeName,
+		Identity: securityGroupIdentity,
+		Use:      r.regional.Use,
+		Fetch: func(ctx context.Context, _ securityGroupsListModel) ([]netSDK.SecurityGroupResponse, error) {
+			return r.networkSecurityGroups.List(ctx)
+		},
+		Describe: func(config securityGroupsListModel, sg netSDK.SecurityGroupResponse) (string, map[string]string, bool) {
+			if sg.ID == nil ||
+				(!config.VPCID.IsNull() && types.StringPointerValue(sg.VPCID).ValueString() != config.VPCID.ValueString()) {
+				return "", nil, false
+			}
+			name := types.StringPointerValue(sg.Name).ValueString()
+			return name, map[string]string{"id": *sg.ID}, true
+		},
+		Resource: func(_ context.Context, config securityGroupsListModel, sg netSDK.SecurityGroupResponse) (any, diag.Diagnostics) {
+			return NetworkSecurityGroupsModel{
+				Id:          types.StringPointerValue(sg.ID),
+				Name:        types.StringPointerValue(sg.Name),
+				Description: types.StringPointerValue(sg.Description),
+				Region:      config.Region,
+			}, nil
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package network
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+var (
+	_ list.ListResource              = &vpcsList{}
+	_ list.ListResourceWithConfigure = &vpcsList{}
+)
+
+type vpcsList struct {
+	regional   utils.RegionalService
+	networkVPC netSDK.VPCService
+}
+
+type vpcsListModel struct {
+	utils.ListConfig
+	Status types.String `tfsdk:"status"`
+}
+
+func NewNetworkVPCsList() list.ListResource {
+	return &vpcsList{}
+}
+
+func (r *vpcsList) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkVpcsTypeName
+}
+
+func (r *vpcsList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkVPC = netSDK.New(&cfg.CoreConfig).VPCs()
+	})
+}
+
+func (r *vpcsList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists VPCs.",
+		Attributes: map[string]listschema.Attribute{
+			"region":     utils.ListRegionAttribute(),
+			"name_regex": utils.ListNameRegexAttribute(),
+			"status": listschema.StringAttribute{
+				Description: "Only list VPCs with this status, e.g. `created`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *vpcsList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	utils.List(ctx, req, stream, utils.Lister[vpcsListModel, netSDK.VPC]{
+		TypeName: networkVpcsTypeName,
+		Identity: vpcIdentity,
+		Use:      r.regional.Use,
+		Fetch: func(ctx context.Context, _ vpcsListModel) ([]netSDK.VPC, error) {
+			return r.networkVPC.List(ctx)
+		},
+		Describe: func(config vpcsListModel, vpc netSDK.VPC) (string, map[string]string, bool) {
+			if vpc.ID == nil ||
+				(!config.Status.IsNull() && vpc.Status != config.Status.ValueString()) {
+				return "", nil, false
+			}
+			name := types.StringPointerValue(vpc.Name).ValueString()
+			return name, map[string]string{"id": *vpc.ID}, true
+		},
+		Resource: func(_ context.Context, config vpcsListModel, vpc netSDK.VPC) (any, diag.Diagnostics) {
+			data := NetworkVPCModel{
+				Region:   config.Region,
+				Timeouts: utils.NullTimeouts(vpcTimeoutsOpts),
+			}
+			readVPC(&vpc, &data)
+			return data, nil
+		},
+	})
+}
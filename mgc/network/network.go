@@ -2,6 +2,8 @@ package network
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -40,3 +42,17 @@ func GetResources() []func() resource.Resource {
 		NewNetworkVpcsRouteResource,
 	}
 }
+
+func GetListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewNetworkSecurityGroupsList,
+		NewNetworkVPCsList,
+	}
+}
+
+func GetFunctions() []func() function.Function {
+	return []func() function.Function{
+		NewFunctionCidrAllocate,
+		NewFunctionCidrOverlaps,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/MagaluCloud/mgc-sdk-go/network"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -12,9 +13,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkNatGatewayTypeName = "mgc_network_nat_gateway"
+
 var (
-	_ resource.Resource              = &natGatewayResource{}
-	_ resource.ResourceWithConfigure = &natGatewayResource{}
+	_ resource.Resource                = &natGatewayResource{}
+	_ resource.ResourceWithConfigure   = &natGatewayResource{}
+	_ resource.ResourceWithImportState = &natGatewayResource{}
+	_ resource.ResourceWithIdentity    = &natGatewayResource{}
+)
+
+var natGatewayIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the NAT gateway."),
 )
 
 func NewNetworkNatGatewayResource() resource.Resource {
@@ -22,6 +31,7 @@ func NewNetworkNatGatewayResource() resource.Resource {
 }
 
 type natGatewayResource struct {
+	regional   utils.RegionalService
 	sdkNetwork network.NatGatewayService
 	region     string
 }
@@ -32,16 +42,18 @@ type natGatewayResourceModel struct {
 	Description      types.String `tfsdk:"description"`
 	VPCID            types.String `tfsdk:"vpc_id"`
 	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	Region           types.String `tfsdk:"region"`
 }
 
-func (r *natGatewayResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_nat_gateway"
+func (r *natGatewayResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkNatGatewayTypeName
 }
 
 func (r *natGatewayResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Manages a NAT Gateway resource.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the NAT Gateway.",
 				Computed:    true,
@@ -93,11 +105,16 @@ func (r *natGatewayResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	r.sdkNetwork = network.New(&dataConfig.CoreConfig).NatGateways()
-	r.region = dataConfig.Region
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.sdkNetwork = network.New(&cfg.CoreConfig).NatGateways()
+		r.region = cfg.Region
+	})
 }
 
 func (r *natGatewayResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkNatGatewayTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer natGatewayIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var plan natGatewayResourceModel
 	diags := req.Config.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -105,6 +122,11 @@ func (r *natGatewayResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var azparsed string
 	if !plan.AvailabilityZone.IsUnknown() {
 		az, err := utils.ConvertAvailabilityZoneToXZone(plan.AvailabilityZone.ValueString())
@@ -122,7 +144,7 @@ func (r *natGatewayResource) Create(ctx context.Context, req resource.CreateRequ
 		Zone:        azparsed,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -133,6 +155,9 @@ func (r *natGatewayResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *natGatewayResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkNatGatewayTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer natGatewayIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var state natGatewayResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -140,14 +165,23 @@ func (r *natGatewayResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	natGateway, err := r.sdkNetwork.Get(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	state.Name = types.StringValue(*natGateway.Name)
-	state.Description = types.StringValue(*natGateway.Description)
+	state.Description = types.StringPointerValue(natGateway.Description)
 	state.VPCID = types.StringValue(*natGateway.VPCID)
 	if natGateway.Zone != nil {
 		state.AvailabilityZone = types.StringValue(utils.ConvertXZoneToAvailabilityZone(r.region, *natGateway.Zone))
@@ -161,10 +195,15 @@ func (r *natGatewayResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *natGatewayResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkNatGatewayTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer natGatewayIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("Update is not supported for NAT Gateway", "")
 }
 
 func (r *natGatewayResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkNatGatewayTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var state natGatewayResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -172,9 +211,22 @@ func (r *natGatewayResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.sdkNetwork.Delete(ctx, state.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
+
+func (r *natGatewayResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = natGatewayIdentity.Schema()
+}
+
+func (r *natGatewayResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	natGatewayIdentity.ImportState(ctx, req, resp)
+}
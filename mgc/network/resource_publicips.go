@@ -5,8 +5,8 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -14,24 +14,32 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkPublicIpsTypeName = "mgc_network_public_ips"
+
 type NetworkPublicIPModel struct {
 	Id          types.String `tfsdk:"id"`
 	PublicIP    types.String `tfsdk:"public_ip"`
 	Description types.String `tfsdk:"description"`
 	VPCId       types.String `tfsdk:"vpc_id"`
+	Region      types.String `tfsdk:"region"`
 }
 
 type NetworkPublicIPResource struct {
+	regional   utils.RegionalService
 	networkPIP netSDK.PublicIPService
 	networkVpc netSDK.VPCService
 }
 
+var publicIPIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the public IP."),
+)
+
 func NewNetworkPublicIPResource() resource.Resource {
 	return &NetworkPublicIPResource{}
 }
 
-func (r *NetworkPublicIPResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_public_ips"
+func (r *NetworkPublicIPResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkPublicIpsTypeName
 }
 
 func (r *NetworkPublicIPResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -44,14 +52,17 @@ func (r *NetworkPublicIPResource) Configure(ctx context.Context, req resource.Co
 		return
 	}
 
-	r.networkPIP = netSDK.New(&dataConfig.CoreConfig).PublicIPs()
-	r.networkVpc = netSDK.New(&dataConfig.CoreConfig).VPCs()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkPIP = netSDK.New(&cfg.CoreConfig).PublicIPs()
+		r.networkVpc = netSDK.New(&cfg.CoreConfig).VPCs()
+	})
 }
 
 func (r *NetworkPublicIPResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Public IP",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the public IP",
 				Computed:    true,
@@ -85,23 +96,33 @@ func (r *NetworkPublicIPResource) Schema(_ context.Context, _ resource.SchemaReq
 }
 
 func (r *NetworkPublicIPResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkPublicIpsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer publicIPIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkPublicIPModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createdPIP, err := r.networkVpc.CreatePublicIP(ctx, data.VPCId.ValueString(), netSDK.PublicIPCreateRequest{
 		Description: data.Description.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	pip, err := r.networkPIP.Get(ctx, createdPIP)
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to fetch public IP address", err.Error())
+		publicIPIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": data.Region.ValueString(), "id": createdPIP}, &resp.Diagnostics)
+		return
 	}
 
 	data.Id = types.StringPointerValue(pip.ID)
@@ -110,15 +131,27 @@ func (r *NetworkPublicIPResource) Create(ctx context.Context, req resource.Creat
 }
 
 func (r *NetworkPublicIPResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkPublicIpsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer publicIPIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkPublicIPModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	pip, err := r.networkPIP.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -130,23 +163,37 @@ func (r *NetworkPublicIPResource) Read(ctx context.Context, req resource.ReadReq
 }
 
 func (r *NetworkPublicIPResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkPublicIpsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data NetworkPublicIPModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkPIP.Delete(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
 func (r *NetworkPublicIPResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkPublicIpsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer publicIPIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("Update is not supported for public IP", "")
 }
 
+func (r *NetworkPublicIPResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = publicIPIdentity.Schema()
+}
+
 func (r *NetworkPublicIPResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	publicIPIdentity.ImportState(ctx, req, resp)
 }
@@ -2,10 +2,10 @@ package network
 
 import (
 	"context"
-	"strings"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -14,21 +14,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkPublicIpsAttachTypeName = "mgc_network_public_ips_attach"
+
 type NetworkPublicIAttachPModel struct {
 	PublicIpID  types.String `tfsdk:"public_ip_id"`
 	InterfaceID types.String `tfsdk:"interface_id"`
+	Region      types.String `tfsdk:"region"`
 }
 
 type NetworkPublicIPAttachResource struct {
+	regional   utils.RegionalService
 	networkPIP netSDK.PublicIPService
 }
 
+var publicIPAttachIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "public_ip_id", Description: "ID of the public IP."},
+	utils.IdentityAttribute{Name: "interface_id", Description: "ID of the network interface."},
+)
+
 func NewNetworkPublicIPAttachResource() resource.Resource {
 	return &NetworkPublicIPAttachResource{}
 }
 
-func (r *NetworkPublicIPAttachResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_public_ips_attach"
+func (r *NetworkPublicIPAttachResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkPublicIpsAttachTypeName
 }
 
 func (r *NetworkPublicIPAttachResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -41,13 +50,16 @@ func (r *NetworkPublicIPAttachResource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	r.networkPIP = netSDK.New(&dataConfig.CoreConfig).PublicIPs()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkPIP = netSDK.New(&cfg.CoreConfig).PublicIPs()
+	})
 }
 
 func (r *NetworkPublicIPAttachResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Public IP Attach",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"interface_id": schema.StringAttribute{
 				Description: "Interface ID",
 				Required:    true,
@@ -67,15 +79,23 @@ func (r *NetworkPublicIPAttachResource) Schema(_ context.Context, _ resource.Sch
 }
 
 func (r *NetworkPublicIPAttachResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkPublicIpsAttachTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer publicIPAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var model NetworkPublicIAttachPModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkPIP.AttachToPort(ctx, model.PublicIpID.ValueString(), model.InterfaceID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -83,33 +103,55 @@ func (r *NetworkPublicIPAttachResource) Create(ctx context.Context, req resource
 }
 
 func (r *NetworkPublicIPAttachResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkPublicIpsAttachTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var model NetworkPublicIAttachPModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkPIP.DetachFromPort(ctx, model.PublicIpID.ValueString(), model.InterfaceID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
 func (r *NetworkPublicIPAttachResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkPublicIpsAttachTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer publicIPAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("Update not supported", "Update not supported")
 }
 
 func (r *NetworkPublicIPAttachResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkPublicIpsAttachTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer publicIPAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var model NetworkPublicIAttachPModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	pip, err := r.networkPIP.Get(ctx, model.PublicIpID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -117,15 +159,10 @@ func (r *NetworkPublicIPAttachResource) Read(ctx context.Context, req resource.R
 	resp.State.Set(ctx, &model)
 }
 
-func (r *NetworkPublicIPAttachResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ",")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid ID", "ID must be in the format public_ip_id,interface_id")
-		return
-	}
+func (r *NetworkPublicIPAttachResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = publicIPAttachIdentity.Schema()
+}
 
-	resp.State.Set(ctx, &NetworkPublicIAttachPModel{
-		PublicIpID:  types.StringValue(parts[0]),
-		InterfaceID: types.StringValue(parts[1]),
-	})
+func (r *NetworkPublicIPAttachResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	publicIPAttachIdentity.ImportState(ctx, req, resp)
 }
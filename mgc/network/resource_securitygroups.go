@@ -5,8 +5,8 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -16,23 +16,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkSecurityGroupsTypeName = "mgc_network_security_groups"
+
 type NetworkSecurityGroupsModel struct {
 	Id                  types.String `tfsdk:"id"`
 	Name                types.String `tfsdk:"name"`
 	Description         types.String `tfsdk:"description"`
 	DisableDefaultRules types.Bool   `tfsdk:"disable_default_rules"`
+	Region              types.String `tfsdk:"region"`
 }
 
 type NetworkSecurityGroupsResource struct {
+	regional              utils.RegionalService
 	networkSecurityGroups netSDK.SecurityGroupService
 }
 
+var securityGroupIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the security group."),
+)
+
 func NewNetworkSecurityGroupsResource() resource.Resource {
 	return &NetworkSecurityGroupsResource{}
 }
 
-func (r *NetworkSecurityGroupsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_security_groups"
+func (r *NetworkSecurityGroupsResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkSecurityGroupsTypeName
 }
 
 func (r *NetworkSecurityGroupsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -45,13 +53,16 @@ func (r *NetworkSecurityGroupsResource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	r.networkSecurityGroups = netSDK.New(&dataConfig.CoreConfig).SecurityGroups()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkSecurityGroups = netSDK.New(&cfg.CoreConfig).SecurityGroups()
+	})
 }
 
 func (r *NetworkSecurityGroupsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Security Group",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the Security Group",
 				Computed:    true,
@@ -87,12 +98,27 @@ func (r *NetworkSecurityGroupsResource) Schema(_ context.Context, _ resource.Sch
 }
 
 func (r *NetworkSecurityGroupsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer securityGroupIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkSecurityGroupsModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	sc, err := r.networkSecurityGroups.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -102,19 +128,27 @@ func (r *NetworkSecurityGroupsResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *NetworkSecurityGroupsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer securityGroupIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkSecurityGroupsModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	created, err := r.networkSecurityGroups.Create(ctx, netSDK.SecurityGroupCreateRequest{
 		Name:             data.Name.ValueString(),
 		Description:      data.Description.ValueStringPointer(),
 		SkipDefaultRules: data.DisableDefaultRules.ValueBoolPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -123,23 +157,37 @@ func (r *NetworkSecurityGroupsResource) Create(ctx context.Context, req resource
 }
 
 func (r *NetworkSecurityGroupsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data NetworkSecurityGroupsModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkSecurityGroups.Delete(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
 func (r *NetworkSecurityGroupsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer securityGroupIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("Update is not supported for Security Group", "")
 }
 
+func (r *NetworkSecurityGroupsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = securityGroupIdentity.Schema()
+}
+
 func (r *NetworkSecurityGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	securityGroupIdentity.ImportState(ctx, req, resp)
 }
@@ -503,6 +503,26 @@ func TestNetworkSecurityGroupsModel_Validation(t *testing.T) {
 	}
 }
 
+func TestNetworkSecurityGroupsResource_ReadNotFound(t *testing.T) {
+	mockSvc := &mockSecurityGroupService{}
+	mockSvc.On("Get", mock.Anything, "sg-123").Return(nil, &clientSDK.HTTPError{StatusCode: 404})
+
+	r := &NetworkSecurityGroupsResource{
+		networkSecurityGroups: mockSvc,
+	}
+
+	state := tfsdk.State{Schema: getTestSchema()}
+	state.Set(context.Background(), NetworkSecurityGroupsModel{Id: types.StringValue("sg-123")})
+
+	req := resource.ReadRequest{State: state}
+	resp := &resource.ReadResponse{State: state}
+
+	r.Read(context.Background(), req, resp)
+	require.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.State.Raw.IsNull(), "a security group deleted outside Terraform must be removed from state")
+	mockSvc.AssertExpectations(t)
+}
+
 // Test error handling with different SDK error types
 func TestNetworkSecurityGroupsResource_ErrorHandling(t *testing.T) {
 	tests := []struct {
@@ -512,7 +532,7 @@ func TestNetworkSecurityGroupsResource_ErrorHandling(t *testing.T) {
 	}{
 		{
 			name:      "http error",
-			sdkError:  &clientSDK.HTTPError{StatusCode: 404},
+			sdkError:  &clientSDK.HTTPError{StatusCode: 500},
 			operation: "read",
 		},
 		{
@@ -936,6 +956,10 @@ func createTestPlan(t testing.TB, data NetworkSecurityGroupsModel) tfsdk.Plan {
 func getTestSchema() schema.Schema {
 	return schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"region": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
 			"id": schema.StringAttribute{
 				Computed: true,
 			},
@@ -3,10 +3,10 @@ package network
 import (
 	"context"
 	"slices"
-	"strings"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -15,21 +15,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkSecurityGroupsAttachTypeName = "mgc_network_security_groups_attach"
+
 type NetworkSecurityGroupsAttachModel struct {
 	SecurityGroupID types.String `tfsdk:"security_group_id"`
 	InterfaceID     types.String `tfsdk:"interface_id"`
+	Region          types.String `tfsdk:"region"`
 }
 
 type NetworkSecurityGroupsAttachResource struct {
+	regional     utils.RegionalService
 	networkPorts netSDK.PortService
 }
 
+var securityGroupAttachIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "security_group_id", Description: "ID of the security group."},
+	utils.IdentityAttribute{Name: "interface_id", Description: "ID of the network interface."},
+)
+
 func NewNetworkSecurityGroupsAttachResource() resource.Resource {
 	return &NetworkSecurityGroupsAttachResource{}
 }
 
-func (r *NetworkSecurityGroupsAttachResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_security_groups_attach"
+func (r *NetworkSecurityGroupsAttachResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkSecurityGroupsAttachTypeName
 }
 
 func (r *NetworkSecurityGroupsAttachResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -42,13 +51,16 @@ func (r *NetworkSecurityGroupsAttachResource) Configure(ctx context.Context, req
 		return
 	}
 
-	r.networkPorts = netSDK.New(&dataConfig.CoreConfig).Ports()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkPorts = netSDK.New(&cfg.CoreConfig).Ports()
+	})
 }
 
 func (r *NetworkSecurityGroupsAttachResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Security Group Attach",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"interface_id": schema.StringAttribute{
 				Description: "The ID of the Network Interface",
 				Required:    true,
@@ -68,25 +80,32 @@ func (r *NetworkSecurityGroupsAttachResource) Schema(_ context.Context, _ resour
 }
 
 func (r *NetworkSecurityGroupsAttachResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsAttachTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer securityGroupAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := NetworkSecurityGroupsAttachModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	interfaceResponse, err := r.networkPorts.Get(ctx, data.InterfaceID.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	if interfaceResponse.SecurityGroups == nil {
-		resp.Diagnostics.AddError("Interface without security groups", "Security Group is nil")
+	interfaceResponse, err := r.networkPorts.Get(ctx, data.InterfaceID.ValueString())
+	if err != nil {
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	if !slices.Contains(*interfaceResponse.SecurityGroups, data.SecurityGroupID.ValueString()) {
-		resp.Diagnostics.AddError("Security Group not attach to interface", "Security Group not found in interface")
+	if interfaceResponse.SecurityGroups == nil || !slices.Contains(*interfaceResponse.SecurityGroups, data.SecurityGroupID.ValueString()) {
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -94,15 +113,23 @@ func (r *NetworkSecurityGroupsAttachResource) Read(ctx context.Context, req reso
 }
 
 func (r *NetworkSecurityGroupsAttachResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsAttachTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer securityGroupAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkSecurityGroupsAttachModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkPorts.AttachSecurityGroup(ctx, data.InterfaceID.ValueString(), data.SecurityGroupID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -110,16 +137,26 @@ func (r *NetworkSecurityGroupsAttachResource) Create(ctx context.Context, req re
 }
 
 func (r *NetworkSecurityGroupsAttachResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsAttachTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer securityGroupAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("Update is not supported for Network Security Groups Attach", "")
 }
 
 func (r *NetworkSecurityGroupsAttachResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsAttachTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data NetworkSecurityGroupsAttachModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkPorts.DetachSecurityGroup(ctx, data.InterfaceID.ValueString(), data.SecurityGroupID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Failed to detach Security Group", err.Error())
@@ -127,15 +164,10 @@ func (r *NetworkSecurityGroupsAttachResource) Delete(ctx context.Context, req re
 	}
 }
 
-func (r *NetworkSecurityGroupsAttachResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	input := strings.Split(req.ID, ",")
-	if len(input) != 2 {
-		resp.Diagnostics.AddError("Invalid ID", "ID must be in the format security_group_id,interface_id")
-		return
-	}
+func (r *NetworkSecurityGroupsAttachResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = securityGroupAttachIdentity.Schema()
+}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &NetworkSecurityGroupsAttachModel{
-		SecurityGroupID: types.StringValue(input[0]),
-		InterfaceID:     types.StringValue(input[1]),
-	})...)
+func (r *NetworkSecurityGroupsAttachResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	securityGroupAttachIdentity.ImportState(ctx, req, resp)
 }
@@ -5,10 +5,10 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -18,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkSecurityGroupsRulesTypeName = "mgc_network_security_groups_rules"
+
 type NetworkSecurityGroupRuleModel struct {
 	Id              types.String `tfsdk:"id"`
 	Description     types.String `tfsdk:"description"`
@@ -28,12 +30,18 @@ type NetworkSecurityGroupRuleModel struct {
 	Protocol        types.String `tfsdk:"protocol"`
 	RemoteIpPrefix  types.String `tfsdk:"remote_ip_prefix"`
 	SecurityGroupId types.String `tfsdk:"security_group_id"`
+	Region          types.String `tfsdk:"region"`
 }
 
 type NetworkSecurityGroupsRulesResource struct {
+	regional     utils.RegionalService
 	networkRules netSDK.RuleService
 }
 
+var securityGroupRuleIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the security group rule."),
+)
+
 func NewNetworkSecurityGroupsRulesResource() resource.Resource {
 	return &NetworkSecurityGroupsRulesResource{}
 }
@@ -42,6 +50,7 @@ func (r *NetworkSecurityGroupsRulesResource) Schema(_ context.Context, _ resourc
 	resp.Schema = schema.Schema{
 		Description: "Network Security Group Rule",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the security group rule",
 				Computed:    true,
@@ -134,20 +143,30 @@ func (r *NetworkSecurityGroupsRulesResource) Configure(ctx context.Context, req
 		return
 	}
 
-	r.networkRules = netSDK.New(&dataConfig.CoreConfig).Rules()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkRules = netSDK.New(&cfg.CoreConfig).Rules()
+	})
 }
 
-func (r *NetworkSecurityGroupsRulesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_security_groups_rules"
+func (r *NetworkSecurityGroupsRulesResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkSecurityGroupsRulesTypeName
 }
 
 func (r *NetworkSecurityGroupsRulesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsRulesTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer securityGroupRuleIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkSecurityGroupRuleModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	created, err := r.networkRules.Create(ctx, data.SecurityGroupId.ValueString(), netSDK.RuleCreateRequest{
 		Description:    data.Description.ValueStringPointer(),
 		Direction:      data.Direction.ValueStringPointer(),
@@ -158,7 +177,7 @@ func (r *NetworkSecurityGroupsRulesResource) Create(ctx context.Context, req res
 		RemoteIPPrefix: data.RemoteIpPrefix.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -167,15 +186,27 @@ func (r *NetworkSecurityGroupsRulesResource) Create(ctx context.Context, req res
 }
 
 func (r *NetworkSecurityGroupsRulesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsRulesTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer securityGroupRuleIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkSecurityGroupRuleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	rule, err := r.networkRules.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -196,23 +227,37 @@ func (r *NetworkSecurityGroupsRulesResource) Read(ctx context.Context, req resou
 }
 
 func (r *NetworkSecurityGroupsRulesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsRulesTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data NetworkSecurityGroupRuleModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkRules.Delete(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
 func (r *NetworkSecurityGroupsRulesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkSecurityGroupsRulesTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer securityGroupRuleIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("Update is not supported for security group rules", "")
 }
 
+func (r *NetworkSecurityGroupsRulesResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = securityGroupRuleIdentity.Schema()
+}
+
 func (r *NetworkSecurityGroupsRulesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	securityGroupRuleIdentity.ImportState(ctx, req, resp)
 }
@@ -5,8 +5,8 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -16,29 +16,38 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkSubnetpoolsTypeName = "mgc_network_subnetpools"
+
 type NetworkSubnetPoolModel struct {
 	ID          types.String `tfsdk:"id"`
 	Cidr        types.String `tfsdk:"cidr"`
 	Description types.String `tfsdk:"description"`
 	Name        types.String `tfsdk:"name"`
+	Region      types.String `tfsdk:"region"`
 }
 
 type mgcNetworkSubnetpoolsResource struct {
+	regional           utils.RegionalService
 	subnetPoolsService netSDK.SubnetPoolService
 }
 
+var subnetpoolIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the subnet pool."),
+)
+
 func NewNetworkSubnetpoolsResource() resource.Resource {
 	return &mgcNetworkSubnetpoolsResource{}
 }
 
-func (r *mgcNetworkSubnetpoolsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_subnetpools"
+func (r *mgcNetworkSubnetpoolsResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkSubnetpoolsTypeName
 }
 
 func (r *mgcNetworkSubnetpoolsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Subnet Pool",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the subnet pool",
 				Computed:    true,
@@ -83,23 +92,33 @@ func (r *mgcNetworkSubnetpoolsResource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	r.subnetPoolsService = netSDK.New(&dataConfig.CoreConfig).SubnetPools()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.subnetPoolsService = netSDK.New(&cfg.CoreConfig).SubnetPools()
+	})
 }
 
 func (r *mgcNetworkSubnetpoolsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkSubnetpoolsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer subnetpoolIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := NetworkSubnetPoolModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subnetPool, err := r.subnetPoolsService.Create(ctx, netSDK.CreateSubnetPoolRequest{
 		CIDR:        data.Cidr.ValueStringPointer(),
 		Description: data.Description.ValueString(),
 		Name:        data.Name.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -108,15 +127,27 @@ func (r *mgcNetworkSubnetpoolsResource) Create(ctx context.Context, req resource
 }
 
 func (r *mgcNetworkSubnetpoolsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkSubnetpoolsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer subnetpoolIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkSubnetPoolModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subnetPool, err := r.subnetPoolsService.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -128,23 +159,37 @@ func (r *mgcNetworkSubnetpoolsResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *mgcNetworkSubnetpoolsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkSubnetpoolsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer subnetpoolIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("Update is not supported for subnet pools", "")
 }
 
 func (r *mgcNetworkSubnetpoolsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkSubnetpoolsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data NetworkSubnetPoolModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.subnetPoolsService.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+func (r *mgcNetworkSubnetpoolsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = subnetpoolIdentity.Schema()
+}
+
 func (r *mgcNetworkSubnetpoolsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	subnetpoolIdentity.ImportState(ctx, req, resp)
 }
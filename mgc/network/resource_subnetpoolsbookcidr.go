@@ -5,6 +5,7 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -13,12 +14,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkSubnetpoolsBookCidrTypeName = "mgc_network_subnetpools_book_cidr"
+
 type NetworkSubnetPoolsBookCIDRModel struct {
 	SubnetPoolID types.String `tfsdk:"subnet_pool_id"`
 	CIDR         types.String `tfsdk:"cidr"`
+	Region       types.String `tfsdk:"region"`
 }
 
 type NetworkSubnetPoolsBookCIDRResource struct {
+	regional           utils.RegionalService
 	subnetPoolsService netSDK.SubnetPoolService
 }
 
@@ -26,8 +31,8 @@ func NewNetworkSubnetPoolsBookCIDRResource() resource.Resource {
 	return &NetworkSubnetPoolsBookCIDRResource{}
 }
 
-func (r *NetworkSubnetPoolsBookCIDRResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_subnetpools_book_cidr"
+func (r *NetworkSubnetPoolsBookCIDRResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkSubnetpoolsBookCidrTypeName
 }
 
 func (r *NetworkSubnetPoolsBookCIDRResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -40,13 +45,16 @@ func (r *NetworkSubnetPoolsBookCIDRResource) Configure(ctx context.Context, req
 		return
 	}
 
-	r.subnetPoolsService = netSDK.New(&dataConfig.CoreConfig).SubnetPools()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.subnetPoolsService = netSDK.New(&cfg.CoreConfig).SubnetPools()
+	})
 }
 
 func (r *NetworkSubnetPoolsBookCIDRResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network Subnet Pools Book CIDR",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"subnet_pool_id": schema.StringAttribute{
 				Description: "Subnet Pool ID",
 				Required:    true,
@@ -63,27 +71,41 @@ func (r *NetworkSubnetPoolsBookCIDRResource) Schema(_ context.Context, _ resourc
 }
 
 func (r *NetworkSubnetPoolsBookCIDRResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkSubnetpoolsBookCidrTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+
 	data := NetworkSubnetPoolsBookCIDRModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.State.Set(ctx, &data)
 }
 
 func (r *NetworkSubnetPoolsBookCIDRResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkSubnetpoolsBookCidrTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+
 	var data NetworkSubnetPoolsBookCIDRModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	_, err := r.subnetPoolsService.BookCIDR(ctx, data.SubnetPoolID.ValueString(), netSDK.BookCIDRRequest{
 		CIDR: data.CIDR.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -91,21 +113,30 @@ func (r *NetworkSubnetPoolsBookCIDRResource) Create(ctx context.Context, req res
 }
 
 func (r *NetworkSubnetPoolsBookCIDRResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkSubnetpoolsBookCidrTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data NetworkSubnetPoolsBookCIDRModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.subnetPoolsService.UnbookCIDR(ctx, data.SubnetPoolID.ValueString(), netSDK.UnbookCIDRRequest{
 		CIDR: data.CIDR.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
 func (r *NetworkSubnetPoolsBookCIDRResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkSubnetpoolsBookCidrTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+
 	resp.Diagnostics.AddError("Update is not supported for network subnet pools book CIDR", "")
 }
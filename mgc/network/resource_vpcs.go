@@ -2,39 +2,52 @@ package network
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strings"
 	"time"
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const networkVpcsTypeName = "mgc_network_vpcs"
+
 const NetworkPoolingTimeout = 5 * time.Minute
 
 type NetworkVPCModel struct {
-	Id          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	Id          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Region      types.String   `tfsdk:"region"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
+var vpcTimeoutsOpts = timeouts.Opts{Create: true}
+
 type NetworkVPCResource struct {
+	regional   utils.RegionalService
 	networkVPC netSDK.VPCService
 }
 
+var vpcIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the VPC."),
+)
+
 func NewNetworkVPCResource() resource.Resource {
 	return &NetworkVPCResource{}
 }
 
-func (r *NetworkVPCResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs"
+func (r *NetworkVPCResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkVpcsTypeName
 }
 
 func (r *NetworkVPCResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -47,13 +60,16 @@ func (r *NetworkVPCResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	r.networkVPC = netSDK.New(&dataConfig.CoreConfig).VPCs()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkVPC = netSDK.New(&cfg.CoreConfig).VPCs()
+	})
 }
 
-func (r *NetworkVPCResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NetworkVPCResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the VPC",
 				Computed:    true,
@@ -76,43 +92,67 @@ func (r *NetworkVPCResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, vpcTimeoutsOpts),
+		},
 	}
 }
 
 func (r *NetworkVPCResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkVpcsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer vpcIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkVPCModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, NetworkPoolingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createdVPC, err := r.networkVPC.Create(ctx, netSDK.CreateVPCRequest{
 		Name:        data.Name.ValueString(),
 		Description: data.Description.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	for startTime := time.Now(); time.Since(startTime) < NetworkPoolingTimeout; {
-		res, err := r.networkVPC.Get(ctx, createdVPC)
-		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
-			return
-		}
-		if res.Status == "created" {
-			break
-		}
-		if strings.Contains(res.Status, "error") {
-			resp.Diagnostics.AddError(
-				"Error in VPC creation",
-				"VPC creation failed with status: ["+res.Status+"] \nVPC ID: "+createdVPC+" \nPlease check the VPC status in the Magalu Cloud CLI or contact support")
-			return
-		}
-		tflog.Info(ctx, "VPC is not yet created, waiting for 10 seconds",
-			map[string]interface{}{"status": res.Status})
-		time.Sleep(10 * time.Second)
+	_, err = utils.StateWaiter[*netSDK.VPC]{
+		Description: fmt.Sprintf("VPC %s", createdVPC),
+		Target:      []string{"created"},
+		IsError:     func(state string) bool { return strings.Contains(state, "error") },
+		Timeout:     createTimeout,
+		MinInterval: 10 * time.Second,
+		Refresh: func(ctx context.Context) (*netSDK.VPC, string, error) {
+			vpc, err := r.networkVPC.Get(ctx, createdVPC)
+			if err != nil {
+				return nil, "", err
+			}
+			return vpc, vpc.Status, nil
+		},
+	}.Wait(ctx)
+	var stateErr *utils.ErrorStateError
+	if errors.As(err, &stateErr) {
+		resp.Diagnostics.AddError(
+			"Error in VPC creation",
+			"VPC creation failed with status: ["+stateErr.State+"] \nVPC ID: "+createdVPC+" \nPlease check the VPC status in the Magalu Cloud CLI or contact support")
+	} else if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+	}
+	if err != nil {
+		vpcIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": data.Region.ValueString(), "id": createdVPC}, &resp.Diagnostics)
+		return
 	}
 
 	data.Id = types.StringValue(createdVPC)
@@ -120,18 +160,36 @@ func (r *NetworkVPCResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *NetworkVPCResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkVpcsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer vpcIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data NetworkVPCModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vpc, err := r.networkVPC.Get(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
+	readVPC(vpc, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readVPC sets the attributes of data read from vpc.
+func readVPC(vpc *netSDK.VPC, data *NetworkVPCModel) {
 	if vpc.Description != nil && *vpc.Description == "" {
 		vpc.Description = nil
 	}
@@ -139,28 +197,52 @@ func (r *NetworkVPCResource) Read(ctx context.Context, req resource.ReadRequest,
 	data.Name = types.StringPointerValue(vpc.Name)
 	data.Description = types.StringPointerValue(vpc.Description)
 	data.Id = types.StringPointerValue(vpc.ID)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *NetworkVPCResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkVpcsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data NetworkVPCModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkVPC.Delete(ctx, data.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
 
+// Update only persists the timeouts block, every other attribute forces replacement.
 func (r *NetworkVPCResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update is not supported for VPC", "")
+	defer tracing.Resource(&ctx, networkVpcsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer vpcIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data NetworkVPCModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NetworkVPCResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = vpcIdentity.Schema()
 }
 
 func (r *NetworkVPCResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	vpcIdentity.ImportState(ctx, req, resp)
 }
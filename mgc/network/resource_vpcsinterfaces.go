@@ -2,11 +2,10 @@ package network
 
 import (
 	"context"
-	"net/http"
 
-	clientSDK "github.com/MagaluCloud/mgc-sdk-go/client"
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -17,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const networkVpcsInterfacesTypeName = "mgc_network_vpcs_interfaces"
+
 type NetworkVPCInterfaceModel struct {
 	Id               types.String   `tfsdk:"id"`
 	VpcId            types.String   `tfsdk:"vpc_id"`
@@ -25,19 +26,25 @@ type NetworkVPCInterfaceModel struct {
 	SubnetsIds       []types.String `tfsdk:"subnet_ids"`
 	AntiSpoofing     types.Bool     `tfsdk:"anti_spoofing"`
 	IPAddress        types.String   `tfsdk:"ip_address"`
+	Region           types.String   `tfsdk:"region"`
 }
 
 type NetworkVPCInterfaceResource struct {
+	regional         utils.RegionalService
 	networkVpcsPorts netSDK.VPCService
 	networkPorts     netSDK.PortService
 }
 
+var vpcInterfaceIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the network interface."),
+)
+
 func NewNetworkVPCInterfaceResource() resource.Resource {
 	return &NetworkVPCInterfaceResource{}
 }
 
-func (r *NetworkVPCInterfaceResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs_interfaces"
+func (r *NetworkVPCInterfaceResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkVpcsInterfacesTypeName
 }
 
 func (r *NetworkVPCInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -50,14 +57,17 @@ func (r *NetworkVPCInterfaceResource) Configure(ctx context.Context, req resourc
 		return
 	}
 
-	r.networkVpcsPorts = netSDK.New(&dataConfig.CoreConfig).VPCs()
-	r.networkPorts = netSDK.New(&dataConfig.CoreConfig).Ports()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkVpcsPorts = netSDK.New(&cfg.CoreConfig).VPCs()
+		r.networkPorts = netSDK.New(&cfg.CoreConfig).Ports()
+	})
 }
 
 func (r *NetworkVPCInterfaceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC Interface",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the VPC Interface",
 				Computed:    true,
@@ -112,15 +122,27 @@ func (r *NetworkVPCInterfaceResource) Schema(_ context.Context, _ resource.Schem
 }
 
 func (r *NetworkVPCInterfaceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkVpcsInterfacesTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer vpcInterfaceIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var model NetworkVPCInterfaceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vpcInterface, err := r.networkPorts.Get(ctx, model.Id.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -137,12 +159,20 @@ func (r *NetworkVPCInterfaceResource) Read(ctx context.Context, req resource.Rea
 }
 
 func (r *NetworkVPCInterfaceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkVpcsInterfacesTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer vpcInterfaceIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var model NetworkVPCInterfaceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	defaultRules := false
 	createNic := netSDK.PortCreateRequest{
 		Name:   model.Name.ValueString(),
@@ -167,7 +197,7 @@ func (r *NetworkVPCInterfaceResource) Create(ctx context.Context, req resource.C
 			Zone: model.AvailabilityZone.ValueStringPointer(),
 		})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -175,18 +205,21 @@ func (r *NetworkVPCInterfaceResource) Create(ctx context.Context, req resource.C
 		model.AntiSpoofing = types.BoolValue(true)
 	}
 
+	partial := map[string]string{"region": model.Region.ValueString(), "id": createdVPCInterface}
 	err = r.networkPorts.Update(ctx, createdVPCInterface, netSDK.PortUpdateRequest{
 		IPSpoofingGuard: model.AntiSpoofing.ValueBoolPointer(),
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		vpcInterfaceIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 		return
 	}
 
 	createdVPCGet, err := r.networkPorts.Get(ctx, createdVPCInterface)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		vpcInterfaceIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 		return
 	}
 
@@ -197,6 +230,9 @@ func (r *NetworkVPCInterfaceResource) Create(ctx context.Context, req resource.C
 }
 
 func (r *NetworkVPCInterfaceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkVpcsInterfacesTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer vpcInterfaceIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var planData NetworkVPCInterfaceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
 	if resp.Diagnostics.HasError() {
@@ -209,6 +245,11 @@ func (r *NetworkVPCInterfaceResource) Update(ctx context.Context, req resource.U
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&stateData.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if planData.AntiSpoofing.ValueBool() != stateData.AntiSpoofing.ValueBool() {
 		stateData.AntiSpoofing = planData.AntiSpoofing
 
@@ -217,7 +258,7 @@ func (r *NetworkVPCInterfaceResource) Update(ctx context.Context, req resource.U
 		})
 
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
@@ -226,28 +267,30 @@ func (r *NetworkVPCInterfaceResource) Update(ctx context.Context, req resource.U
 }
 
 func (r *NetworkVPCInterfaceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkVpcsInterfacesTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var model NetworkVPCInterfaceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &model)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&model.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkPorts.Delete(ctx, model.Id.ValueString())
-	if err != nil {
-		switch e := err.(type) {
-		case *clientSDK.HTTPError:
-			if e.StatusCode == http.StatusNotFound {
-				return
-			}
-		default:
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
-			return
-		}
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
 	}
 }
 
+func (r *NetworkVPCInterfaceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = vpcInterfaceIdentity.Schema()
+}
+
 func (r *NetworkVPCInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.Set(ctx, &NetworkVPCInterfaceModel{
-		Id: types.StringValue(req.ID),
-	})...)
+	vpcInterfaceIdentity.ImportState(ctx, req, resp)
 }
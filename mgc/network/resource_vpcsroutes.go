@@ -2,30 +2,39 @@ package network
 
 import (
 	"context"
-	"errors"
 	"fmt"
-	"net/http"
 	"slices"
 	"strings"
 	"time"
 
-	clientSDK "github.com/MagaluCloud/mgc-sdk-go/client"
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const networkVpcsRouteTypeName = "mgc_network_vpcs_route"
+
 const (
 	RoutePoolingTimeout = 100 * time.Minute
 )
 
+var routeStatuses = []netSDK.RouteStatus{
+	netSDK.RouteStatusProcessing,
+	netSDK.RouteStatusCreated,
+	netSDK.RouteStatusPending,
+	netSDK.RouteStatusDeleting,
+	netSDK.RouteStatusDeleted,
+	netSDK.RouteStatusUpdating,
+	netSDK.RouteStatusError,
+}
+
 type NetworkVpcsRouteModel struct {
 	ID              types.String `tfsdk:"id"`
 	VpcID           types.String `tfsdk:"vpc_id"`
@@ -37,16 +46,28 @@ type NetworkVpcsRouteModel struct {
 	Status          types.String `tfsdk:"status"`
 }
 
+type NetworkVpcsRouteResourceModel struct {
+	NetworkVpcsRouteModel
+	Region   types.String   `tfsdk:"region"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
 type NetworkVpcsRouteResource struct {
+	regional     utils.RegionalService
 	networkRoute netSDK.VpcsRoutesService
 }
 
+var vpcRouteIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "vpc_id", Description: "ID of the VPC."},
+	utils.IdentityAttribute{Name: "route_id", Description: "ID of the route.", StateAttribute: "id"},
+)
+
 func NewNetworkVpcsRouteResource() resource.Resource {
 	return &NetworkVpcsRouteResource{}
 }
 
-func (r *NetworkVpcsRouteResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs_route"
+func (r *NetworkVpcsRouteResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkVpcsRouteTypeName
 }
 
 func (r *NetworkVpcsRouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -59,13 +80,16 @@ func (r *NetworkVpcsRouteResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	r.networkRoute = netSDK.New(&dataConfig.CoreConfig).VpcsRoutes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkRoute = netSDK.New(&cfg.CoreConfig).VpcsRoutes()
+	})
 }
 
-func (r *NetworkVpcsRouteResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *NetworkVpcsRouteResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC Route",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the route.",
 				Computed:    true,
@@ -113,16 +137,33 @@ func (r *NetworkVpcsRouteResource) Schema(_ context.Context, _ resource.SchemaRe
 				Computed:    true,
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{Create: true, Delete: true}),
+		},
 	}
 }
 
 func (r *NetworkVpcsRouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var data NetworkVpcsRouteModel
+	defer tracing.Resource(&ctx, networkVpcsRouteTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer vpcRouteIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data NetworkVpcsRouteResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, RoutePoolingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	vpcID := data.VpcID.ValueString()
 
 	createdRoute, err := r.networkRoute.Create(ctx, vpcID, netSDK.VpcsRoutesCreateRequest{
@@ -131,118 +172,139 @@ func (r *NetworkVpcsRouteResource) Create(ctx context.Context, req resource.Crea
 		Description:     data.Description.ValueStringPointer(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	route, err := r.WaitUntilRouteStatusMatches(ctx, vpcID, createdRoute.ID, string(netSDK.RouteStatusCreated))
+	route, err := r.WaitUntilRouteStatusMatches(ctx, vpcID, createdRoute.ID, createTimeout, string(netSDK.RouteStatusCreated))
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
-		if fetched, getErr := r.networkRoute.Get(ctx, vpcID, createdRoute.ID); getErr == nil && fetched != nil {
-			resp.Diagnostics.Append(resp.State.Set(ctx, convertSDKRouteResultToTerraformNetworkVpcsRouteModel(fetched))...)
-		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		vpcRouteIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": data.Region.ValueString(), "vpc_id": vpcID, "route_id": createdRoute.ID}, &resp.Diagnostics)
 		return
 	}
 
-	tfResult := convertSDKRouteResultToTerraformNetworkVpcsRouteModel(route)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &tfResult)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &NetworkVpcsRouteResourceModel{
+		NetworkVpcsRouteModel: *convertSDKRouteResultToTerraformNetworkVpcsRouteModel(route),
+		Timeouts:              data.Timeouts,
+	})...)
 }
 
 func (r *NetworkVpcsRouteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-	var data NetworkVpcsRouteModel
+	defer tracing.Resource(&ctx, networkVpcsRouteTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer vpcRouteIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
+	var data NetworkVpcsRouteResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	route, err := r.networkRoute.Get(ctx, data.VpcID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		if httpErr, ok := err.(*clientSDK.HTTPError); ok && httpErr.StatusCode == http.StatusNotFound {
+		if utils.IsNotFound(err) {
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	tfResult := convertSDKRouteResultToTerraformNetworkVpcsRouteModel(route)
-	resp.Diagnostics.Append(resp.State.Set(ctx, &tfResult)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &NetworkVpcsRouteResourceModel{
+		NetworkVpcsRouteModel: *convertSDKRouteResultToTerraformNetworkVpcsRouteModel(route),
+		Timeouts:              data.Timeouts,
+	})...)
 }
 
 func (r *NetworkVpcsRouteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data NetworkVpcsRouteModel
+	defer tracing.Resource(&ctx, networkVpcsRouteTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
+	var data NetworkVpcsRouteResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, RoutePoolingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkRoute.Delete(ctx, data.VpcID.ValueString(), data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	_, err = r.WaitUntilRouteStatusMatches(ctx, data.VpcID.ValueString(), data.ID.ValueString(), string(netSDK.RouteStatusDeleted))
-	if err != nil {
-		switch e := err.(type) {
-		case *clientSDK.HTTPError:
-			if e.StatusCode == http.StatusNotFound {
-				return
-			} else {
-				resp.Diagnostics.AddError(utils.ParseSDKError(err))
-				return
-			}
-		default:
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
-			return
-		}
+	_, err = r.WaitUntilRouteStatusMatches(ctx, data.VpcID.ValueString(), data.ID.ValueString(), deleteTimeout, string(netSDK.RouteStatusDeleted))
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
 	}
 }
 
+// Update only persists the timeouts block, every other attribute forces replacement.
 func (r *NetworkVpcsRouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError("Update is not supported for route", "")
-}
+	defer tracing.Resource(&ctx, networkVpcsRouteTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer vpcRouteIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
 
-func (r *NetworkVpcsRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	parts := strings.Split(req.ID, ",")
-	if len(parts) != 2 {
-		resp.Diagnostics.AddError("Invalid import format", "Use `<vpc_id>,<route_id>`")
+	var data NetworkVpcsRouteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("vpc_id"), parts[0])...,
-	)
-	resp.Diagnostics.Append(
-		resp.State.SetAttribute(ctx, path.Root("id"), parts[1])...,
-	)
-}
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-func (r *NetworkVpcsRouteResource) WaitUntilRouteStatusMatches(ctx context.Context, vpcID, routeID string, expectedStatus ...string) (*netSDK.VpcsRoute, error) {
-	var result *netSDK.VpcsRoute
-	var err error
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
 
-	time.Sleep(5 * time.Second)
-	for startTime := time.Now(); time.Since(startTime) < RoutePoolingTimeout; {
-		result, err = r.networkRoute.Get(ctx, vpcID, routeID)
-		if err != nil {
-			return nil, err
-		}
+func (r *NetworkVpcsRouteResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = vpcRouteIdentity.Schema()
+}
 
-		status := strings.ToLower(string(result.Status))
+func (r *NetworkVpcsRouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vpcRouteIdentity.ImportState(ctx, req, resp)
+}
 
-		if slices.Contains(expectedStatus, status) {
-			return result, nil
-		}
-		if status == string(netSDK.RouteStatusError) {
-			return result, errors.New("route provisioning failure")
+func (r *NetworkVpcsRouteResource) WaitUntilRouteStatusMatches(ctx context.Context, vpcID, routeID string, timeout time.Duration, expectedStatus ...string) (*netSDK.VpcsRoute, error) {
+	var pending []string
+	for _, status := range routeStatuses {
+		if !slices.Contains(expectedStatus, string(status)) && status != netSDK.RouteStatusError {
+			pending = append(pending, string(status))
 		}
-
-		tflog.Debug(ctx, fmt.Sprintf("current route status: [%s]", status))
-		time.Sleep(30 * time.Second)
 	}
 
-	return result, errors.New("timeout waiting for route to provision")
+	return utils.StateWaiter[*netSDK.VpcsRoute]{
+		Description: fmt.Sprintf("route %s", routeID),
+		Target:      expectedStatus,
+		Pending:     pending,
+		Error:       []string{string(netSDK.RouteStatusError)},
+		Timeout:     timeout,
+		Delay:       5 * time.Second,
+		MinInterval: 10 * time.Second,
+		MaxInterval: 30 * time.Second,
+		Refresh: func(ctx context.Context) (*netSDK.VpcsRoute, string, error) {
+			route, err := r.networkRoute.Get(ctx, vpcID, routeID)
+			if err != nil {
+				return nil, "", err
+			}
+			return route, strings.ToLower(string(route.Status)), nil
+		},
+	}.Wait(ctx)
 }
 
 func convertSDKRouteResultToTerraformNetworkVpcsRouteModel(sdkResult *netSDK.VpcsRoute) *NetworkVpcsRouteModel {
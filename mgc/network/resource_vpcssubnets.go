@@ -5,7 +5,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,9 +14,12 @@ import (
 
 	netSDK "github.com/MagaluCloud/mgc-sdk-go/network"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const networkVpcsSubnetsTypeName = "mgc_network_vpcs_subnets"
+
 type mgcNetworkVpcsSubnetsModel struct {
 	ID               types.String `tfsdk:"id"`
 	CidrBlock        types.String `tfsdk:"cidr_block"`
@@ -28,26 +30,33 @@ type mgcNetworkVpcsSubnetsModel struct {
 	SubnetpoolId     types.String `tfsdk:"subnetpool_id"`
 	VpcId            types.String `tfsdk:"vpc_id"`
 	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	Region           types.String `tfsdk:"region"`
 }
 
 type mgcNetworkVpcsSubnetsResource struct {
+	regional           utils.RegionalService
 	networkVpcsSubnets netSDK.VPCService
 	networkSubnets     netSDK.SubnetService
 	region             string
 }
 
+var subnetIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the subnet."),
+)
+
 func NewNetworkVpcsSubnetsResource() resource.Resource {
 	return &mgcNetworkVpcsSubnetsResource{}
 }
 
-func (r *mgcNetworkVpcsSubnetsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_network_vpcs_subnets"
+func (r *mgcNetworkVpcsSubnetsResource) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = networkVpcsSubnetsTypeName
 }
 
 func (r *mgcNetworkVpcsSubnetsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Network VPC Subnet",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The ID of the VPC subnet",
 				Computed:    true,
@@ -136,18 +145,28 @@ func (r *mgcNetworkVpcsSubnetsResource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	r.networkVpcsSubnets = netSDK.New(&dataConfig.CoreConfig).VPCs()
-	r.networkSubnets = netSDK.New(&dataConfig.CoreConfig).Subnets()
-	r.region = dataConfig.Region
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.networkVpcsSubnets = netSDK.New(&cfg.CoreConfig).VPCs()
+		r.networkSubnets = netSDK.New(&cfg.CoreConfig).Subnets()
+		r.region = cfg.Region
+	})
 }
 
 func (r *mgcNetworkVpcsSubnetsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, networkVpcsSubnetsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer subnetIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := mgcNetworkVpcsSubnetsModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	dnsCreateParam := []string{}
 	if !data.DnsNameservers.IsNull() && !data.DnsNameservers.IsUnknown() {
 		data.DnsNameservers.ElementsAs(ctx, &dnsCreateParam, false)
@@ -175,13 +194,14 @@ func (r *mgcNetworkVpcsSubnetsResource) Create(ctx context.Context, req resource
 		Zone: azparsed,
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	createdSubnet, err := r.networkSubnets.Get(ctx, subnetID)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		subnetIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": data.Region.ValueString(), "id": subnetID}, &resp.Diagnostics)
 		return
 	}
 
@@ -192,15 +212,27 @@ func (r *mgcNetworkVpcsSubnetsResource) Create(ctx context.Context, req resource
 }
 
 func (r *mgcNetworkVpcsSubnetsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, networkVpcsSubnetsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer subnetIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := &mgcNetworkVpcsSubnetsModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	subnet, err := r.networkSubnets.Get(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -216,12 +248,20 @@ func (r *mgcNetworkVpcsSubnetsResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *mgcNetworkVpcsSubnetsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, networkVpcsSubnetsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer subnetIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := mgcNetworkVpcsSubnetsModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	dnsServers := []string{}
 	if !data.DnsNameservers.IsNull() && !data.DnsNameservers.IsUnknown() {
 		data.DnsNameservers.ElementsAs(ctx, &dnsServers, false)
@@ -232,7 +272,7 @@ func (r *mgcNetworkVpcsSubnetsResource) Update(ctx context.Context, req resource
 
 	_, err := r.networkSubnets.Update(ctx, data.ID.ValueString(), subnetUpdateParams)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -240,15 +280,22 @@ func (r *mgcNetworkVpcsSubnetsResource) Update(ctx context.Context, req resource
 }
 
 func (r *mgcNetworkVpcsSubnetsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, networkVpcsSubnetsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	data := mgcNetworkVpcsSubnetsModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.networkSubnets.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 }
@@ -260,6 +307,10 @@ func convertIPStringToIPVersion(ipVersion string) int {
 	return 6
 }
 
+func (r *mgcNetworkVpcsSubnetsResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = subnetIdentity.Schema()
+}
+
 func (r *mgcNetworkVpcsSubnetsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	subnetIdentity.ImportState(ctx, req, resp)
 }
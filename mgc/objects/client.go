@@ -0,0 +1,124 @@
+package objects
+
+import (
+	"crypto/tls"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	objSdk "github.com/MagaluCloud/mgc-sdk-go/objectstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// newObjectStorageClient builds the object storage client for the provider
+// settings and returns it with the endpoint used to build bucket URLs.
+func newObjectStorageClient(cfg utils.DataConfig) (*objSdk.ObjectStorageClient, string, error) {
+	endpoint := cfg.ObjectStorage.Endpoint
+	if endpoint == "" {
+		regionEndpoint, err := utils.RegionToS3Url(cfg.Region, cfg.Env)
+		if err != nil {
+			return nil, "", fmt.Errorf("%w. Set object_storage.endpoint to use another endpoint", err)
+		}
+		endpoint = regionEndpoint.String()
+	}
+
+	opts := []objSdk.ClientOption{}
+	if cfg.ObjectStorage.IsCustom() {
+		// The SDK only accepts the public endpoints, so custom settings are
+		// applied through a MinIO client built here.
+		minioClient, err := newMinioClient(cfg, endpoint, http.DefaultTransport.(*http.Transport))
+		if err != nil {
+			return nil, "", err
+		}
+		opts = append(opts, objSdk.WithMinioClient(minioClient))
+	} else {
+		opts = append(opts, objSdk.WithEndpoint(objSdk.Endpoint(endpoint)))
+	}
+
+	client, err := objSdk.New(&cfg.CoreConfig, cfg.KeyPairID, cfg.KeyPairSecret, opts...)
+	if err != nil {
+		return nil, "", err
+	}
+	return client, endpoint, nil
+}
+
+// newMinioClient addresses buckets of a custom endpoint as configured by
+// use_path_style. The region endpoints keep the SDK addressing unless path
+// style is requested, when only skip_tls_verify is set. Requests are sent
+// with a clone of base.
+func newMinioClient(cfg utils.DataConfig, endpoint string, base *http.Transport) (*minio.Client, error) {
+	if err := utils.ValidateHostEndpointURL(endpoint); err != nil {
+		return nil, fmt.Errorf("invalid object storage endpoint %q: %w", endpoint, err)
+	}
+	u, _ := url.Parse(endpoint)
+
+	transport := base.Clone()
+	if cfg.ObjectStorage.SkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	lookup := minio.BucketLookupAuto
+	switch {
+	case cfg.ObjectStorage.UsePathStyle:
+		lookup = minio.BucketLookupPath
+	case cfg.ObjectStorage.Endpoint != "":
+		lookup = minio.BucketLookupDNS
+	}
+
+	return minio.New(u.Host, &minio.Options{
+		Creds:        credentials.NewStaticV4(cfg.KeyPairID, cfg.KeyPairSecret, ""),
+		Secure:       u.Scheme != "http",
+		Transport:    &forceDeleteTransport{base: transport},
+		BucketLookup: lookup,
+	})
+}
+
+// forceDeleteTransport mirrors the SDK transport, which is not used when the
+// MinIO client is built by the provider.
+type forceDeleteTransport struct {
+	base http.RoundTripper
+}
+
+func (t *forceDeleteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodDelete && objSdk.HasForceDelete(req.Context()) {
+		req.Header.Set("X-Force-Container-Delete", "true")
+	}
+	return t.base.RoundTrip(req)
+}
+
+// regionalBuckets holds the bucket service of the region selected by the
+// region attribute of a resource or data source.
+type regionalBuckets struct {
+	regional utils.RegionalService
+	buckets  objSdk.BucketService
+	region   string
+	endpoint string
+	err      error
+}
+
+func (b *regionalBuckets) configure(cfg utils.DataConfig) {
+	b.regional = utils.NewRegionalService(cfg, func(cfg utils.DataConfig) {
+		var client *objSdk.ObjectStorageClient
+		client, b.endpoint, b.err = newObjectStorageClient(cfg)
+		b.region = cfg.Region
+		if b.err == nil {
+			b.buckets = client.Buckets()
+		}
+	})
+}
+
+// use selects region like utils.RegionalService.Use. Client errors are only
+// reported here, so a provider region without object storage does not fail
+// resources that select another region.
+func (b *regionalBuckets) use(region *types.String) diag.Diagnostics {
+	diags := b.regional.Use(region)
+	if !diags.HasError() && b.err != nil {
+		diags.AddAttributeError(path.Root("region"), "Failed to configure object storage", b.err.Error())
+	}
+	return diags
+}
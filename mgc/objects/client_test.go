@@ -0,0 +1,130 @@
+package objects
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKey = "11111111-1111-4111-8111-111111111111"
+
+func TestNewObjectStorageClient_CustomEndpointPathStyle(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		if r.URL.Query().Has("location") {
+			w.Write([]byte(`<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">br-mgl1</LocationConstraint>`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client, endpoint, err := newObjectStorageClient(utils.DataConfig{
+		Region:        "br-mgl1",
+		Env:           "prod",
+		KeyPairID:     testKey,
+		KeyPairSecret: testKey,
+		ObjectStorage: utils.ObjectStorageConfig{Endpoint: server.URL, UsePathStyle: true},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, server.URL, endpoint)
+
+	exists, err := client.Buckets().Exists(context.Background(), "my-bucket")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Contains(t, paths, "/my-bucket/")
+}
+
+func TestNewMinioClient_CustomEndpointVirtualHost(t *testing.T) {
+	t.Parallel()
+	var hosts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hosts = append(hosts, r.Host)
+		if r.URL.Query().Has("location") {
+			w.Write([]byte(`<LocationConstraint xmlns="http://s3.amazonaws.com/doc/2006-03-01/">br-mgl1</LocationConstraint>`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The bucket host does not resolve, so every connection goes to the server.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+	}
+
+	client, err := newMinioClient(utils.DataConfig{
+		Region:        "br-mgl1",
+		Env:           "prod",
+		KeyPairID:     testKey,
+		KeyPairSecret: testKey,
+		ObjectStorage: utils.ObjectStorageConfig{Endpoint: "http://s3.example.com"},
+	}, "http://s3.example.com", transport)
+	require.NoError(t, err)
+
+	exists, err := client.BucketExists(context.Background(), "my-bucket")
+	require.NoError(t, err)
+	assert.True(t, exists)
+	assert.Contains(t, hosts, "my-bucket.s3.example.com")
+}
+
+func TestNewObjectStorageClient_EndpointWithPath(t *testing.T) {
+	_, _, err := newObjectStorageClient(utils.DataConfig{
+		Region:        "br-se1",
+		Env:           "prod",
+		KeyPairID:     testKey,
+		KeyPairSecret: testKey,
+		ObjectStorage: utils.ObjectStorageConfig{Endpoint: "https://proxy.internal/s3"},
+	})
+	assert.ErrorContains(t, err, `path "/s3" is not supported`)
+}
+
+func TestNewObjectStorageClient_RegionEndpoint(t *testing.T) {
+	_, endpoint, err := newObjectStorageClient(utils.DataConfig{
+		Region:        "br-ne1",
+		Env:           "prod",
+		KeyPairID:     testKey,
+		KeyPairSecret: testKey,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://br-ne1.magaluobjects.com", endpoint)
+
+	_, _, err = newObjectStorageClient(utils.DataConfig{
+		Region:        "br-mgl1",
+		Env:           "prod",
+		KeyPairID:     testKey,
+		KeyPairSecret: testKey,
+	})
+	assert.ErrorContains(t, err, "object_storage.endpoint")
+}
+
+func TestRegionalBuckets_Use(t *testing.T) {
+	var b regionalBuckets
+	b.configure(utils.DataConfig{
+		Region:        "br-mgl1",
+		Env:           "prod",
+		KeyPairID:     testKey,
+		KeyPairSecret: testKey,
+		RegionClients: utils.NewRegionClients("prod", ""),
+	})
+
+	region := types.StringNull()
+	diags := b.use(&region)
+	assert.True(t, diags.HasError())
+
+	region = types.StringValue("br-ne1")
+	diags = b.use(&region)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "br-ne1", b.region)
+	assert.Equal(t, "https://br-ne1.magaluobjects.com", b.endpoint)
+	assert.NotNil(t, b.buckets)
+}
@@ -10,16 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	objSdk "github.com/MagaluCloud/mgc-sdk-go/objectstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const objectStorageBucketTypeName = "mgc_object_storage_bucket"
+
 var _ datasource.DataSource = &objectStorageBucketDataSource{}
 
 type objectStorageBucketDataSource struct {
-	buckets  objSdk.BucketService
-	region   string
-	endpoint string
+	regionalBuckets
 }
 
 type ObjectStorageBucketDataSourceModel struct {
@@ -36,8 +36,8 @@ func NewObjectStorageBucketDataSource() datasource.DataSource {
 	return &objectStorageBucketDataSource{}
 }
 
-func (d *objectStorageBucketDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_object_storage_bucket"
+func (d *objectStorageBucketDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = objectStorageBucketTypeName
 }
 
 func (d *objectStorageBucketDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -51,20 +51,7 @@ func (d *objectStorageBucketDataSource) Configure(ctx context.Context, req datas
 		return
 	}
 
-	endpoint, err := utils.RegionToS3Url(dataConfig.Region, dataConfig.Env)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid region/env for object storage", endpoint.String())
-		return
-	}
-
-	a, err := objSdk.New(&dataConfig.CoreConfig, dataConfig.KeyPairID, dataConfig.KeyPairSecret, objSdk.WithEndpoint(endpoint))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to configure object storage", "Invalid credentials data")
-		return
-	}
-	d.buckets = a.Buckets()
-	d.region = dataConfig.Region
-	d.endpoint = endpoint.String()
+	d.configure(dataConfig)
 }
 
 func (d *objectStorageBucketDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
@@ -117,10 +104,7 @@ func (d *objectStorageBucketDataSource) Schema(ctx context.Context, req datasour
 					},
 				},
 			},
-			"region": schema.StringAttribute{
-				Computed:    true,
-				Description: "The region where the bucket is located.",
-			},
+			"region": utils.DataSourceRegionAttribute(),
 			"url": schema.StringAttribute{
 				Computed:    true,
 				Description: "The URL endpoint of the bucket.",
@@ -130,12 +114,19 @@ func (d *objectStorageBucketDataSource) Schema(ctx context.Context, req datasour
 }
 
 func (d *objectStorageBucketDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, objectStorageBucketTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data ObjectStorageBucketDataSourceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(d.use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := data.Bucket.ValueString()
 
 	exists, err := d.buckets.Exists(ctx, bucketName)
@@ -7,26 +7,27 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	objSdk "github.com/MagaluCloud/mgc-sdk-go/objectstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
 var _ datasource.DataSource = &objectStorageBucketsDataSource{}
 
 type objectStorageBucketsDataSource struct {
-	buckets objSdk.BucketService
+	regionalBuckets
 }
 
 type ObjectStorageBucketsDataSourceModel struct {
 	Buckets []types.String `tfsdk:"buckets"`
+	Region  types.String   `tfsdk:"region"`
 }
 
 func NewObjectStorageBucketsDataSource() datasource.DataSource {
 	return &objectStorageBucketsDataSource{}
 }
 
-func (d *objectStorageBucketsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_object_storage_buckets"
+func (d *objectStorageBucketsDataSource) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = objectStorageBucketsTypeName
 }
 
 func (d *objectStorageBucketsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -40,24 +41,14 @@ func (d *objectStorageBucketsDataSource) Configure(ctx context.Context, req data
 		return
 	}
 
-	endpoint, err := utils.RegionToS3Url(dataConfig.Region, dataConfig.Env)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid region/env for object storage", endpoint.String())
-		return
-	}
-
-	a, err := objSdk.New(&dataConfig.CoreConfig, dataConfig.KeyPairID, dataConfig.KeyPairSecret, objSdk.WithEndpoint(endpoint))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to configure object storage", "Invalid credentials data")
-		return
-	}
-	d.buckets = a.Buckets()
+	d.configure(dataConfig)
 }
 
 func (d *objectStorageBucketsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Retrieves a list of all object storage bucket names.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"buckets": schema.ListAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
@@ -68,7 +59,18 @@ func (d *objectStorageBucketsDataSource) Schema(ctx context.Context, req datasou
 }
 
 func (d *objectStorageBucketsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, objectStorageBucketsTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data ObjectStorageBucketsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(d.use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	buckets, err := d.buckets.List(ctx)
 	if err != nil {
@@ -0,0 +1,78 @@
+package objects
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	objSdk "github.com/MagaluCloud/mgc-sdk-go/objectstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+var (
+	_ list.ListResource              = &objectStorageBucketsList{}
+	_ list.ListResourceWithConfigure = &objectStorageBucketsList{}
+)
+
+type objectStorageBucketsList struct {
+	regionalBuckets
+}
+
+type objectStorageBucketsListModel struct {
+	utils.ListConfig
+}
+
+func NewObjectStorageBucketsList() list.ListResource {
+	return &objectStorageBucketsList{}
+}
+
+func (r *objectStorageBucketsList) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = objectStorageBucketsTypeName
+}
+
+func (r *objectStorageBucketsList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to configure provider", "Invalid provider data")
+		return
+	}
+
+	r.configure(dataConfig)
+}
+
+func (r *objectStorageBucketsList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists object storage buckets.",
+		Attributes: map[string]listschema.Attribute{
+			"region":     utils.ListRegionAttribute(),
+			"name_regex": utils.ListNameRegexAttribute(),
+		},
+	}
+}
+
+func (r *objectStorageBucketsList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	utils.List(ctx, req, stream, utils.Lister[objectStorageBucketsListModel, objSdk.Bucket]{
+		TypeName: objectStorageBucketsTypeName,
+		Identity: bucketIdentity,
+		Use:      r.use,
+		Fetch: func(ctx context.Context, _ objectStorageBucketsListModel) ([]objSdk.Bucket, error) {
+			return r.buckets.List(ctx)
+		},
+		Describe: func(_ objectStorageBucketsListModel, bucket objSdk.Bucket) (string, map[string]string, bool) {
+			return bucket.Name, map[string]string{"bucket": bucket.Name}, true
+		},
+		Resource: func(ctx context.Context, _ objectStorageBucketsListModel, bucket objSdk.Bucket) (any, diag.Diagnostics) {
+			state := ObjectStorageBucket{Bucket: types.StringValue(bucket.Name)}
+			diags := r.readBucket(ctx, &state)
+			return state, diags
+		},
+	})
+}
@@ -2,6 +2,7 @@ package objects
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -17,3 +18,9 @@ func GetResources() []func() resource.Resource {
 		NewObjectStorageBucketsResource,
 	}
 }
+
+func GetListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewObjectStorageBucketsList,
+	}
+}
@@ -6,7 +6,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -16,9 +16,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	objSdk "github.com/MagaluCloud/mgc-sdk-go/objectstorage"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const objectStorageBucketsTypeName = "mgc_object_storage_buckets"
+
 type ObjectStorageBucket struct {
 	Bucket     types.String `tfsdk:"bucket"`
 	Versioning types.Bool   `tfsdk:"versioning"`
@@ -37,18 +40,20 @@ type CORS struct {
 	MaxAgeSeconds  types.Int64 `tfsdk:"max_age_seconds"`
 }
 
+var bucketIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "bucket", Description: "Name of the bucket."},
+)
+
 func NewObjectStorageBucketsResource() resource.Resource {
 	return &objectStorageBuckets{}
 }
 
 type objectStorageBuckets struct {
-	buckets  objSdk.BucketService
-	region   string
-	endpoint string
+	regionalBuckets
 }
 
-func (r *objectStorageBuckets) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_object_storage_buckets"
+func (r *objectStorageBuckets) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = objectStorageBucketsTypeName
 }
 
 func (r *objectStorageBuckets) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -62,20 +67,7 @@ func (r *objectStorageBuckets) Configure(ctx context.Context, req resource.Confi
 		return
 	}
 
-	endpoint, err := utils.RegionToS3Url(dataConfig.Region, dataConfig.Env)
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid region/env for object storage", endpoint.String())
-		return
-	}
-
-	a, err := objSdk.New(&dataConfig.CoreConfig, dataConfig.KeyPairID, dataConfig.KeyPairSecret, objSdk.WithEndpoint(endpoint))
-	if err != nil {
-		resp.Diagnostics.AddError("Failed to configure object storage", "Invalid credentials data")
-		return
-	}
-	r.buckets = a.Buckets()
-	r.region = dataConfig.Region
-	r.endpoint = endpoint.String()
+	r.configure(dataConfig)
 }
 
 func (r *objectStorageBuckets) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -140,10 +132,7 @@ func (r *objectStorageBuckets) Schema(ctx context.Context, req resource.SchemaRe
 					},
 				},
 			},
-			"region": schema.StringAttribute{
-				Computed:    true,
-				Description: "The region where the bucket is located.",
-			},
+			"region": utils.ResourceRegionAttribute(),
 			"url": schema.StringAttribute{
 				Computed:    true,
 				Description: "The URL endpoint of the bucket.",
@@ -153,6 +142,9 @@ func (r *objectStorageBuckets) Schema(ctx context.Context, req resource.SchemaRe
 }
 
 func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, objectStorageBucketsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer bucketIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var plan ObjectStorageBucket
 	diags := req.Plan.Get(ctx, &plan)
 	if diags.HasError() {
@@ -160,6 +152,11 @@ func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	resp.Diagnostics.Append(r.use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := plan.Bucket.ValueString()
 
 	if err := r.buckets.Create(ctx, bucketName); err != nil {
@@ -170,13 +167,14 @@ func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
+	partial := map[string]string{"region": plan.Region.ValueString(), "bucket": bucketName}
 	if !plan.Versioning.IsNull() && plan.Versioning.ValueBool() {
 		if err := r.buckets.EnableVersioning(ctx, bucketName); err != nil {
 			resp.Diagnostics.AddError(
 				"Error enabling versioning",
 				fmt.Sprintf("Could not enable versioning for bucket %s: %s", bucketName, err.Error()),
 			)
-			_ = r.buckets.Delete(ctx, bucketName, false)
+			bucketIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 			return
 		}
 	}
@@ -187,7 +185,7 @@ func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRe
 				"Error locking bucket",
 				fmt.Sprintf("Could not enable object lock for bucket %s: %s", bucketName, err.Error()),
 			)
-			_ = r.buckets.Delete(ctx, bucketName, false)
+			bucketIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 			return
 		}
 	}
@@ -199,7 +197,7 @@ func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRe
 				"Error parsing bucket policy",
 				fmt.Sprintf("Could not parse bucket policy JSON: %s", err.Error()),
 			)
-			_ = r.buckets.Delete(ctx, bucketName, false)
+			bucketIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 			return
 		}
 
@@ -208,7 +206,7 @@ func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRe
 				"Error setting bucket policy",
 				fmt.Sprintf("Could not set bucket policy for %s: %s", bucketName, err.Error()),
 			)
-			_ = r.buckets.Delete(ctx, bucketName, false)
+			bucketIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 			return
 		}
 	}
@@ -221,7 +219,7 @@ func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRe
 		})
 		if diags.HasError() {
 			resp.Diagnostics.Append(diags...)
-			_ = r.buckets.Delete(ctx, bucketName, false)
+			bucketIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 			return
 		}
 
@@ -231,7 +229,7 @@ func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRe
 				"Error parsing CORS configuration",
 				fmt.Sprintf("Could not parse CORS configuration: %s", err.Error()),
 			)
-			_ = r.buckets.Delete(ctx, bucketName, false)
+			bucketIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 			return
 		}
 
@@ -240,7 +238,7 @@ func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRe
 				"Error setting CORS configuration",
 				fmt.Sprintf("Could not set CORS configuration for bucket %s: %s", bucketName, err.Error()),
 			)
-			_ = r.buckets.Delete(ctx, bucketName, false)
+			bucketIdentity.SetCreated(ctx, &resp.State, partial, &resp.Diagnostics)
 			return
 		}
 	} else if plan.CORS.IsUnknown() {
@@ -265,6 +263,9 @@ func (r *objectStorageBuckets) Create(ctx context.Context, req resource.CreateRe
 }
 
 func (r *objectStorageBuckets) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, objectStorageBucketsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer bucketIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var state ObjectStorageBucket
 	diags := req.State.Get(ctx, &state)
 	if diags.HasError() {
@@ -272,6 +273,11 @@ func (r *objectStorageBuckets) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
+	resp.Diagnostics.Append(r.use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := state.Bucket.ValueString()
 
 	exists, err := r.buckets.Exists(ctx, bucketName)
@@ -288,13 +294,27 @@ func (r *objectStorageBuckets) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	versioningStatus, err := r.buckets.GetVersioningStatus(ctx, bucketName)
+	resp.Diagnostics.Append(r.readBucket(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// readBucket sets the attributes of state read from its bucket, which exists.
+func (b *regionalBuckets) readBucket(ctx context.Context, state *ObjectStorageBucket) diag.Diagnostics {
+	var diags diag.Diagnostics
+	bucketName := state.Bucket.ValueString()
+
+	versioningStatus, err := b.buckets.GetVersioningStatus(ctx, bucketName)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Error reading versioning status",
 			fmt.Sprintf("Could not read versioning status for bucket %s: %s", bucketName, err.Error()),
 		)
-		return
+		return diags
 	}
 	if versioningStatus != nil && versioningStatus.Status == "Enabled" {
 		state.Versioning = types.BoolValue(true)
@@ -302,31 +322,31 @@ func (r *objectStorageBuckets) Read(ctx context.Context, req resource.ReadReques
 		state.Versioning = types.BoolValue(false)
 	}
 
-	lockStatus, err := r.buckets.GetBucketLockStatus(ctx, bucketName)
+	lockStatus, err := b.buckets.GetBucketLockStatus(ctx, bucketName)
 	if err != nil {
 		state.Lock = types.BoolValue(false)
 	} else {
 		state.Lock = types.BoolValue(lockStatus)
 	}
 
-	policy, err := r.buckets.GetPolicy(ctx, bucketName)
+	policy, err := b.buckets.GetPolicy(ctx, bucketName)
 	if err != nil {
 		state.Policy = types.StringValue("")
 	} else if policy != nil {
 		policyJSON, err := json.Marshal(policy)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Error serializing bucket policy",
 				fmt.Sprintf("Could not serialize policy for bucket %s: %s", bucketName, err.Error()),
 			)
-			return
+			return diags
 		}
 		state.Policy = types.StringValue(string(policyJSON))
 	} else {
 		state.Policy = types.StringValue("")
 	}
 
-	corsConfig, err := r.buckets.GetCORS(ctx, bucketName)
+	corsConfig, err := b.buckets.GetCORS(ctx, bucketName)
 	if err != nil || corsConfig == nil || len(corsConfig.CORSRules) == 0 {
 		state.CORS = types.ObjectNull(map[string]attr.Type{
 			"allowed_headers": types.ListType{ElemType: types.StringType},
@@ -338,34 +358,35 @@ func (r *objectStorageBuckets) Read(ctx context.Context, req resource.ReadReques
 	} else {
 		tfCORS, err := convertFromCORSConfiguration(ctx, corsConfig)
 		if err != nil {
-			resp.Diagnostics.AddError(
+			diags.AddError(
 				"Error converting CORS configuration",
 				fmt.Sprintf("Could not convert CORS configuration: %s", err.Error()),
 			)
-			return
+			return diags
 		}
-		corsObj, diag := types.ObjectValueFrom(ctx, map[string]attr.Type{
+		corsObj, corsDiags := types.ObjectValueFrom(ctx, map[string]attr.Type{
 			"allowed_headers": types.ListType{ElemType: types.StringType},
 			"allowed_methods": types.ListType{ElemType: types.StringType},
 			"allowed_origins": types.ListType{ElemType: types.StringType},
 			"expose_headers":  types.ListType{ElemType: types.StringType},
 			"max_age_seconds": types.Int64Type,
 		}, tfCORS)
-		if diag.HasError() {
-			resp.Diagnostics.Append(diag...)
-			return
+		if corsDiags.HasError() {
+			diags.Append(corsDiags...)
+			return diags
 		}
 		state.CORS = corsObj
 	}
 
-	state.Region = types.StringValue(r.region)
-	state.URL = types.StringValue(fmt.Sprintf("%s/%s", r.endpoint, bucketName))
-
-	diags = resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
+	state.Region = types.StringValue(b.region)
+	state.URL = types.StringValue(fmt.Sprintf("%s/%s", b.endpoint, bucketName))
+	return diags
 }
 
 func (r *objectStorageBuckets) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, objectStorageBucketsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer bucketIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var plan ObjectStorageBucket
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -378,6 +399,11 @@ func (r *objectStorageBuckets) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	resp.Diagnostics.Append(r.use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := plan.Bucket.ValueString()
 
 	if !plan.Versioning.Equal(state.Versioning) {
@@ -506,6 +532,8 @@ func (r *objectStorageBuckets) Update(ctx context.Context, req resource.UpdateRe
 }
 
 func (r *objectStorageBuckets) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, objectStorageBucketsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var state ObjectStorageBucket
 	diags := req.State.Get(ctx, &state)
 	if diags.HasError() {
@@ -513,6 +541,11 @@ func (r *objectStorageBuckets) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
+	resp.Diagnostics.Append(r.use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	bucketName := state.Bucket.ValueString()
 
 	exists, err := r.buckets.Exists(ctx, bucketName)
@@ -537,6 +570,10 @@ func (r *objectStorageBuckets) Delete(ctx context.Context, req resource.DeleteRe
 	}
 }
 
+func (r *objectStorageBuckets) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = bucketIdentity.Schema()
+}
+
 func (r *objectStorageBuckets) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("bucket"), req, resp)
+	bucketIdentity.ImportState(ctx, req, resp)
 }
@@ -0,0 +1,70 @@
+package platform_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/acctest"
+)
+
+func TestAccFunctionAvailabilityZones(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.Config(srv, `
+output "zone" {
+  value = provider::mgc::az_to_zone("br-se1-b")
+}
+
+output "availability_zone" {
+  value = provider::mgc::zone_to_az("br-ne1", "a")
+}
+
+output "endpoint" {
+  value = provider::mgc::region_endpoint("br-ne1")
+}
+
+output "qa_endpoint" {
+  value = provider::mgc::region_endpoint("br-mc1", "dev-qa")
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("zone", "b"),
+					resource.TestCheckOutput("availability_zone", "br-ne1-a"),
+					resource.TestCheckOutput("endpoint", "https://api.magalu.cloud/br-ne1"),
+					resource.TestCheckOutput("qa_endpoint", "https://api.dev-qa.jaxyendy.com/br-mc1"),
+				),
+			},
+			{
+				Config: acctest.Config(srv, `
+output "zone" {
+  value = provider::mgc::az_to_zone("br-se1")
+}
+`),
+				ExpectError: regexp.MustCompile(`invalid availability zone\s+format: br-se1`),
+			},
+			{
+				Config: acctest.Config(srv, `
+output "availability_zone" {
+  value = provider::mgc::zone_to_az("br-ne1", "ab")
+}
+`),
+				ExpectError: regexp.MustCompile(`invalid zone "ab"`),
+			},
+			{
+				Config: acctest.Config(srv, `
+output "endpoint" {
+  value = provider::mgc::region_endpoint("br-mc1")
+}
+`),
+				ExpectError: regexp.MustCompile(`region "br-mc1" is\s+not\s+available\s+in\s+the\s+prod\s+environment`),
+			},
+		},
+	})
+}
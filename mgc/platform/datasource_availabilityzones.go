@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdkAzs "github.com/MagaluCloud/mgc-sdk-go/availabilityzones"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -11,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const availabilityZonesTypeName = "mgc_availability_zones"
+
 var _ datasource.DataSource = &DataSourceAvailabilityZones{}
 
 type DataSourceAvailabilityZones struct {
@@ -21,8 +24,8 @@ func NewDataSourceAvailabilityZones() datasource.DataSource {
 	return &DataSourceAvailabilityZones{}
 }
 
-func (r *DataSourceAvailabilityZones) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_availability_zones"
+func (r *DataSourceAvailabilityZones) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = availabilityZonesTypeName
 }
 
 type Regions struct {
@@ -86,13 +89,15 @@ func (r *DataSourceAvailabilityZones) Schema(_ context.Context, req datasource.S
 	}
 }
 func (r *DataSourceAvailabilityZones) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, availabilityZonesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data Regions
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	sdkOutput, err := r.sdkClient.List(ctx, sdkAzs.ListOptions{ShowBlocked: false})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
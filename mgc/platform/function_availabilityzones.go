@@ -0,0 +1,104 @@
+package platform
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var (
+	_ function.Function = &FunctionAzToZone{}
+	_ function.Function = &FunctionZoneToAz{}
+)
+
+var rgxZone = regexp.MustCompile(`^[a-z]$`)
+
+type FunctionAzToZone struct{}
+
+func NewFunctionAzToZone() function.Function {
+	return &FunctionAzToZone{}
+}
+
+func (f *FunctionAzToZone) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "az_to_zone"
+}
+
+func (f *FunctionAzToZone) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Zone letter of an availability zone",
+		Description: "Returns the zone letter of an availability zone, as some APIs expect it: `a` for `br-se1-a`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "availability_zone",
+				Description: "Availability zone, such as `br-se1-a`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FunctionAzToZone) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var availabilityZone string
+	resp.Error = req.Arguments.Get(ctx, &availabilityZone)
+	if resp.Error != nil {
+		return
+	}
+
+	zone, err := utils.ConvertAvailabilityZoneToXZone(availabilityZone)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, zone)
+}
+
+type FunctionZoneToAz struct{}
+
+func NewFunctionZoneToAz() function.Function {
+	return &FunctionZoneToAz{}
+}
+
+func (f *FunctionZoneToAz) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "zone_to_az"
+}
+
+func (f *FunctionZoneToAz) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Availability zone of a region and zone letter",
+		Description: "Returns the availability zone of a region and zone letter: `br-se1-a` for `br-se1` and `a`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "region",
+				Description: "Region, such as `br-se1`.",
+			},
+			function.StringParameter{
+				Name:        "zone",
+				Description: "Zone letter, such as `a`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FunctionZoneToAz) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var region, zone string
+	resp.Error = req.Arguments.Get(ctx, &region, &zone)
+	if resp.Error != nil {
+		return
+	}
+
+	if !rgxZone.MatchString(strings.ToLower(zone)) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid zone %q, expected a single letter", zone))
+		return
+	}
+	availabilityZone := utils.ConvertXZoneToAvailabilityZone(region, zone)
+	if _, err := utils.ConvertAvailabilityZoneToXZone(availabilityZone); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid region %q", region))
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, availabilityZone)
+}
@@ -0,0 +1,69 @@
+package platform
+
+import (
+	"context"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &FunctionRegionEndpoint{}
+
+type FunctionRegionEndpoint struct{}
+
+func NewFunctionRegionEndpoint() function.Function {
+	return &FunctionRegionEndpoint{}
+}
+
+func (f *FunctionRegionEndpoint) Metadata(_ context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "region_endpoint"
+}
+
+func (f *FunctionRegionEndpoint) Definition(_ context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "API endpoint of a region",
+		Description: "Returns the API URL the provider uses for a region, such as `https://api.magalu.cloud/br-se1` for `br-se1`. " +
+			"An environment other than `prod` may be given as a second argument.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "region",
+				Description: "Region, such as `br-se1`.",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:        "env",
+			Description: "Environment, `prod` when omitted. At most one may be given.",
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *FunctionRegionEndpoint) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var (
+		region string
+		envs   []string
+	)
+	resp.Error = req.Arguments.Get(ctx, &region, &envs)
+	if resp.Error != nil {
+		return
+	}
+	if region == "" {
+		resp.Error = function.NewArgumentFuncError(0, "region must not be empty")
+		return
+	}
+	if len(envs) > 1 {
+		resp.Error = function.NewArgumentFuncError(2, "at most one environment may be given")
+		return
+	}
+
+	env := utils.ENV_PROD
+	if len(envs) == 1 {
+		env = envs[0]
+	}
+	url, err := utils.RegionToUrl(region, env)
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, url)
+}
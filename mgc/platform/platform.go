@@ -2,6 +2,7 @@ package platform
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -14,3 +15,11 @@ func GetDataSources() []func() datasource.DataSource {
 func GetResources() []func() resource.Resource {
 	return []func() resource.Resource{}
 }
+
+func GetFunctions() []func() function.Function {
+	return []func() function.Function{
+		NewFunctionAzToZone,
+		NewFunctionRegionEndpoint,
+		NewFunctionZoneToAz,
+	}
+}
@@ -3,13 +3,16 @@ package mgc
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"regexp"
 	"runtime"
+	"strings"
 
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/blockstorage"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/containerregistry"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/database"
 	internalhttp "github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/http"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/kubernetes"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/lbaas"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/network"
@@ -18,8 +21,17 @@ import (
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/ssh"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/virtualmachines"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -37,6 +49,13 @@ const (
 	defaultEnv       = "prod"
 )
 
+var (
+	_ provider.ProviderWithListResources      = &mgcProvider{}
+	_ provider.ProviderWithFunctions          = &mgcProvider{}
+	_ provider.ProviderWithEphemeralResources = &mgcProvider{}
+	_ provider.ProviderWithActions            = &mgcProvider{}
+)
+
 type mgcProvider struct {
 	version string
 }
@@ -44,11 +63,45 @@ type mgcProvider struct {
 var rgxUUIDv4 = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
 
 type ProviderModel struct {
-	Region        types.String `tfsdk:"region"`
-	Env           types.String `tfsdk:"env"`
-	ApiKey        types.String `tfsdk:"api_key"`
-	KeyPairID     types.String `tfsdk:"key_pair_id"`
-	KeyPairSecret types.String `tfsdk:"key_pair_secret"`
+	Region           types.String            `tfsdk:"region"`
+	Env              types.String            `tfsdk:"env"`
+	ApiKey           types.String            `tfsdk:"api_key"`
+	KeyPairID        types.String            `tfsdk:"key_pair_id"`
+	KeyPairSecret    types.String            `tfsdk:"key_pair_secret"`
+	Profile          types.String            `tfsdk:"profile"`
+	ApiEndpoint      types.String            `tfsdk:"api_endpoint"`
+	ServiceEndpoints map[string]types.String `tfsdk:"service_endpoints"`
+	HttpDebug        types.Bool              `tfsdk:"http_debug"`
+	HttpRecord       types.String            `tfsdk:"http_record"`
+	HttpReplay       types.String            `tfsdk:"http_replay"`
+	Retry            *RetryModel             `tfsdk:"retry"`
+	RateLimit        *RateLimitModel         `tfsdk:"rate_limit"`
+	ObjectStorage    *ObjectStorageModel     `tfsdk:"object_storage"`
+}
+
+type RetryModel struct {
+	MaxAttempts          types.Int64  `tfsdk:"max_attempts"`
+	MinBackoff           types.String `tfsdk:"min_backoff"`
+	MaxBackoff           types.String `tfsdk:"max_backoff"`
+	RetryableStatusCodes types.List   `tfsdk:"retryable_status_codes"`
+	RespectRetryAfter    types.Bool   `tfsdk:"respect_retry_after"`
+}
+
+type RateLimitModel struct {
+	RequestsPerSecond types.Float64                    `tfsdk:"requests_per_second"`
+	MaxInFlight       types.Int64                      `tfsdk:"max_in_flight"`
+	Services          map[string]ServiceRateLimitModel `tfsdk:"services"`
+}
+
+type ServiceRateLimitModel struct {
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	MaxInFlight       types.Int64   `tfsdk:"max_in_flight"`
+}
+
+type ObjectStorageModel struct {
+	Endpoint      types.String `tfsdk:"endpoint"`
+	UsePathStyle  types.Bool   `tfsdk:"use_path_style"`
+	SkipTLSVerify types.Bool   `tfsdk:"skip_tls_verify"`
 }
 
 func (p *mgcProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -61,29 +114,23 @@ func (p *mgcProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 		Description: "Terraform Provider for Magalu Cloud",
 		Attributes: map[string]schema.Attribute{
 			"env": schema.StringAttribute{
-				Description: "The environment to use. Options: prod / pre-prod / dev-qa. Default is " + defaultEnv,
+				Description: "The environment to use. Options: " + strings.Join(validEnvs, " / ") + ", or any name when api_endpoint is set. Can also be set with the " + envEnv + " environment variable. Default is " + defaultEnv,
 				Optional:    true,
-				Validators: []validator.String{
-					stringvalidator.OneOf("prod", "pre-prod", "dev-qa"),
-				},
 			},
 			"region": schema.StringAttribute{
-				Description: "The region to use for resources. Options: br-ne1 / br-se1. Default is " + defaultRegion,
+				Description: "The region to use for resources. Options: " + strings.Join(validRegions, " / ") + ", or any name when api_endpoint is set. Can also be set with the " + envRegion + " environment variable. Default is " + defaultRegion,
 				Optional:    true,
-				Validators: []validator.String{
-					stringvalidator.OneOf("br-ne1", "br-se1", "br-mgl1", "br-mc1"),
-				},
 			},
 			"api_key": schema.StringAttribute{
-				Description: "The Magalu API Key for authentication.",
-				Required:    true,
+				Description: "The Magalu API Key for authentication. Can also be set with the " + envApiKey + " environment variable or read from the mgc CLI profile.",
+				Optional:    true,
 				Sensitive:   true,
 				Validators: []validator.String{
 					stringvalidator.RegexMatches(rgxUUIDv4, "must be a valid Magalu Cloud API key"),
 				},
 			},
 			"key_pair_id": schema.StringAttribute{
-				Description: "Key Pair ID for Object Storage.",
+				Description: "Key Pair ID for Object Storage. Can also be set with the " + envKeyPairID + " environment variable or read from the mgc CLI profile.",
 				Optional:    true,
 				Sensitive:   true,
 				Validators: []validator.String{
@@ -92,7 +139,7 @@ func (p *mgcProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 				},
 			},
 			"key_pair_secret": schema.StringAttribute{
-				Description: "Key Pair Secret for Object Storage.",
+				Description: "Key Pair Secret for Object Storage. Can also be set with the " + envKeyPairSecret + " environment variable or read from the mgc CLI profile.",
 				Optional:    true,
 				Sensitive:   true,
 				Validators: []validator.String{
@@ -100,6 +147,158 @@ func (p *mgcProvider) Schema(ctx context.Context, req provider.SchemaRequest, re
 					stringvalidator.AlsoRequires(path.MatchRoot("key_pair_id")),
 				},
 			},
+			"profile": schema.StringAttribute{
+				Description: "The mgc CLI profile used as a fallback for settings not defined in the provider block or in MGC_* environment variables. Can also be set with the " + envProfile + " environment variable. Default is the CLI current profile.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"api_endpoint": schema.StringAttribute{
+				Description: "Base URL used for all API requests instead of the URL derived from env and region, such as a private endpoint, an internal proxy or a local mock API. " +
+					"Services are requested under it, e.g. <api_endpoint>/compute/v1/instances. Can also be set with the " + envApiEndpoint + " environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					utils.URLValidator{},
+				},
+			},
+			"service_endpoints": schema.MapAttribute{
+				Description: "Base URL for a single service, taking precedence over api_endpoint and the region URL. Keys: " + strings.Join(internalhttp.EndpointServices(), " / ") + ".",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.OneOf(internalhttp.EndpointServices()...)),
+					mapvalidator.ValueStringsAre(utils.URLValidator{}),
+				},
+			},
+			"http_debug": schema.BoolAttribute{
+				Description: "Whether to log the method, headers and JSON body of every API request and response at TRACE level (TF_LOG=TRACE). " +
+					"API keys, passwords, private keys, user_data and registry credentials are redacted. Can also be set with the " + envHttpDebug + " environment variable. Default is false.",
+				Optional: true,
+			},
+			"http_record": schema.StringAttribute{
+				Description: "Path of a cassette file receiving every API request and response, with the same secrets redacted as in http_debug. " +
+					"Each provider run appends to the file, so it can be attached to a bug report and replayed with http_replay. Can also be set with the " + envHttpRecord + " environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+					stringvalidator.ConflictsWith(path.MatchRoot("http_replay")),
+				},
+			},
+			"http_replay": schema.StringAttribute{
+				Description: "Path of a cassette file written with http_record. API requests are answered from the cassette and never sent, so a recorded apply can be reproduced offline. " +
+					"Can also be set with the " + envHttpReplay + " environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"retry": schema.SingleNestedBlock{
+				Description: "Retry behavior for transient API errors. Requests failing with a retryable status code are retried with exponential backoff.",
+				Attributes: map[string]schema.Attribute{
+					"max_attempts": schema.Int64Attribute{
+						Description: fmt.Sprintf("Maximum number of attempts for each API request, including the first one. Set to 1 to disable retries. Default is %d.", internalhttp.DefaultRetryMaxAttempts),
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 20),
+						},
+					},
+					"min_backoff": schema.StringAttribute{
+						Description: "Wait time before the first retry, doubled on each following attempt. Default is " + internalhttp.DefaultRetryMinBackoff.String() + ".",
+						Optional:    true,
+						Validators: []validator.String{
+							utils.DurationValidator{},
+						},
+					},
+					"max_backoff": schema.StringAttribute{
+						Description: "Maximum wait time between retries. Default is " + internalhttp.DefaultRetryMaxBackoff.String() + ".",
+						Optional:    true,
+						Validators: []validator.String{
+							utils.DurationValidator{},
+						},
+					},
+					"retryable_status_codes": schema.ListAttribute{
+						Description: "HTTP status codes that trigger a retry. Default is [429, 502, 503, 504]. POST and PATCH requests are only retried on 429 and 503, as the API may have handled them on the other codes.",
+						Optional:    true,
+						ElementType: types.Int64Type,
+						Validators: []validator.List{
+							listvalidator.ValueInt64sAre(int64validator.Between(400, 599)),
+						},
+					},
+					"respect_retry_after": schema.BoolAttribute{
+						Description: "Whether to wait for the duration sent by the API in the Retry-After header, limited by max_backoff. Default is true.",
+						Optional:    true,
+					},
+				},
+			},
+			"rate_limit": schema.SingleNestedBlock{
+				Description: "Client-side limits applied to API requests made by all resources and data sources. Unset limits are not enforced.",
+				Attributes: map[string]schema.Attribute{
+					"requests_per_second": schema.Float64Attribute{
+						Description: "Maximum number of API requests per second across all services.",
+						Optional:    true,
+						Validators: []validator.Float64{
+							float64validator.AtLeast(0.1),
+						},
+					},
+					"max_in_flight": schema.Int64Attribute{
+						Description: "Maximum number of concurrent API requests across all services.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"services": schema.MapNestedAttribute{
+						Description: "Limits for a single service, applied in addition to the global limits. Keys: " + strings.Join(internalhttp.RateLimitedServices(), " / ") + ".",
+						Optional:    true,
+						Validators: []validator.Map{
+							mapvalidator.KeysAre(stringvalidator.OneOf(internalhttp.RateLimitedServices()...)),
+						},
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"requests_per_second": schema.Float64Attribute{
+									Description: "Maximum number of API requests per second to the service.",
+									Optional:    true,
+									Validators: []validator.Float64{
+										float64validator.AtLeast(0.1),
+									},
+								},
+								"max_in_flight": schema.Int64Attribute{
+									Description: "Maximum number of concurrent API requests to the service.",
+									Optional:    true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"object_storage": schema.SingleNestedBlock{
+				Description: "Connection settings for Object Storage, used by the bucket resources and data sources.",
+				Attributes: map[string]schema.Attribute{
+					"endpoint": schema.StringAttribute{
+						Description: "S3 endpoint used instead of the one derived from env and region, such as a local MinIO server. " +
+							"Only a scheme and host are supported, such as `https://s3.example.com`; paths are rejected. Can also be set with the " + envObjectStorageEndpoint + " environment variable.",
+						Optional: true,
+						Validators: []validator.String{
+							utils.URLValidator{},
+						},
+					},
+					"use_path_style": schema.BoolAttribute{
+						Description: "Whether to address buckets of a custom endpoint as <endpoint>/<bucket> instead of <bucket>.<endpoint host>. Default is false. " +
+							"The endpoints derived from env and region keep their default addressing unless it is true.",
+						Optional: true,
+					},
+					"skip_tls_verify": schema.BoolAttribute{
+						Description: "Whether to skip verification of the endpoint TLS certificate. Only use it with test endpoints. Default is false.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -111,17 +310,21 @@ func (p *mgcProvider) Configure(ctx context.Context, req provider.ConfigureReque
 		return
 	}
 
-	if plan.Env.ValueString() == "" {
-		plan.Env = types.StringValue(defaultEnv)
+	resp.Diagnostics.Append(newProviderConfigResolver().Resolve(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if plan.Region.ValueString() == "" {
-		plan.Region = types.StringValue(defaultRegion)
+	resourceOut, diags := NewConfigData(ctx, plan, p.version)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
-
-	resourceOut := NewConfigData(plan, p.version)
 	resp.DataSourceData = resourceOut
 	resp.ResourceData = resourceOut
+	resp.ListResourceData = resourceOut
+	resp.EphemeralResourceData = resourceOut
+	resp.ActionData = resourceOut
 }
 
 func (p *mgcProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -158,28 +361,137 @@ func (p *mgcProvider) DataSources(ctx context.Context) []func() datasource.DataS
 	return dataSources
 }
 
-func NewConfigData(plan ProviderModel, tfVersion string) utils.DataConfig {
+func (p *mgcProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	var listResources []func() list.ListResource
+
+	listResources = append(listResources, blockstorage.GetListResources()...)
+	listResources = append(listResources, database.GetListResources()...)
+	listResources = append(listResources, kubernetes.GetListResources()...)
+	listResources = append(listResources, network.GetListResources()...)
+	listResources = append(listResources, objects.GetListResources()...)
+	listResources = append(listResources, virtualmachines.GetListResources()...)
+
+	return listResources
+}
+
+func (p *mgcProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	var ephemeralResources []func() ephemeral.EphemeralResource
+
+	ephemeralResources = append(ephemeralResources, containerregistry.GetEphemeralResources()...)
+	ephemeralResources = append(ephemeralResources, kubernetes.GetEphemeralResources()...)
+
+	return ephemeralResources
+}
+
+func (p *mgcProvider) Functions(ctx context.Context) []func() function.Function {
+	var functions []func() function.Function
+
+	functions = append(functions, network.GetFunctions()...)
+	functions = append(functions, platform.GetFunctions()...)
+
+	return functions
+}
+
+func (p *mgcProvider) Actions(ctx context.Context) []func() action.Action {
+	var actions []func() action.Action
+
+	actions = append(actions, blockstorage.GetActions()...)
+	actions = append(actions, database.GetActions()...)
+	actions = append(actions, kubernetes.GetActions()...)
+	actions = append(actions, virtualmachines.GetActions()...)
+
+	return actions
+}
+
+func NewConfigData(ctx context.Context, plan ProviderModel, tfVersion string) (utils.DataConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	retryConfig, retryDiags := plan.Retry.toRetryConfig(ctx)
+	diags.Append(retryDiags...)
+	if diags.HasError() {
+		return utils.DataConfig{}, diags
+	}
+
 	output := utils.DataConfig{
 		ApiKey:        plan.ApiKey.ValueString(),
 		Env:           plan.Env.ValueString(),
 		Region:        plan.Region.ValueString(),
 		KeyPairID:     plan.KeyPairID.ValueString(),
 		KeyPairSecret: plan.KeyPairSecret.ValueString(),
+		ObjectStorage: plan.ObjectStorage.toObjectStorageConfig(),
+	}
+
+	baseUrl := plan.ApiEndpoint.ValueString()
+	if baseUrl == "" {
+		var err error
+		baseUrl, err = utils.RegionToUrl(output.Region, output.Env)
+		if err != nil {
+			diags.AddAttributeError(path.Root("region"), "Invalid region",
+				err.Error()+". Set api_endpoint to use an endpoint outside the region table.")
+			return utils.DataConfig{}, diags
+		}
 	}
+	sdkUrl := sdk.MgcUrl(baseUrl)
+	tflog.Info(ctx, "Using MGC URL: "+sdkUrl.String())
 
-	sdkUrl := sdk.MgcUrl(utils.RegionToUrl(output.Region, output.Env))
-	tflog.Info(context.Background(), "Using MGC URL: "+sdkUrl.String())
+	endpointConfig := internalhttp.EndpointConfig{
+		Default:  plan.ApiEndpoint.ValueString(),
+		Services: map[string]string{},
+	}
+	for service, endpoint := range plan.ServiceEndpoints {
+		endpointConfig.Services[service] = endpoint.ValueString()
+		tflog.Info(ctx, "Using custom service endpoint", map[string]any{"service": service, "url": endpoint.ValueString()})
+	}
 
-	output.CoreConfig = *sdk.NewMgcClient(
+	var transport http.RoundTripper = http.DefaultTransport.(*http.Transport).Clone()
+	// Cassettes hold each attempt as sent, so retries are replayed as recorded.
+	switch {
+	case plan.HttpReplay.ValueString() != "":
+		replay, err := internalhttp.NewReplayRoundTripper(plan.HttpReplay.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("http_replay"), "Invalid HTTP cassette", err.Error())
+			return utils.DataConfig{}, diags
+		}
+		transport = replay
+		tflog.Info(ctx, "Replaying API responses from cassette", map[string]any{"path": plan.HttpReplay.ValueString()})
+	case plan.HttpRecord.ValueString() != "":
+		record, err := internalhttp.NewRecordRoundTripper(transport, plan.HttpRecord.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("http_record"), "Invalid HTTP cassette", err.Error())
+			return utils.DataConfig{}, diags
+		}
+		transport = record
+		tflog.Info(ctx, "Recording API interactions to cassette", map[string]any{"path": plan.HttpRecord.ValueString()})
+	}
+	if plan.HttpDebug.ValueBool() {
+		// Innermost, so every retry attempt is logged with its final URL.
+		transport = internalhttp.NewDebugRoundTripper(transport)
+	}
+	// Also inside the retries, so each attempt gets its own span.
+	transport = internalhttp.NewTracingRoundTripper(transport)
+	transport = internalhttp.NewRateLimitRoundTripper(transport, plan.RateLimit.toRateLimitConfig())
+	transport = internalhttp.NewRetryRoundTripper(transport, retryConfig)
+	transport = internalhttp.NewRequestIDRoundTripper(transport)
+	transport = internalhttp.NewEndpointRoundTripper(transport, endpointConfig)
+
+	// Retries are handled by the transport chain, so the SDK only makes a single attempt.
+	clientOptions := []sdk.Option{
 		sdk.WithAPIKey(output.ApiKey),
-		sdk.WithBaseURL(sdkUrl),
 		sdk.WithUserAgent(fmt.Sprintf("MgcTF/%s (%s; %s)", tfVersion, runtime.GOOS, runtime.GOARCH)),
-	)
+		sdk.WithHTTPClient(&http.Client{Transport: transport}),
+		sdk.WithRetryConfig(1, sdk.DefaultInitialInterval, sdk.DefaultMaxInterval, sdk.DefaultBackoffFactor),
+	}
+	output.CoreConfig = *sdk.NewMgcClient(append(clientOptions, sdk.WithBaseURL(sdkUrl))...)
+	output.RegionClients = utils.NewRegionClients(output.Env, plan.ApiEndpoint.ValueString(), clientOptions...)
+	output.CatalogCache = utils.NewCatalogCache(utils.CatalogTTL)
 
-	httpClient := output.CoreConfig.GetConfig().HTTPClient
-	httpClient.Transport = internalhttp.NewRequestIDRoundTripper(httpClient.Transport)
+	return output, diags
+}
 
-	return output
+// SetupTracing enables OpenTelemetry tracing when MGC_OTEL_EXPORTER is set.
+// The returned function flushes the exported spans.
+func SetupTracing(ctx context.Context, version string) (func(context.Context) error, error) {
+	return tracing.Setup(ctx, tracing.ConfigFromEnv(), version)
 }
 
 func New(version string) func() provider.Provider {
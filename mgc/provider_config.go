@@ -0,0 +1,336 @@
+package mgc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	internalhttp "github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/http"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	envApiKey        = "MGC_API_KEY"
+	envRegion        = "MGC_REGION"
+	envEnv           = "MGC_ENV"
+	envKeyPairID     = "MGC_KEY_PAIR_ID"
+	envKeyPairSecret = "MGC_KEY_PAIR_SECRET"
+	envProfile       = "MGC_PROFILE"
+	envApiEndpoint   = "MGC_API_ENDPOINT"
+	envHttpDebug     = "MGC_HTTP_DEBUG"
+	envHttpRecord    = "MGC_HTTP_RECORD"
+	envHttpReplay    = "MGC_HTTP_REPLAY"
+
+	envObjectStorageEndpoint = "MGC_OBJECT_STORAGE_ENDPOINT"
+
+	defaultProfile      = "default"
+	cliConfigDirName    = "mgc"
+	cliCurrentFile      = "current"
+	cliConfigFile       = "cli.yaml"
+	cliAuthFile         = "auth.yaml"
+	sourceProviderBlock = "provider configuration"
+	sourceDefault       = "default value"
+)
+
+var (
+	validEnvs    = []string{"prod", "pre-prod", "dev-qa"}
+	validRegions = []string{"br-ne1", "br-se1", "br-mgl1", "br-mc1"}
+)
+
+// cliProfile holds the subset of the mgc CLI profile files read by the provider.
+type cliProfile struct {
+	Name string `yaml:"-"`
+
+	Region string `yaml:"region"`
+	Env    string `yaml:"env"`
+
+	ApiKey          string `yaml:"api_key"`
+	AccessKeyID     string `yaml:"access_key_id"`
+	SecretAccessKey string `yaml:"secret_access_key"`
+}
+
+// providerConfigResolver fills unset provider attributes using, in order,
+// the MGC_* environment variables and the mgc CLI profile.
+type providerConfigResolver struct {
+	lookupEnv func(string) (string, bool)
+	configDir string
+}
+
+func newProviderConfigResolver() providerConfigResolver {
+	configDir := ""
+	if userDir, err := os.UserConfigDir(); err == nil {
+		configDir = filepath.Join(userDir, cliConfigDirName)
+	}
+
+	return providerConfigResolver{
+		lookupEnv: os.LookupEnv,
+		configDir: configDir,
+	}
+}
+
+func precedenceDescription(profileName string) string {
+	return fmt.Sprintf("settings are resolved in this order: the provider block, the MGC_* environment variables, "+
+		"then the mgc CLI profile %q", profileName)
+}
+
+func (r providerConfigResolver) env(key string) string {
+	if r.lookupEnv == nil {
+		return ""
+	}
+	v, _ := r.lookupEnv(key)
+	return strings.TrimSpace(v)
+}
+
+func (r providerConfigResolver) profileName(configured types.String) (string, bool) {
+	if v := configured.ValueString(); v != "" {
+		return v, true
+	}
+	if v := r.env(envProfile); v != "" {
+		return v, true
+	}
+	if r.configDir != "" {
+		if b, err := os.ReadFile(filepath.Join(r.configDir, cliCurrentFile)); err == nil {
+			if v := strings.TrimSpace(string(b)); v != "" {
+				return v, false
+			}
+		}
+	}
+	return defaultProfile, false
+}
+
+// loadProfile reads the profile files from the CLI configuration directory.
+// A missing profile is only an error when it was explicitly requested.
+func (r providerConfigResolver) loadProfile(name string, explicit bool) (*cliProfile, error) {
+	profile := &cliProfile{Name: name}
+	if r.configDir == "" {
+		return profile, nil
+	}
+
+	profileDir := filepath.Join(r.configDir, name)
+	if _, err := os.Stat(profileDir); err != nil {
+		if errors.Is(err, fs.ErrNotExist) && !explicit {
+			return profile, nil
+		}
+		return nil, fmt.Errorf("mgc CLI profile %q not found in %s", name, r.configDir)
+	}
+
+	for _, file := range []string{cliConfigFile, cliAuthFile} {
+		b, err := os.ReadFile(filepath.Join(profileDir, file))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to read %s of mgc CLI profile %q: %w", file, name, err)
+		}
+		if err := yaml.Unmarshal(b, profile); err != nil {
+			return nil, fmt.Errorf("failed to parse %s of mgc CLI profile %q: %w", file, name, err)
+		}
+	}
+
+	return profile, nil
+}
+
+// Resolve fills the empty attributes of the provider model and returns
+// diagnostics describing any missing or invalid setting.
+func (r providerConfigResolver) Resolve(ctx context.Context, model *ProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	profileName, explicitProfile := r.profileName(model.Profile)
+	profile, err := r.loadProfile(profileName, explicitProfile)
+	if err != nil {
+		diags.AddAttributeError(path.Root("profile"), "Invalid mgc CLI profile", err.Error())
+		return diags
+	}
+	model.Profile = types.StringValue(profileName)
+	profileSource := fmt.Sprintf("mgc CLI profile %q", profileName)
+
+	resolve := func(current *types.String, envKey, profileValue, fallback string) string {
+		switch {
+		case current.ValueString() != "":
+			return sourceProviderBlock
+		case r.env(envKey) != "":
+			*current = types.StringValue(r.env(envKey))
+			return "environment variable " + envKey
+		case profileValue != "":
+			*current = types.StringValue(profileValue)
+			return profileSource
+		case fallback != "":
+			*current = types.StringValue(fallback)
+			return sourceDefault
+		}
+		return ""
+	}
+
+	if model.ObjectStorage == nil {
+		model.ObjectStorage = &ObjectStorageModel{}
+	}
+
+	sources := map[string]string{
+		"api_key":         resolve(&model.ApiKey, envApiKey, profile.ApiKey, ""),
+		"env":             resolve(&model.Env, envEnv, profile.Env, defaultEnv),
+		"region":          resolve(&model.Region, envRegion, profile.Region, defaultRegion),
+		"key_pair_id":     resolve(&model.KeyPairID, envKeyPairID, profile.AccessKeyID, ""),
+		"key_pair_secret": resolve(&model.KeyPairSecret, envKeyPairSecret, profile.SecretAccessKey, ""),
+		"api_endpoint":    resolve(&model.ApiEndpoint, envApiEndpoint, "", ""),
+		"http_record":     resolve(&model.HttpRecord, envHttpRecord, "", ""),
+		"http_replay":     resolve(&model.HttpReplay, envHttpReplay, "", ""),
+
+		"object_storage.endpoint": resolve(&model.ObjectStorage.Endpoint, envObjectStorageEndpoint, "", ""),
+	}
+
+	if model.HttpDebug.IsNull() && r.env(envHttpDebug) != "" {
+		if enabled, err := strconv.ParseBool(r.env(envHttpDebug)); err == nil {
+			model.HttpDebug = types.BoolValue(enabled)
+			sources["http_debug"] = "environment variable " + envHttpDebug
+		} else {
+			diags.AddAttributeError(path.Root("http_debug"), "Invalid HTTP debug setting",
+				fmt.Sprintf("The environment variable %s must be true or false, got %q.", envHttpDebug, r.env(envHttpDebug)))
+		}
+	}
+
+	for attribute, source := range sources {
+		if source == "" {
+			continue
+		}
+		tflog.Info(ctx, "Resolved provider setting", map[string]any{"setting": attribute, "source": source})
+	}
+
+	if model.ApiKey.ValueString() == "" {
+		diags.AddAttributeError(path.Root("api_key"), "Missing API Key",
+			fmt.Sprintf("No API key was found; %s. Set api_key in the provider block, export %s, "+
+				"or authenticate the mgc CLI profile.", precedenceDescription(profileName), envApiKey))
+	} else if !rgxUUIDv4.MatchString(model.ApiKey.ValueString()) {
+		diags.AddAttributeError(path.Root("api_key"), "Invalid API Key",
+			fmt.Sprintf("The API key read from the %s must be a valid Magalu Cloud API key.", sources["api_key"]))
+	}
+
+	// The API endpoint replaces the URLs of the env and region tables, so a
+	// mock or proxy can use names outside them.
+	if model.ApiEndpoint.ValueString() == "" {
+		if !slices.Contains(validEnvs, model.Env.ValueString()) {
+			diags.AddAttributeError(path.Root("env"), "Invalid environment",
+				fmt.Sprintf("The environment %q read from the %s must be one of: %s. Set api_endpoint to use another environment.",
+					model.Env.ValueString(), sources["env"], strings.Join(validEnvs, ", ")))
+		}
+
+		if !slices.Contains(validRegions, model.Region.ValueString()) {
+			diags.AddAttributeError(path.Root("region"), "Invalid region",
+				fmt.Sprintf("The region %q read from the %s must be one of: %s. Set api_endpoint to use another region.",
+					model.Region.ValueString(), sources["region"], strings.Join(validRegions, ", ")))
+		}
+	}
+
+	if endpoint := model.ApiEndpoint.ValueString(); endpoint != "" {
+		if err := utils.ValidateEndpointURL(endpoint); err != nil {
+			diags.AddAttributeError(path.Root("api_endpoint"), "Invalid API endpoint",
+				fmt.Sprintf("The API endpoint %q read from the %s is not valid: %s.", endpoint, sources["api_endpoint"], err))
+		}
+	}
+
+	if endpoint := model.ObjectStorage.Endpoint.ValueString(); endpoint != "" {
+		if err := utils.ValidateHostEndpointURL(endpoint); err != nil {
+			diags.AddAttributeError(path.Root("object_storage").AtName("endpoint"), "Invalid object storage endpoint",
+				fmt.Sprintf("The object storage endpoint %q read from the %s is not valid: %s.", endpoint, sources["object_storage.endpoint"], err))
+		}
+	}
+
+	if model.HttpRecord.ValueString() != "" && model.HttpReplay.ValueString() != "" {
+		diags.AddAttributeError(path.Root("http_replay"), "Conflicting HTTP cassettes",
+			fmt.Sprintf("http_record, read from the %s, and http_replay, read from the %s, cannot be set together.",
+				sources["http_record"], sources["http_replay"]))
+	}
+
+	if (model.KeyPairID.ValueString() == "") != (model.KeyPairSecret.ValueString() == "") {
+		diags.AddAttributeError(path.Root("key_pair_id"), "Incomplete key pair",
+			fmt.Sprintf("key_pair_id and key_pair_secret must be set together; %s.", precedenceDescription(profileName)))
+	}
+
+	return diags
+}
+
+// toRetryConfig merges the retry block with the transport defaults.
+func (m *RetryModel) toRetryConfig(ctx context.Context) (internalhttp.RetryConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := internalhttp.DefaultRetryConfig()
+	if m == nil {
+		return config, diags
+	}
+
+	if !m.MaxAttempts.IsNull() {
+		config.MaxAttempts = int(m.MaxAttempts.ValueInt64())
+	}
+	if d, err := time.ParseDuration(m.MinBackoff.ValueString()); err == nil {
+		config.MinBackoff = d
+	}
+	if d, err := time.ParseDuration(m.MaxBackoff.ValueString()); err == nil {
+		config.MaxBackoff = d
+	}
+	if !m.RespectRetryAfter.IsNull() {
+		config.RespectRetryAfter = m.RespectRetryAfter.ValueBool()
+	}
+	if !m.RetryableStatusCodes.IsNull() {
+		var codes []int64
+		diags.Append(m.RetryableStatusCodes.ElementsAs(ctx, &codes, false)...)
+		config.RetryableStatusCodes = make([]int, 0, len(codes))
+		for _, code := range codes {
+			config.RetryableStatusCodes = append(config.RetryableStatusCodes, int(code))
+		}
+	}
+
+	if config.MinBackoff > config.MaxBackoff {
+		diags.AddAttributeError(path.Root("retry").AtName("min_backoff"), "Invalid retry backoff",
+			fmt.Sprintf("min_backoff (%s) must not be greater than max_backoff (%s).", config.MinBackoff, config.MaxBackoff))
+	}
+
+	return config, diags
+}
+
+func (m ServiceRateLimitModel) toRateLimit() internalhttp.RateLimit {
+	return internalhttp.RateLimit{
+		RequestsPerSecond: m.RequestsPerSecond.ValueFloat64(),
+		MaxInFlight:       int(m.MaxInFlight.ValueInt64()),
+	}
+}
+
+// toRateLimitConfig converts the rate_limit block; a nil block disables client-side limits.
+func (m *RateLimitModel) toRateLimitConfig() internalhttp.RateLimitConfig {
+	config := internalhttp.RateLimitConfig{Services: map[string]internalhttp.RateLimit{}}
+	if m == nil {
+		return config
+	}
+
+	config.Global = ServiceRateLimitModel{
+		RequestsPerSecond: m.RequestsPerSecond,
+		MaxInFlight:       m.MaxInFlight,
+	}.toRateLimit()
+	for service, limit := range m.Services {
+		config.Services[service] = limit.toRateLimit()
+	}
+
+	return config
+}
+
+// toObjectStorageConfig converts the object_storage block; a nil block keeps the region endpoint.
+func (m *ObjectStorageModel) toObjectStorageConfig() utils.ObjectStorageConfig {
+	if m == nil {
+		return utils.ObjectStorageConfig{}
+	}
+
+	return utils.ObjectStorageConfig{
+		Endpoint:      m.Endpoint.ValueString(),
+		UsePathStyle:  m.UsePathStyle.ValueBool(),
+		SkipTLSVerify: m.SkipTLSVerify.ValueBool(),
+	}
+}
@@ -0,0 +1,243 @@
+package mgc
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testApiKey        = "11111111-1111-4111-8111-111111111111"
+	testEnvApiKey     = "22222222-2222-4222-8222-222222222222"
+	testProfileApiKey = "33333333-3333-4333-8333-333333333333"
+)
+
+func envLookup(values map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		v, ok := values[key]
+		return v, ok
+	}
+}
+
+func writeProfile(t *testing.T, dir, name, cli, auth string) {
+	t.Helper()
+	profileDir := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(profileDir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(profileDir, cliConfigFile), []byte(cli), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(profileDir, cliAuthFile), []byte(auth), 0o600))
+}
+
+func TestProviderConfigResolver_ProviderBlockWins(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile(t, dir, defaultProfile, "region: br-ne1\n", "api_key: "+testProfileApiKey+"\n")
+
+	r := providerConfigResolver{
+		lookupEnv: envLookup(map[string]string{envApiKey: testEnvApiKey, envRegion: "br-mgl1"}),
+		configDir: dir,
+	}
+	model := ProviderModel{ApiKey: types.StringValue(testApiKey), Region: types.StringValue("br-se1")}
+
+	diags := r.Resolve(context.Background(), &model)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, testApiKey, model.ApiKey.ValueString())
+	assert.Equal(t, "br-se1", model.Region.ValueString())
+	assert.Equal(t, defaultEnv, model.Env.ValueString())
+}
+
+func TestProviderConfigResolver_EnvironmentOverProfile(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile(t, dir, defaultProfile, "region: br-ne1\nenv: prod\n", "api_key: "+testProfileApiKey+"\n")
+
+	r := providerConfigResolver{
+		lookupEnv: envLookup(map[string]string{
+			envApiKey:        testEnvApiKey,
+			envKeyPairID:     testApiKey,
+			envKeyPairSecret: testApiKey,
+		}),
+		configDir: dir,
+	}
+	model := ProviderModel{}
+
+	diags := r.Resolve(context.Background(), &model)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, testEnvApiKey, model.ApiKey.ValueString())
+	assert.Equal(t, "br-ne1", model.Region.ValueString())
+	assert.Equal(t, testApiKey, model.KeyPairID.ValueString())
+	assert.Equal(t, defaultProfile, model.Profile.ValueString())
+}
+
+func TestProviderConfigResolver_CurrentProfile(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile(t, dir, "work", "region: br-mgl1\n", "api_key: "+testProfileApiKey+"\n"+
+		"access_key_id: "+testApiKey+"\nsecret_access_key: "+testEnvApiKey+"\n")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, cliCurrentFile), []byte("work\n"), 0o600))
+
+	r := providerConfigResolver{lookupEnv: envLookup(nil), configDir: dir}
+	model := ProviderModel{}
+
+	diags := r.Resolve(context.Background(), &model)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "work", model.Profile.ValueString())
+	assert.Equal(t, testProfileApiKey, model.ApiKey.ValueString())
+	assert.Equal(t, "br-mgl1", model.Region.ValueString())
+	assert.Equal(t, testApiKey, model.KeyPairID.ValueString())
+	assert.Equal(t, testEnvApiKey, model.KeyPairSecret.ValueString())
+}
+
+func TestProviderConfigResolver_ProfileNameFromDirectory(t *testing.T) {
+	dir := t.TempDir()
+	writeProfile(t, dir, "work", "name: personal\nregion: br-mgl1\n", "api_key: "+testProfileApiKey+"\n")
+
+	r := providerConfigResolver{lookupEnv: envLookup(nil), configDir: dir}
+	profile, err := r.loadProfile("work", true)
+	require.NoError(t, err)
+	assert.Equal(t, "work", profile.Name)
+	assert.Equal(t, "br-mgl1", profile.Region)
+}
+
+func TestProviderConfigResolver_MissingExplicitProfile(t *testing.T) {
+	r := providerConfigResolver{lookupEnv: envLookup(map[string]string{envProfile: "missing"}), configDir: t.TempDir()}
+	model := ProviderModel{ApiKey: types.StringValue(testApiKey)}
+
+	diags := r.Resolve(context.Background(), &model)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid mgc CLI profile", diags[0].Summary())
+}
+
+func TestProviderConfigResolver_MissingApiKey(t *testing.T) {
+	r := providerConfigResolver{lookupEnv: envLookup(nil), configDir: t.TempDir()}
+	model := ProviderModel{}
+
+	diags := r.Resolve(context.Background(), &model)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Missing API Key", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), envApiKey)
+}
+
+func TestProviderConfigResolver_InvalidValuesFromEnvironment(t *testing.T) {
+	r := providerConfigResolver{
+		lookupEnv: envLookup(map[string]string{
+			envApiKey:    "not-a-key",
+			envRegion:    "us-east-1",
+			envKeyPairID: testApiKey,
+		}),
+		configDir: t.TempDir(),
+	}
+	model := ProviderModel{}
+
+	diags := r.Resolve(context.Background(), &model)
+	summaries := []string{}
+	for _, d := range diags.Errors() {
+		summaries = append(summaries, d.Summary())
+	}
+	assert.ElementsMatch(t, []string{"Invalid API Key", "Invalid region", "Incomplete key pair"}, summaries)
+}
+
+func TestProviderConfigResolver_ApiEndpointFromEnvironment(t *testing.T) {
+	r := providerConfigResolver{
+		lookupEnv: envLookup(map[string]string{envApiKey: testEnvApiKey, envApiEndpoint: "http://127.0.0.1:8080"}),
+		configDir: t.TempDir(),
+	}
+	model := ProviderModel{}
+
+	diags := r.Resolve(context.Background(), &model)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "http://127.0.0.1:8080", model.ApiEndpoint.ValueString())
+
+	r.lookupEnv = envLookup(map[string]string{envApiKey: testEnvApiKey, envApiEndpoint: "127.0.0.1:8080"})
+	model = ProviderModel{}
+
+	diags = r.Resolve(context.Background(), &model)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid API endpoint", diags[0].Summary())
+}
+
+func TestProviderConfigResolver_ApiEndpointAcceptsAnyRegion(t *testing.T) {
+	r := providerConfigResolver{lookupEnv: envLookup(nil), configDir: t.TempDir()}
+	model := ProviderModel{
+		ApiKey: types.StringValue(testApiKey),
+		Env:    types.StringValue("local"),
+		Region: types.StringValue("mock-1"),
+	}
+
+	diags := r.Resolve(context.Background(), &model)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid environment", diags[0].Summary())
+	assert.Equal(t, "Invalid region", diags[1].Summary())
+
+	model = ProviderModel{
+		ApiKey:      types.StringValue(testApiKey),
+		Env:         types.StringValue("local"),
+		Region:      types.StringValue("mock-1"),
+		ApiEndpoint: types.StringValue("http://127.0.0.1:8080"),
+	}
+	diags = r.Resolve(context.Background(), &model)
+	require.False(t, diags.HasError(), diags)
+
+	config, diags := NewConfigData(context.Background(), model, "test")
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "mock-1", config.Region)
+}
+
+func TestNewConfigData_RejectsUnknownRegionForEnv(t *testing.T) {
+	model := ProviderModel{
+		ApiKey: types.StringValue(testApiKey),
+		Env:    types.StringValue("prod"),
+		Region: types.StringValue("br-mc1"),
+	}
+
+	_, diags := NewConfigData(context.Background(), model, "test")
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid region", diags[0].Summary())
+
+	model.ApiEndpoint = types.StringValue("http://127.0.0.1:8080")
+	config, diags := NewConfigData(context.Background(), model, "test")
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "http://127.0.0.1:8080", config.CoreConfig.GetConfig().BaseURL.String())
+}
+
+func TestProviderConfigResolver_HttpDebugFromEnvironment(t *testing.T) {
+	r := providerConfigResolver{
+		lookupEnv: envLookup(map[string]string{envApiKey: testEnvApiKey, envHttpDebug: "1"}),
+		configDir: t.TempDir(),
+	}
+	model := ProviderModel{}
+
+	diags := r.Resolve(context.Background(), &model)
+	require.False(t, diags.HasError(), diags)
+	assert.True(t, model.HttpDebug.ValueBool())
+
+	model = ProviderModel{HttpDebug: types.BoolValue(false)}
+	diags = r.Resolve(context.Background(), &model)
+	require.False(t, diags.HasError(), diags)
+	assert.False(t, model.HttpDebug.ValueBool())
+
+	r.lookupEnv = envLookup(map[string]string{envApiKey: testEnvApiKey, envHttpDebug: "verbose"})
+	model = ProviderModel{}
+	diags = r.Resolve(context.Background(), &model)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid HTTP debug setting", diags[0].Summary())
+}
+
+func TestProviderConfigResolver_HttpCassettesConflict(t *testing.T) {
+	r := providerConfigResolver{
+		lookupEnv: envLookup(map[string]string{envApiKey: testEnvApiKey, envHttpReplay: "replay.json"}),
+		configDir: t.TempDir(),
+	}
+	model := ProviderModel{}
+
+	diags := r.Resolve(context.Background(), &model)
+	require.False(t, diags.HasError(), diags)
+	assert.Equal(t, "replay.json", model.HttpReplay.ValueString())
+
+	model = ProviderModel{HttpRecord: types.StringValue("record.json")}
+	diags = r.Resolve(context.Background(), &model)
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Conflicting HTTP cassettes", diags[0].Summary())
+	assert.Contains(t, diags[0].Detail(), "environment variable "+envHttpReplay)
+}
@@ -0,0 +1,47 @@
+package mgc
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// The type names are constants of each package, also used to name the
+// tracing spans, so they must keep the provider prefix.
+func TestProvider_TypeNamesHaveProviderPrefix(t *testing.T) {
+	ctx := context.Background()
+	p := &mgcProvider{}
+	prefix := providerTypeName + "_"
+
+	for _, f := range p.Resources(ctx) {
+		var resp resource.MetadataResponse
+		f().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+		assert.True(t, strings.HasPrefix(resp.TypeName, prefix), resp.TypeName)
+	}
+	for _, f := range p.DataSources(ctx) {
+		var resp datasource.MetadataResponse
+		f().Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+		assert.True(t, strings.HasPrefix(resp.TypeName, prefix), resp.TypeName)
+	}
+	for _, f := range p.ListResources(ctx) {
+		var resp resource.MetadataResponse
+		f().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+		assert.True(t, strings.HasPrefix(resp.TypeName, prefix), resp.TypeName)
+	}
+	for _, f := range p.EphemeralResources(ctx) {
+		var resp ephemeral.MetadataResponse
+		f().Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+		assert.True(t, strings.HasPrefix(resp.TypeName, prefix), resp.TypeName)
+	}
+	for _, f := range p.Actions(ctx) {
+		var resp action.MetadataResponse
+		f().Metadata(ctx, action.MetadataRequest{ProviderTypeName: providerTypeName}, &resp)
+		assert.True(t, strings.HasPrefix(resp.TypeName, prefix), resp.TypeName)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	sshSDK "github.com/MagaluCloud/mgc-sdk-go/sshkeys"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -31,8 +32,8 @@ func NewDataSourceSSH() datasource.DataSource {
 	return &DataSourceSSH{}
 }
 
-func (r *DataSourceSSH) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_ssh_keys"
+func (r *DataSourceSSH) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = sshKeysTypeName
 }
 
 func (r *DataSourceSSH) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -79,13 +80,15 @@ func (r *DataSourceSSH) Schema(_ context.Context, req datasource.SchemaRequest,
 }
 
 func (r *DataSourceSSH) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, sshKeysTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data SshKeysModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	sdkOutput, err := r.sshKeys.List(ctx, sshSDK.ListOptions{ /*TODO: Add options*/ })
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
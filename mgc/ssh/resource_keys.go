@@ -5,8 +5,8 @@ import (
 
 	sdkSSHKeys "github.com/MagaluCloud/mgc-sdk-go/sshkeys"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -14,9 +14,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const sshKeysTypeName = "mgc_ssh_keys"
+
 var (
 	_ resource.Resource              = &sshKeys{}
 	_ resource.ResourceWithConfigure = &sshKeys{}
+	_ resource.ResourceWithIdentity  = &sshKeys{}
+)
+
+var sshKeyIdentity = utils.NewResourceIdentity(
+	utils.IDAttribute("ID of the SSH key."),
 )
 
 func NewSshKeysResource() resource.Resource {
@@ -27,8 +34,8 @@ type sshKeys struct {
 	sshKeys sdkSSHKeys.KeyService
 }
 
-func (r *sshKeys) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_ssh_keys"
+func (r *sshKeys) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = sshKeysTypeName
 }
 
 func (r *sshKeys) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -80,12 +87,19 @@ func (r *sshKeys) Schema(_ context.Context, req resource.SchemaRequest, resp *re
 }
 
 func (r *sshKeys) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, sshKeysTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer sshKeyIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	plan := &sshKeyModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &plan)...)
 
 	result, err := r.sshKeys.Get(ctx, plan.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -98,6 +112,9 @@ func (r *sshKeys) Read(ctx context.Context, req resource.ReadRequest, resp *reso
 }
 
 func (r *sshKeys) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, sshKeysTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer sshKeyIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	plan := &sshKeyModel{}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	if resp.Diagnostics.HasError() {
@@ -109,7 +126,7 @@ func (r *sshKeys) Create(ctx context.Context, req resource.CreateRequest, resp *
 		Key:  plan.Key.ValueString(),
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	plan.ID = types.StringValue(createResult.ID)
@@ -117,18 +134,27 @@ func (r *sshKeys) Create(ctx context.Context, req resource.CreateRequest, resp *
 }
 
 func (r *sshKeys) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, sshKeysTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer sshKeyIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("This resource does not support update", "The resource does not support update operations.")
 }
 
 func (r *sshKeys) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, sshKeysTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data sshKeyModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	_, err := r.sshKeys.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 	}
 }
 
+func (r *sshKeys) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = sshKeyIdentity.Schema()
+}
+
 func (r *sshKeys) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	sshKeyIdentity.ImportState(ctx, req, resp)
 }
@@ -0,0 +1,240 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	summaryQuotaExceeded  = "Quota exceeded"
+	summaryNoCapacity     = "Insufficient capacity"
+	summaryAuthentication = "Authentication failed"
+	summaryPermission     = "Permission denied"
+	summaryAlreadyExists  = "Resource already exists"
+	summaryConflict       = "Resource conflict"
+	summaryInvalidName    = "Invalid name"
+	summaryInvalidRequest = "Invalid request"
+)
+
+// apiError is an error response body decoded into the parts users act on.
+// The products do not share one error format, so the decoding accepts the
+// fields each of them uses.
+type apiError struct {
+	StatusCode int
+	Code       string
+	Message    string
+	Fields     []fieldError
+}
+
+// fieldError is an error the API reported for one request field.
+type fieldError struct {
+	Field   string
+	Message string
+}
+
+var (
+	codeKeys    = []string{"code", "slug", "error_code", "type"}
+	messageKeys = []string{"message", "detail", "msg", "error_description", "error"}
+	fieldsKeys  = []string{"details", "detail", "errors", "fields"}
+
+	fieldNameKeys    = []string{"field", "loc", "name", "path", "attribute"}
+	fieldMessageKeys = []string{"message", "msg", "reason", "detail", "error"}
+
+	// Prefixes FastAPI and similar frameworks put before the field name.
+	fieldLocations = map[string]bool{"body": true, "query": true, "path": true}
+
+	invalidNamePattern = regexp.MustCompile(`\binvalid[ _]name\b|\bname\b.*\b(invalid|must|not allowed|too long|too short)\b`)
+	attributeName      = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+	camelBoundary      = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+)
+
+// parseAPIError decodes a JSON error body. It reports false when the body is
+// not JSON or has none of the known fields, so the raw body is shown instead.
+func parseAPIError(statusCode int, body []byte) (apiError, bool) {
+	var raw map[string]any
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return apiError{}, false
+	}
+
+	e := apiError{
+		StatusCode: statusCode,
+		Code:       firstString(raw, codeKeys),
+		Message:    firstString(raw, messageKeys),
+	}
+	for _, key := range fieldsKeys {
+		if fields := fieldErrors(raw[key]); len(fields) > 0 {
+			e.Fields = fields
+			break
+		}
+	}
+
+	if e.Message == "" && len(e.Fields) == 0 {
+		return apiError{}, false
+	}
+	return e, true
+}
+
+// firstString returns the first non-empty string value among keys.
+func firstString(raw map[string]any, keys []string) string {
+	for _, key := range keys {
+		if s, ok := raw[key].(string); ok && strings.TrimSpace(s) != "" {
+			return strings.TrimSpace(s)
+		}
+	}
+	return ""
+}
+
+// fieldErrors accepts a list of objects naming a field and a message, as in
+// {"loc": ["body", "name"], "msg": "..."}, or an object mapping field names
+// to one or more messages.
+func fieldErrors(value any) []fieldError {
+	var fields []fieldError
+	switch v := value.(type) {
+	case []any:
+		for _, item := range v {
+			obj, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			field := fieldError{Message: firstString(obj, fieldMessageKeys)}
+			for _, key := range fieldNameKeys {
+				if field.Field = fieldName(obj[key]); field.Field != "" {
+					break
+				}
+			}
+			if field.Field != "" || field.Message != "" {
+				fields = append(fields, field)
+			}
+		}
+	case map[string]any:
+		for name, messages := range v {
+			switch m := messages.(type) {
+			case string:
+				fields = append(fields, fieldError{Field: name, Message: m})
+			case []any:
+				for _, message := range m {
+					if s, ok := message.(string); ok {
+						fields = append(fields, fieldError{Field: name, Message: s})
+					}
+				}
+			}
+		}
+		// Map iteration order is random, keep the detail stable.
+		slices.SortStableFunc(fields, func(a, b fieldError) int { return strings.Compare(a.Field, b.Field) })
+	}
+	return fields
+}
+
+func fieldName(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case []any:
+		var parts []string
+		for _, part := range v {
+			s := fmt.Sprint(part)
+			if len(parts) == 0 && fieldLocations[s] {
+				continue
+			}
+			parts = append(parts, s)
+		}
+		return strings.Join(parts, ".")
+	}
+	return ""
+}
+
+// classify maps the error to a summary and what the user can do about it.
+func (e apiError) classify() (summary, hint string) {
+	text := strings.ToLower(e.Code + " " + e.Message)
+	for _, f := range e.Fields {
+		text += " " + strings.ToLower(f.Field+" "+f.Message)
+	}
+
+	switch {
+	case strings.Contains(text, "quota"):
+		return summaryQuotaExceeded, "The tenant reached a quota limit in this region. Free unused resources, " +
+			"or request a quota increase from Magalu Cloud support, then apply again."
+	case strings.Contains(text, "capacity") || strings.Contains(text, "no valid host"):
+		return summaryNoCapacity, "The region has no capacity left for this request right now. " +
+			"Try another availability zone or machine type, or apply again later."
+	case e.StatusCode == http.StatusUnauthorized:
+		return summaryAuthentication, "Check that api_key, or the MGC_API_KEY environment variable, " +
+			"holds a valid API key that has not expired or been revoked."
+	case e.StatusCode == http.StatusForbidden:
+		return summaryPermission, "The API key is not allowed to perform this operation. " +
+			"Check the scopes it was created with and the tenant it belongs to."
+	case strings.Contains(text, "already exists") || strings.Contains(text, "already_exists"):
+		return summaryAlreadyExists, "Choose another name, or bring the existing object under " +
+			"Terraform management with an import block or terraform import."
+	case e.StatusCode == http.StatusConflict:
+		return summaryConflict, "The object is in a state that does not allow this operation, often " +
+			"because another operation on it is still running. Apply again once it finishes."
+	case e.nameField() || invalidNamePattern.MatchString(text):
+		return summaryInvalidName, "Check the characters and length allowed in names of this resource type."
+	case len(e.Fields) > 0:
+		return summaryInvalidRequest, ""
+	}
+	return "", ""
+}
+
+func (e apiError) nameField() bool {
+	for _, f := range e.Fields {
+		if p, ok := attributePath(f.Field); ok && p.Equal(path.Root("name")) {
+			return true
+		}
+	}
+	return false
+}
+
+// detail lists the API message, the field errors and the remediation hint.
+func (e apiError) detail() string {
+	var lines []string
+	if e.Message != "" {
+		message := e.Message
+		if e.Code != "" {
+			message = fmt.Sprintf("%s (code: %s)", message, e.Code)
+		}
+		lines = append(lines, message)
+	}
+	for _, f := range e.Fields {
+		switch {
+		case f.Field == "":
+			lines = append(lines, "  - "+f.Message)
+		case f.Message == "":
+			lines = append(lines, "  - "+f.Field)
+		default:
+			lines = append(lines, fmt.Sprintf("  - %s: %s", f.Field, f.Message))
+		}
+	}
+	if _, hint := e.classify(); hint != "" {
+		lines = append(lines, "", hint)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// attributePath returns the attribute the error is about when the API
+// reported exactly one field. Only the top level attribute is used: nested
+// request fields rarely match the schema below it, and Terraform points at
+// the resource block when the attribute is not in the configuration.
+func (e apiError) attributePath() (path.Path, bool) {
+	if len(e.Fields) != 1 {
+		return path.Empty(), false
+	}
+	return attributePath(e.Fields[0].Field)
+}
+
+func attributePath(field string) (path.Path, bool) {
+	root, _, _ := strings.Cut(field, ".")
+	root, _, _ = strings.Cut(root, "[")
+	root = strings.ToLower(camelBoundary.ReplaceAllString(root, "${1}_${2}"))
+	if !attributeName.MatchString(root) {
+		return path.Empty(), false
+	}
+	return path.Root(root), true
+}
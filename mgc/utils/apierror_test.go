@@ -0,0 +1,156 @@
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+
+	clientSDK "github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAPIError(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want apiError
+		ok   bool
+	}{
+		{
+			name: "message and slug",
+			body: `{"error":"Forbidden","message":"Quota exceeded for vcpu: 32 of 32 in use","slug":"quota_exceeded"}`,
+			want: apiError{Code: "quota_exceeded", Message: "Quota exceeded for vcpu: 32 of 32 in use"},
+			ok:   true,
+		},
+		{
+			name: "error only",
+			body: `{"error":"not found"}`,
+			want: apiError{Message: "not found"},
+			ok:   true,
+		},
+		{
+			name: "validation list",
+			body: `{"detail":[{"loc":["body","name"],"msg":"string does not match regex","type":"value_error"}]}`,
+			want: apiError{Fields: []fieldError{{Field: "name", Message: "string does not match regex"}}},
+			ok:   true,
+		},
+		{
+			name: "details with fields",
+			body: `{"code":"INVALID_ARGUMENT","message":"invalid request","details":[{"field":"volume.size","message":"must be at least 10"}]}`,
+			want: apiError{
+				Code:    "INVALID_ARGUMENT",
+				Message: "invalid request",
+				Fields:  []fieldError{{Field: "volume.size", Message: "must be at least 10"}},
+			},
+			ok: true,
+		},
+		{
+			name: "field map",
+			body: `{"message":"validation failed","fields":{"ttl":"too short","name":["required"]}}`,
+			want: apiError{
+				Message: "validation failed",
+				Fields:  []fieldError{{Field: "name", Message: "required"}, {Field: "ttl", Message: "too short"}},
+			},
+			ok: true,
+		},
+		{name: "not json", body: `server error`},
+		{name: "unknown fields", body: `{"status":500}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseAPIError(0, []byte(tt.body))
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAPIErrorClassify(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		body   string
+		want   string
+	}{
+		{"quota", http.StatusForbidden, `{"message":"Quota exceeded for vcpu"}`, summaryQuotaExceeded},
+		{"quota slug", http.StatusUnprocessableEntity, `{"message":"cannot create instance","slug":"quota_exceeded_ram"}`, summaryQuotaExceeded},
+		{"capacity", http.StatusBadRequest, `{"message":"Not enough capacity for machine type BV8-32-100"}`, summaryNoCapacity},
+		{"unauthorized", http.StatusUnauthorized, `{"message":"invalid api key"}`, summaryAuthentication},
+		{"forbidden", http.StatusForbidden, `{"message":"access denied"}`, summaryPermission},
+		{"already exists", http.StatusConflict, `{"message":"vpc acc-vpc already exists"}`, summaryAlreadyExists},
+		{"conflict", http.StatusConflict, `{"message":"instance is being resized"}`, summaryConflict},
+		{"invalid name field", http.StatusUnprocessableEntity, `{"detail":[{"loc":["body","name"],"msg":"string does not match regex"}]}`, summaryInvalidName},
+		{"invalid name message", http.StatusBadRequest, `{"message":"name must start with a letter"}`, summaryInvalidName},
+		{"other field", http.StatusBadRequest, `{"errors":[{"field":"size","message":"must be at least 10"}]}`, summaryInvalidRequest},
+		{"unclassified", http.StatusInternalServerError, `{"message":"internal error"}`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, ok := parseAPIError(tt.status, []byte(tt.body))
+			require.True(t, ok)
+			summary, _ := e.classify()
+			assert.Equal(t, tt.want, summary)
+		})
+	}
+}
+
+func TestParseSDKError_HTTPErrorQuota(t *testing.T) {
+	httpErr := &clientSDK.HTTPError{
+		StatusCode: http.StatusForbidden,
+		Status:     "403 Forbidden",
+		Body:       []byte(`{"error":"Forbidden","message":"Quota exceeded for vcpu","slug":"quota_exceeded"}`),
+	}
+
+	msg, detail := ParseSDKError(httpErr)
+	assert.Equal(t, summaryQuotaExceeded, msg)
+	assert.Equal(t, "Quota exceeded for vcpu (code: quota_exceeded)\n\n"+
+		"The tenant reached a quota limit in this region. Free unused resources, "+
+		"or request a quota increase from Magalu Cloud support, then apply again.\n\n"+
+		"HTTP Error:\n  Status: 403 Forbidden\n"+
+		"  Body: {\"error\":\"Forbidden\",\"message\":\"Quota exceeded for vcpu\",\"slug\":\"quota_exceeded\"}\n"+
+		"  URL: \n  Request ID: \n  MGC Trace ID: ", detail)
+}
+
+func TestSDKErrorDiagnostic(t *testing.T) {
+	t.Run("single field", func(t *testing.T) {
+		d := SDKErrorDiagnostic(&clientSDK.HTTPError{
+			StatusCode: http.StatusUnprocessableEntity,
+			Body:       []byte(`{"detail":[{"loc":["body","machineType","name"],"msg":"unknown machine type"}]}`),
+		})
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		require.True(t, ok)
+		assert.Equal(t, path.Root("machine_type"), withPath.Path())
+		assert.Equal(t, summaryInvalidRequest, d.Summary())
+		assert.Contains(t, d.Detail(), "  - machineType.name: unknown machine type")
+	})
+
+	t.Run("several fields", func(t *testing.T) {
+		d := SDKErrorDiagnostic(&clientSDK.HTTPError{
+			StatusCode: http.StatusBadRequest,
+			Body:       []byte(`{"errors":[{"field":"name","message":"required"},{"field":"size","message":"required"}]}`),
+		})
+		_, ok := d.(diag.DiagnosticWithPath)
+		assert.False(t, ok)
+		assert.Equal(t, diag.SeverityError, d.Severity())
+	})
+
+	t.Run("validation error", func(t *testing.T) {
+		d := SDKErrorDiagnostic(&clientSDK.ValidationError{Field: "name", Message: "required"})
+		withPath, ok := d.(diag.DiagnosticWithPath)
+		require.True(t, ok)
+		assert.Equal(t, path.Root("name"), withPath.Path())
+		assert.Equal(t, simpleValidationError, d.Summary())
+	})
+
+	t.Run("not an API error", func(t *testing.T) {
+		d := SDKErrorDiagnostic(errors.New("connection refused"))
+		_, ok := d.(diag.DiagnosticWithPath)
+		assert.False(t, ok)
+		assert.Equal(t, simpleGenericError, d.Summary())
+	})
+}
@@ -0,0 +1,106 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// CatalogTTL is how long a catalog read from the API is reused. Catalogs such
+// as machine types or database engines rarely change during a terraform run.
+const CatalogTTL = 5 * time.Minute
+
+// CatalogCache keeps the read-mostly catalogs of the API, such as machine
+// types, images and database engines, for every resource, data source and
+// action of a provider instance, so that a plan reads each one once per region
+// instead of once per object.
+type CatalogCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[catalogKey]*catalogEntry
+}
+
+type catalogKey struct {
+	region string
+	key    string
+}
+
+// catalogEntry is locked while its value is fetched, so concurrent readers of
+// a missing entry wait for a single request.
+type catalogEntry struct {
+	mu        sync.Mutex
+	value     any
+	expiresAt time.Time
+}
+
+func NewCatalogCache(ttl time.Duration) *CatalogCache {
+	return &CatalogCache{
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[catalogKey]*catalogEntry{},
+	}
+}
+
+func (c *CatalogCache) entry(region, key string) *catalogEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	k := catalogKey{region: region, key: key}
+	e, ok := c.entries[k]
+	if !ok {
+		e = &catalogEntry{}
+		c.entries[k] = e
+	}
+	return e
+}
+
+// Catalog is the view of the provider catalog cache for the region of a
+// DataConfig.
+type Catalog struct {
+	cache  *CatalogCache
+	region string
+}
+
+// Catalog returns the catalog cache of the config region. Configs without a
+// cache, such as in unit tests, read every catalog from the API.
+func (c DataConfig) Catalog() Catalog {
+	return Catalog{cache: c.CatalogCache, region: c.Region}
+}
+
+// FromCatalog returns the entry key of catalog, calling fetch when it is
+// missing or expired. Errors are not cached. The value is shared by every
+// caller and must not be modified.
+func FromCatalog[T any](ctx context.Context, catalog Catalog, key string, fetch func(context.Context) (T, error)) (T, error) {
+	if catalog.cache == nil {
+		return fetch(ctx)
+	}
+
+	e := catalog.cache.entry(catalog.region, key)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if value, ok := e.value.(T); ok && catalog.cache.now().Before(e.expiresAt) {
+		return value, nil
+	}
+
+	value, err := fetch(ctx)
+	if err != nil {
+		return value, err
+	}
+	e.value = value
+	e.expiresAt = catalog.cache.now().Add(catalog.cache.ttl)
+	return value, nil
+}
+
+// CatalogKey is the key of the catalog name listed with options, as the API
+// filters it by them.
+func CatalogKey(name string, options any) string {
+	encoded, err := json.Marshal(options)
+	if err != nil {
+		return name
+	}
+	return name + string(encoded)
+}
@@ -0,0 +1,124 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testCatalog(region string, cache *CatalogCache) Catalog {
+	return DataConfig{Region: region, CatalogCache: cache}.Catalog()
+}
+
+func countingFetch(calls *atomic.Int32, values ...string) func(context.Context) ([]string, error) {
+	return func(context.Context) ([]string, error) {
+		calls.Add(1)
+		return values, nil
+	}
+}
+
+func TestFromCatalog(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCatalogCache(time.Minute)
+	now := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	cache.now = func() time.Time { return now }
+	catalog := testCatalog("br-se1", cache)
+
+	var calls atomic.Int32
+	values, err := FromCatalog(ctx, catalog, "engines", countingFetch(&calls, "mysql"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mysql"}, values)
+
+	values, err = FromCatalog(ctx, catalog, "engines", countingFetch(&calls, "postgresql"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mysql"}, values)
+	assert.EqualValues(t, 1, calls.Load())
+
+	now = now.Add(time.Minute)
+	values, err = FromCatalog(ctx, catalog, "engines", countingFetch(&calls, "postgresql"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"postgresql"}, values)
+	assert.EqualValues(t, 2, calls.Load())
+}
+
+func TestFromCatalog_Keys(t *testing.T) {
+	ctx := context.Background()
+	cache := NewCatalogCache(time.Minute)
+
+	var calls atomic.Int32
+	_, err := FromCatalog(ctx, testCatalog("br-se1", cache), "engines", countingFetch(&calls))
+	require.NoError(t, err)
+	_, err = FromCatalog(ctx, testCatalog("br-ne1", cache), "engines", countingFetch(&calls))
+	require.NoError(t, err)
+	_, err = FromCatalog(ctx, testCatalog("br-se1", cache), "instance-types", countingFetch(&calls))
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, calls.Load())
+}
+
+func TestFromCatalog_Errors(t *testing.T) {
+	ctx := context.Background()
+	catalog := testCatalog("br-se1", NewCatalogCache(time.Minute))
+
+	_, err := FromCatalog(ctx, catalog, "engines", func(context.Context) ([]string, error) {
+		return nil, errors.New("unavailable")
+	})
+	assert.EqualError(t, err, "unavailable")
+
+	values, err := FromCatalog(ctx, catalog, "engines", func(context.Context) ([]string, error) {
+		return []string{"mysql"}, nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mysql"}, values)
+}
+
+func TestFromCatalog_Concurrent(t *testing.T) {
+	catalog := testCatalog("br-se1", NewCatalogCache(time.Minute))
+
+	var calls atomic.Int32
+	fetch := func(context.Context) ([]string, error) {
+		calls.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return []string{"mysql"}, nil
+	}
+
+	var wg sync.WaitGroup
+	for range 50 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			values, err := FromCatalog(context.Background(), catalog, "engines", fetch)
+			assert.NoError(t, err)
+			assert.Equal(t, []string{"mysql"}, values)
+		}()
+	}
+	wg.Wait()
+	assert.EqualValues(t, 1, calls.Load())
+}
+
+func TestFromCatalog_WithoutCache(t *testing.T) {
+	var calls atomic.Int32
+	catalog := DataConfig{Region: "br-se1"}.Catalog()
+	for range 2 {
+		_, err := FromCatalog(context.Background(), catalog, "engines", countingFetch(&calls))
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 2, calls.Load())
+}
+
+func TestCatalogKey(t *testing.T) {
+	active, engineID := "ACTIVE", "engine-id"
+	type filter struct {
+		Status   *string `json:"status,omitempty"`
+		EngineID *string `json:"engine_id,omitempty"`
+	}
+
+	assert.Equal(t, "dbaas/instance-types{}", CatalogKey("dbaas/instance-types", filter{}))
+	assert.Equal(t, `dbaas/instance-types{"status":"ACTIVE","engine_id":"engine-id"}`,
+		CatalogKey("dbaas/instance-types", filter{Status: &active, EngineID: &engineID}))
+}
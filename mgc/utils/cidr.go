@@ -0,0 +1,79 @@
+package utils
+
+import (
+	"fmt"
+	"math/big"
+	"net/netip"
+)
+
+// AllocateCidrs carves one block per prefix length out of pool, in order.
+// Each block is the lowest one overlapping neither allocated nor the blocks
+// carved before it, so the result only changes when a block it returned is
+// taken by something else.
+func AllocateCidrs(pool netip.Prefix, allocated []netip.Prefix, prefixLengths []int) ([]netip.Prefix, error) {
+	pool = pool.Masked()
+	taken := make([]netip.Prefix, 0, len(allocated)+len(prefixLengths))
+	for _, p := range allocated {
+		taken = append(taken, p.Masked())
+	}
+
+	blocks := make([]netip.Prefix, 0, len(prefixLengths))
+	for _, bits := range prefixLengths {
+		if bits < pool.Bits() || bits > pool.Addr().BitLen() {
+			return nil, fmt.Errorf("a /%d block does not fit in %s", bits, pool)
+		}
+		block, ok := lowestFreeBlock(pool, taken, bits)
+		if !ok {
+			return nil, fmt.Errorf("no free /%d block left in %s", bits, pool)
+		}
+		taken = append(taken, block)
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
+
+// lowestFreeBlock tries aligned candidates from the start of pool, jumping
+// past the taken blocks that overlap each one, so it runs in as many steps as
+// there are taken blocks, not as there are candidates.
+func lowestFreeBlock(pool netip.Prefix, taken []netip.Prefix, bits int) (netip.Prefix, bool) {
+	bitLen := pool.Addr().BitLen()
+	size := blockSize(bitLen, bits)
+	end := new(big.Int).Add(addrToInt(pool.Addr()), blockSize(bitLen, pool.Bits()))
+
+	candidate := addrToInt(pool.Addr())
+	for new(big.Int).Add(candidate, size).Cmp(end) <= 0 {
+		block := netip.PrefixFrom(intToAddr(candidate, bitLen), bits)
+
+		next := candidate
+		for _, t := range taken {
+			if !t.Overlaps(block) {
+				continue
+			}
+			if tEnd := new(big.Int).Add(addrToInt(t.Addr()), blockSize(bitLen, t.Bits())); tEnd.Cmp(next) > 0 {
+				next = tEnd
+			}
+		}
+		if next == candidate {
+			return block, true
+		}
+
+		// Round up to the next block boundary.
+		candidate = new(big.Int).Add(next, new(big.Int).Sub(size, big.NewInt(1)))
+		candidate.Div(candidate, size).Mul(candidate, size)
+	}
+	return netip.Prefix{}, false
+}
+
+func blockSize(bitLen, bits int) *big.Int {
+	return new(big.Int).Lsh(big.NewInt(1), uint(bitLen-bits))
+}
+
+func addrToInt(addr netip.Addr) *big.Int {
+	return new(big.Int).SetBytes(addr.AsSlice())
+}
+
+func intToAddr(i *big.Int, bitLen int) netip.Addr {
+	b := i.FillBytes(make([]byte, bitLen/8))
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
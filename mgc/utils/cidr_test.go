@@ -0,0 +1,101 @@
+package utils
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func prefixes(t *testing.T, values ...string) []netip.Prefix {
+	t.Helper()
+	out := make([]netip.Prefix, 0, len(values))
+	for _, v := range values {
+		out = append(out, netip.MustParsePrefix(v))
+	}
+	return out
+}
+
+func TestAllocateCidrs(t *testing.T) {
+	tests := []struct {
+		name      string
+		pool      string
+		allocated []string
+		lengths   []int
+		want      []string
+		wantErr   string
+	}{
+		{
+			name:    "empty pool",
+			pool:    "172.26.0.0/16",
+			lengths: []int{24, 24, 26},
+			want:    []string{"172.26.0.0/24", "172.26.1.0/24", "172.26.2.0/26"},
+		},
+		{
+			name:      "skips allocated blocks",
+			pool:      "172.26.0.0/16",
+			allocated: []string{"172.26.0.0/24", "172.26.1.128/25"},
+			lengths:   []int{25, 24},
+			want:      []string{"172.26.1.0/25", "172.26.2.0/24"},
+		},
+		{
+			name:      "fills gaps before larger blocks",
+			pool:      "10.0.0.0/24",
+			allocated: []string{"10.0.0.64/26"},
+			lengths:   []int{26, 25},
+			want:      []string{"10.0.0.0/26", "10.0.0.128/25"},
+		},
+		{
+			name:      "pool exhausted",
+			pool:      "10.0.0.0/24",
+			allocated: []string{"10.0.0.64/26"},
+			lengths:   []int{26, 25, 26},
+			wantErr:   "no free /26 block left in 10.0.0.0/24",
+		},
+		{
+			name:      "ignores blocks outside the pool",
+			pool:      "10.0.0.0/24",
+			allocated: []string{"192.168.0.0/24", "fd00::/64"},
+			lengths:   []int{24},
+			want:      []string{"10.0.0.0/24"},
+		},
+		{
+			name:    "unmasked pool",
+			pool:    "10.0.0.17/28",
+			lengths: []int{29, 29},
+			want:    []string{"10.0.0.16/29", "10.0.0.24/29"},
+		},
+		{
+			name:      "ipv6",
+			pool:      "fd00:10::/48",
+			allocated: []string{"fd00:10::/64", "fd00:10:0:2::/63"},
+			lengths:   []int{64, 64, 64},
+			want:      []string{"fd00:10:0:1::/64", "fd00:10:0:4::/64", "fd00:10:0:5::/64"},
+		},
+		{
+			name:    "larger than the pool",
+			pool:    "10.0.0.0/24",
+			lengths: []int{16},
+			wantErr: "a /16 block does not fit in 10.0.0.0/24",
+		},
+		{
+			name:    "longer than an address",
+			pool:    "10.0.0.0/24",
+			lengths: []int{33},
+			wantErr: "a /33 block does not fit in 10.0.0.0/24",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := AllocateCidrs(netip.MustParsePrefix(tt.pool), prefixes(t, tt.allocated...), tt.lengths)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, prefixes(t, tt.want...), got)
+		})
+	}
+}
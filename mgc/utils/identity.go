@@ -0,0 +1,158 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// IdentityAttribute is a string attribute of a resource identity.
+type IdentityAttribute struct {
+	Name        string
+	Description string
+	// StateAttribute is the state attribute holding the value, Name when empty.
+	StateAttribute string
+	// Optional attributes can be left out of import blocks.
+	Optional bool
+}
+
+func (a IdentityAttribute) statePath() path.Path {
+	if a.StateAttribute != "" {
+		return path.Root(a.StateAttribute)
+	}
+	return path.Root(a.Name)
+}
+
+// ResourceIdentity lists the attributes that together locate a resource in
+// the API. Required attributes, in order, are also the comma separated parts
+// of its import ID.
+type ResourceIdentity []IdentityAttribute
+
+// NewResourceIdentity is the identity of a global resource.
+func NewResourceIdentity(attributes ...IdentityAttribute) ResourceIdentity {
+	return attributes
+}
+
+// RegionalIdentity is the identity of a regional resource. Region is optional
+// on import and defaults to the provider region.
+func RegionalIdentity(attributes ...IdentityAttribute) ResourceIdentity {
+	return append(ResourceIdentity{{
+		Name:        "region",
+		Description: "Region where the resource is managed.",
+		Optional:    true,
+	}}, attributes...)
+}
+
+// IDAttribute is the id identity attribute.
+func IDAttribute(description string) IdentityAttribute {
+	return IdentityAttribute{Name: "id", Description: description}
+}
+
+func (i ResourceIdentity) Schema() identityschema.Schema {
+	attributes := map[string]identityschema.Attribute{}
+	for _, a := range i {
+		attributes[a.Name] = identityschema.StringAttribute{
+			Description:       a.Description,
+			RequiredForImport: !a.Optional,
+			OptionalForImport: a.Optional,
+		}
+	}
+	return identityschema.Schema{Attributes: attributes}
+}
+
+// Set copies the identity attributes from state to identity. It does nothing
+// when the state was removed or Terraform does not support identities. Both
+// are read when Set runs, so it can be deferred at the start of Create, Read
+// and Update:
+//
+//	defer vpcIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+func (i ResourceIdentity) Set(ctx context.Context, state *tfsdk.State, identity *tfsdk.ResourceIdentity, diags *diag.Diagnostics) {
+	if identity == nil || state == nil || state.Raw.IsNull() {
+		return
+	}
+
+	for _, a := range i {
+		var value types.String
+		diags.Append(state.GetAttribute(ctx, a.statePath(), &value)...)
+		if diags.HasError() {
+			return
+		}
+		if value.IsUnknown() {
+			continue
+		}
+		diags.Append(identity.SetAttribute(ctx, path.Root(a.Name), value)...)
+	}
+}
+
+// ImportState sets the identity attributes in state, from the identity of an
+// import block or from the parts of the import ID. Resources that need other
+// attributes set on import do so before calling it.
+func (i ResourceIdentity) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		for _, a := range i {
+			var value types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(a.Name), &value)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			if !value.IsNull() && value.ValueString() != "" {
+				resp.Diagnostics.Append(resp.State.SetAttribute(ctx, a.statePath(), value)...)
+			}
+		}
+		return
+	}
+
+	required := i.required()
+	parts := []string{req.ID}
+	if len(required) > 1 {
+		parts = strings.Split(req.ID, ",")
+	}
+	if len(parts) != len(required) || slices.Contains(parts, "") {
+		resp.Diagnostics.AddError("Invalid import format", fmt.Sprintf("Use `%s`", i.ImportFormat()))
+		return
+	}
+
+	for n, a := range required {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, a.statePath(), parts[n])...)
+	}
+}
+
+// SetCreated writes values, keyed by identity attribute name, to state as an
+// import would. Create calls it when a step after the create request, such as
+// waiting for the object to be ready, fails: the object is then tracked and
+// tainted instead of left behind, and the next apply refreshes and replaces
+// it. Empty values are skipped.
+func (i ResourceIdentity) SetCreated(ctx context.Context, state *tfsdk.State, values map[string]string, diags *diag.Diagnostics) {
+	for _, a := range i {
+		if value := values[a.Name]; value != "" {
+			diags.Append(state.SetAttribute(ctx, a.statePath(), value)...)
+		}
+	}
+}
+
+// ImportFormat describes the import ID, e.g. `vpc_id,route_id`.
+func (i ResourceIdentity) ImportFormat() string {
+	var names []string
+	for _, a := range i.required() {
+		names = append(names, a.Name)
+	}
+	return strings.Join(names, ",")
+}
+
+func (i ResourceIdentity) required() []IdentityAttribute {
+	var required []IdentityAttribute
+	for _, a := range i {
+		if !a.Optional {
+			required = append(required, a)
+		}
+	}
+	return required
+}
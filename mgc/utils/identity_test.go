@@ -0,0 +1,161 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testRouteIdentity = RegionalIdentity(
+	IdentityAttribute{Name: "vpc_id", Description: "ID of the VPC."},
+	IdentityAttribute{Name: "route_id", Description: "ID of the route.", StateAttribute: "id"},
+)
+
+var testRouteSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"region": schema.StringAttribute{Optional: true},
+		"vpc_id": schema.StringAttribute{Required: true},
+		"id":     schema.StringAttribute{Computed: true},
+		"name":   schema.StringAttribute{Optional: true},
+	},
+}
+
+func newTestState(ctx context.Context) tfsdk.State {
+	return tfsdk.State{
+		Schema: testRouteSchema,
+		Raw:    tftypes.NewValue(testRouteSchema.Type().TerraformType(ctx), nil),
+	}
+}
+
+func newTestIdentity(ctx context.Context) *tfsdk.ResourceIdentity {
+	identitySchema := testRouteIdentity.Schema()
+	return &tfsdk.ResourceIdentity{
+		Schema: identitySchema,
+		Raw:    tftypes.NewValue(identitySchema.Type().TerraformType(ctx), nil),
+	}
+}
+
+func TestResourceIdentity_Schema(t *testing.T) {
+	s := testRouteIdentity.Schema()
+
+	require.Len(t, s.Attributes, 3)
+	assert.True(t, s.Attributes["region"].IsOptionalForImport())
+	assert.True(t, s.Attributes["vpc_id"].IsRequiredForImport())
+	assert.True(t, s.Attributes["route_id"].IsRequiredForImport())
+	assert.Equal(t, "vpc_id,route_id", testRouteIdentity.ImportFormat())
+}
+
+func TestResourceIdentity_Set(t *testing.T) {
+	ctx := context.Background()
+
+	state := newTestState(ctx)
+	require.False(t, state.SetAttribute(ctx, path.Root("region"), "br-ne1").HasError())
+	require.False(t, state.SetAttribute(ctx, path.Root("vpc_id"), "vpc").HasError())
+	require.False(t, state.SetAttribute(ctx, path.Root("id"), "route").HasError())
+
+	identity := newTestIdentity(ctx)
+	var diags diag.Diagnostics
+	testRouteIdentity.Set(ctx, &state, identity, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	for name, expected := range map[string]string{"region": "br-ne1", "vpc_id": "vpc", "route_id": "route"} {
+		var value types.String
+		identity.GetAttribute(ctx, path.Root(name), &value)
+		assert.Equal(t, expected, value.ValueString(), name)
+	}
+
+	removed := newTestState(ctx)
+	identity = newTestIdentity(ctx)
+	testRouteIdentity.Set(ctx, &removed, identity, &diags)
+	assert.True(t, identity.Raw.IsNull())
+
+	testRouteIdentity.Set(ctx, &state, nil, &diags)
+	assert.False(t, diags.HasError())
+}
+
+func TestResourceIdentity_ImportState(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("import id", func(t *testing.T) {
+		resp := &resource.ImportStateResponse{State: newTestState(ctx)}
+		testRouteIdentity.ImportState(ctx, resource.ImportStateRequest{ID: "vpc,route"}, resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var vpcID, id, region types.String
+		resp.State.GetAttribute(ctx, path.Root("vpc_id"), &vpcID)
+		resp.State.GetAttribute(ctx, path.Root("id"), &id)
+		resp.State.GetAttribute(ctx, path.Root("region"), &region)
+		assert.Equal(t, "vpc", vpcID.ValueString())
+		assert.Equal(t, "route", id.ValueString())
+		assert.True(t, region.IsNull())
+	})
+
+	for _, id := range []string{"", "vpc", "vpc,", ",route", "vpc,route,extra"} {
+		t.Run("invalid import id "+id, func(t *testing.T) {
+			resp := &resource.ImportStateResponse{State: newTestState(ctx)}
+			testRouteIdentity.ImportState(ctx, resource.ImportStateRequest{ID: id}, resp)
+			require.True(t, resp.Diagnostics.HasError())
+			assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "vpc_id,route_id")
+		})
+	}
+
+	t.Run("import identity", func(t *testing.T) {
+		identity := newTestIdentity(ctx)
+		identity.SetAttribute(ctx, path.Root("region"), "br-ne1")
+		identity.SetAttribute(ctx, path.Root("vpc_id"), "vpc")
+		identity.SetAttribute(ctx, path.Root("route_id"), "route")
+
+		resp := &resource.ImportStateResponse{State: newTestState(ctx)}
+		testRouteIdentity.ImportState(ctx, resource.ImportStateRequest{Identity: identity}, resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var id, region types.String
+		resp.State.GetAttribute(ctx, path.Root("id"), &id)
+		resp.State.GetAttribute(ctx, path.Root("region"), &region)
+		assert.Equal(t, "route", id.ValueString())
+		assert.Equal(t, "br-ne1", region.ValueString())
+	})
+
+	t.Run("single attribute identity keeps commas", func(t *testing.T) {
+		resp := &resource.ImportStateResponse{State: newTestState(ctx)}
+		RegionalIdentity(IDAttribute("ID.")).ImportState(ctx, resource.ImportStateRequest{ID: "a,b"}, resp)
+		require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+		var id types.String
+		resp.State.GetAttribute(ctx, path.Root("id"), &id)
+		assert.Equal(t, "a,b", id.ValueString())
+	})
+}
+
+func TestResourceIdentity_SetCreated(t *testing.T) {
+	ctx := context.Background()
+
+	state := newTestState(ctx)
+	var diags diag.Diagnostics
+	testRouteIdentity.SetCreated(ctx, &state, map[string]string{"region": "br-ne1", "vpc_id": "vpc", "route_id": "route"}, &diags)
+	require.False(t, diags.HasError(), diags)
+
+	var id, region, name types.String
+	state.GetAttribute(ctx, path.Root("id"), &id)
+	state.GetAttribute(ctx, path.Root("region"), &region)
+	state.GetAttribute(ctx, path.Root("name"), &name)
+	assert.Equal(t, "route", id.ValueString())
+	assert.Equal(t, "br-ne1", region.ValueString())
+	assert.True(t, name.IsNull())
+	assert.True(t, state.Raw.IsFullyKnown())
+
+	state = newTestState(ctx)
+	testRouteIdentity.SetCreated(ctx, &state, map[string]string{"vpc_id": "vpc", "route_id": "route"}, &diags)
+	require.False(t, diags.HasError(), diags)
+	state.GetAttribute(ctx, path.Root("region"), &region)
+	assert.True(t, region.IsNull())
+}
@@ -0,0 +1,166 @@
+package utils
+
+import (
+	"context"
+	"iter"
+	"regexp"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ListRegionAttribute is the optional region attribute of list resources.
+func ListRegionAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Description: "Region to list from. Defaults to the provider region.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(KnownRegions()...),
+		},
+	}
+}
+
+// ListNameRegexAttribute is the optional name filter of list resources.
+func ListNameRegexAttribute() listschema.StringAttribute {
+	return listschema.StringAttribute{
+		Description: "Only list resources whose name matches this regular expression.",
+		Optional:    true,
+	}
+}
+
+// ListConfig holds the attributes of every list resource config. The config
+// models of list resources embed it.
+type ListConfig struct {
+	Region    types.String `tfsdk:"region"`
+	NameRegex types.String `tfsdk:"name_regex"`
+}
+
+func (c *ListConfig) listConfig() *ListConfig {
+	return c
+}
+
+// Lister is the type-specific part of a list resource, whose config model C
+// embeds ListConfig and which lists items of type T.
+type Lister[C, T any] struct {
+	TypeName string
+	Identity ResourceIdentity
+	// Use points the clients at the config region, setting it to the
+	// provider region when null.
+	Use func(region *types.String) diag.Diagnostics
+	// Fetch lists the items of the region. Config filters the API does not
+	// support are left to Describe.
+	Fetch func(ctx context.Context, config C) ([]T, error)
+	// Describe returns the name of item and its identity values, besides
+	// region, or false to skip it.
+	Describe func(config C, item T) (name string, values map[string]string, ok bool)
+	// Resource returns the state model of item, read like the resource does.
+	// It is only called when the request includes resources, with the
+	// config region set.
+	Resource func(ctx context.Context, config C, item T) (any, diag.Diagnostics)
+}
+
+// List streams the items of the config region whose name matches name_regex,
+// until the request limit is reached. Failures are streamed as diagnostics.
+func List[C, T any, PC interface {
+	*C
+	listConfig() *ListConfig
+}](ctx context.Context, req list.ListRequest, stream *list.ListResultsStream, lister Lister[C, T]) {
+	var diags diag.Diagnostics
+	defer tracing.List(&ctx, lister.TypeName, &diags)()
+
+	var config C
+	shared := PC(&config).listConfig()
+	diags.Append(req.Config.Get(ctx, &config)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	matchName, nameDiags := NameMatcher(shared.NameRegex)
+	diags.Append(nameDiags...)
+	diags.Append(lister.Use(&shared.Region)...)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	items, err := lister.Fetch(ctx, config)
+	if err != nil {
+		diags.Append(SDKErrorDiagnostic(err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = ListResults(req, items, func(item T) (list.ListResult, bool) {
+		name, values, ok := lister.Describe(config, item)
+		if !ok || !matchName(name) {
+			return list.ListResult{}, false
+		}
+		values["region"] = shared.Region.ValueString()
+		result := lister.Identity.ListResult(ctx, req, name, values)
+		if req.IncludeResource {
+			model, modelDiags := lister.Resource(ctx, config, item)
+			result.Diagnostics.Append(modelDiags...)
+			if !modelDiags.HasError() {
+				result.Diagnostics.Append(result.Resource.Set(ctx, model)...)
+			}
+		}
+		return result, true
+	})
+}
+
+// NameMatcher compiles the name_regex filter of a list resource. A null
+// filter matches every name.
+func NameMatcher(regex types.String) (func(string) bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if regex.IsNull() || regex.ValueString() == "" {
+		return func(string) bool { return true }, diags
+	}
+
+	rgx, err := regexp.Compile(regex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", err.Error())
+		return nil, diags
+	}
+	return rgx.MatchString, diags
+}
+
+// ListResults streams the result of each item, skipping those result reports
+// false for, until the request limit is reached.
+func ListResults[T any](req list.ListRequest, items []T, result func(T) (list.ListResult, bool)) iter.Seq[list.ListResult] {
+	return func(push func(list.ListResult) bool) {
+		var pushed int64
+		for _, item := range items {
+			if req.Limit > 0 && pushed >= req.Limit {
+				return
+			}
+			r, ok := result(item)
+			if !ok {
+				continue
+			}
+			pushed++
+			if !push(r) {
+				return
+			}
+		}
+	}
+}
+
+// ListResult returns the result of a listed resource, with its identity set
+// from values keyed by identity attribute name. The resource is left for the
+// caller to set when the request includes it.
+func (i ResourceIdentity) ListResult(ctx context.Context, req list.ListRequest, displayName string, values map[string]string) list.ListResult {
+	result := req.NewListResult(ctx)
+	result.DisplayName = displayName
+
+	for _, a := range i {
+		result.Diagnostics.Append(result.Identity.SetAttribute(ctx, path.Root(a.Name), types.StringValue(values[a.Name]))...)
+	}
+	return result
+}
@@ -0,0 +1,195 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNameMatcher(t *testing.T) {
+	match, diags := NameMatcher(types.StringNull())
+	require.False(t, diags.HasError())
+	assert.True(t, match("anything"))
+
+	match, diags = NameMatcher(types.StringValue("^web-"))
+	require.False(t, diags.HasError())
+	assert.True(t, match("web-1"))
+	assert.False(t, match("db-1"))
+
+	_, diags = NameMatcher(types.StringValue("("))
+	require.True(t, diags.HasError())
+	assert.Equal(t, "Invalid name_regex", diags.Errors()[0].Summary())
+}
+
+func TestListResults(t *testing.T) {
+	names := []string{"a", "skip", "b", "c"}
+	result := func(name string) (list.ListResult, bool) {
+		return list.ListResult{DisplayName: name}, name != "skip"
+	}
+
+	collect := func(req list.ListRequest) []string {
+		var listed []string
+		for r := range ListResults(req, names, result) {
+			listed = append(listed, r.DisplayName)
+		}
+		return listed
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, collect(list.ListRequest{}))
+	assert.Equal(t, []string{"a", "b"}, collect(list.ListRequest{Limit: 2}))
+
+	var listed []string
+	for r := range ListResults(list.ListRequest{}, names, result) {
+		listed = append(listed, r.DisplayName)
+		break
+	}
+	assert.Equal(t, []string{"a"}, listed)
+}
+
+func TestResourceIdentity_ListResult(t *testing.T) {
+	ctx := context.Background()
+	values := map[string]string{"region": "br-ne1", "vpc_id": "vpc", "route_id": "route"}
+	req := list.ListRequest{
+		IncludeResource:        true,
+		ResourceSchema:         testRouteSchema,
+		ResourceIdentitySchema: testRouteIdentity.Schema(),
+	}
+
+	result := testRouteIdentity.ListResult(ctx, req, "default", values)
+	require.False(t, result.Diagnostics.HasError(), result.Diagnostics)
+	assert.Equal(t, "default", result.DisplayName)
+
+	for name, expected := range values {
+		var value types.String
+		result.Identity.GetAttribute(ctx, path.Root(name), &value)
+		assert.Equal(t, expected, value.ValueString(), name)
+	}
+	assert.True(t, result.Resource.Raw.IsNull())
+}
+
+type testRoutesListModel struct {
+	ListConfig
+	VPCID types.String `tfsdk:"vpc_id"`
+}
+
+type testRoute struct {
+	ID   string
+	Name string
+}
+
+type testRouteModel struct {
+	Region types.String `tfsdk:"region"`
+	VPCID  types.String `tfsdk:"vpc_id"`
+	ID     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+}
+
+func newTestListRequest(ctx context.Context, nameRegex string, includeResource bool) list.ListRequest {
+	configSchema := listschema.Schema{
+		Attributes: map[string]listschema.Attribute{
+			"region":     ListRegionAttribute(),
+			"name_regex": ListNameRegexAttribute(),
+			"vpc_id":     listschema.StringAttribute{Required: true},
+		},
+	}
+	return list.ListRequest{
+		Config: tfsdk.Config{
+			Schema: configSchema,
+			Raw: tftypes.NewValue(configSchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+				"region":     tftypes.NewValue(tftypes.String, nil),
+				"name_regex": tftypes.NewValue(tftypes.String, nameRegex),
+				"vpc_id":     tftypes.NewValue(tftypes.String, "vpc"),
+			}),
+		},
+		IncludeResource:        includeResource,
+		ResourceSchema:         testRouteSchema,
+		ResourceIdentitySchema: testRouteIdentity.Schema(),
+	}
+}
+
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	var fetchedVPC string
+	lister := Lister[testRoutesListModel, testRoute]{
+		TypeName: "mgc_network_vpcs_routes",
+		Identity: testRouteIdentity,
+		Use: func(region *types.String) diag.Diagnostics {
+			*region = types.StringValue("br-se1")
+			return nil
+		},
+		Fetch: func(_ context.Context, config testRoutesListModel) ([]testRoute, error) {
+			fetchedVPC = config.VPCID.ValueString()
+			return []testRoute{{"1", "web-1"}, {"2", "db-1"}, {"3", "web-skip"}, {"4", "web-2"}}, nil
+		},
+		Describe: func(config testRoutesListModel, route testRoute) (string, map[string]string, bool) {
+			if route.Name == "web-skip" {
+				return "", nil, false
+			}
+			return route.Name, map[string]string{"vpc_id": config.VPCID.ValueString(), "route_id": route.ID}, true
+		},
+		Resource: func(_ context.Context, config testRoutesListModel, route testRoute) (any, diag.Diagnostics) {
+			return testRouteModel{
+				Region: config.Region,
+				VPCID:  config.VPCID,
+				ID:     types.StringValue(route.ID),
+				Name:   types.StringValue(route.Name),
+			}, nil
+		},
+	}
+
+	var stream list.ListResultsStream
+	List(ctx, newTestListRequest(ctx, "^web-", false), &stream, lister)
+	assert.Equal(t, "vpc", fetchedVPC)
+
+	var listed []string
+	for r := range stream.Results {
+		require.False(t, r.Diagnostics.HasError(), r.Diagnostics)
+		listed = append(listed, r.DisplayName)
+
+		var region types.String
+		r.Identity.GetAttribute(ctx, path.Root("region"), &region)
+		assert.Equal(t, "br-se1", region.ValueString())
+		assert.True(t, r.Resource.Raw.IsNull())
+	}
+	assert.Equal(t, []string{"web-1", "web-2"}, listed)
+
+	List(ctx, newTestListRequest(ctx, "^web-1$", true), &stream, lister)
+	var included int
+	for r := range stream.Results {
+		require.False(t, r.Diagnostics.HasError(), r.Diagnostics)
+		included++
+		var route testRouteModel
+		require.False(t, r.Resource.Get(ctx, &route).HasError())
+		assert.Equal(t, testRouteModel{
+			Region: types.StringValue("br-se1"),
+			VPCID:  types.StringValue("vpc"),
+			ID:     types.StringValue("1"),
+			Name:   types.StringValue("web-1"),
+		}, route)
+	}
+	assert.Equal(t, 1, included)
+
+	lister.Fetch = func(context.Context, testRoutesListModel) ([]testRoute, error) {
+		return nil, errors.New("boom")
+	}
+	List(ctx, newTestListRequest(ctx, "", false), &stream, lister)
+	for r := range stream.Results {
+		assert.True(t, r.Diagnostics.HasError())
+	}
+
+	List(ctx, newTestListRequest(ctx, "(", false), &stream, lister)
+	for r := range stream.Results {
+		require.True(t, r.Diagnostics.HasError())
+		assert.Equal(t, "Invalid name_regex", r.Diagnostics.Errors()[0].Summary())
+	}
+}
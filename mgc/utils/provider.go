@@ -10,5 +10,20 @@ type DataConfig struct {
 	Region        string
 	KeyPairID     string
 	KeyPairSecret string
+	ObjectStorage ObjectStorageConfig
 	CoreConfig    sdk.CoreClient
+	RegionClients *RegionClients
+	CatalogCache  *CatalogCache
+}
+
+// ObjectStorageConfig overrides how the object storage client reaches the S3 API.
+// The zero value uses the endpoint of the configured region with the SDK addressing.
+type ObjectStorageConfig struct {
+	Endpoint      string
+	UsePathStyle  bool
+	SkipTLSVerify bool
+}
+
+func (c ObjectStorageConfig) IsCustom() bool {
+	return c.Endpoint != "" || c.UsePathStyle || c.SkipTLSVerify
 }
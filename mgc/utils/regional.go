@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+
+	sdk "github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	actschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	ephschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RegionClients caches one SDK core client per region, built with the same
+// credentials, HTTP transport and user agent as the provider client.
+type RegionClients struct {
+	mu       sync.Mutex
+	env      string
+	endpoint string
+	options  []sdk.Option
+	clients  map[string]*sdk.CoreClient
+}
+
+// NewRegionClients returns a cache for env. When endpoint is set every region
+// uses it, as it replaces the region URLs.
+func NewRegionClients(env, endpoint string, options ...sdk.Option) *RegionClients {
+	return &RegionClients{
+		env:      env,
+		endpoint: endpoint,
+		options:  options,
+		clients:  map[string]*sdk.CoreClient{},
+	}
+}
+
+func (c *RegionClients) Get(region string) (*sdk.CoreClient, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if client, ok := c.clients[region]; ok {
+		return client, nil
+	}
+
+	url := c.endpoint
+	if url == "" {
+		var err error
+		if url, err = RegionToUrl(region, c.env); err != nil {
+			return nil, err
+		}
+	}
+
+	client := sdk.NewMgcClient(append(slices.Clone(c.options), sdk.WithBaseURL(sdk.MgcUrl(url)))...)
+	c.clients[region] = client
+	return client, nil
+}
+
+// ForRegion returns a copy of the config targeting region. An empty region, or
+// the provider region, returns the config unchanged.
+func (c DataConfig) ForRegion(region string) (DataConfig, error) {
+	if region == "" || region == c.Region {
+		return c, nil
+	}
+	if c.RegionClients == nil {
+		return c, fmt.Errorf("region %q cannot be selected, the provider is not configured", region)
+	}
+
+	client, err := c.RegionClients.Get(region)
+	if err != nil {
+		return c, err
+	}
+	c.Region = region
+	c.CoreConfig = *client
+	return c, nil
+}
+
+// KnownRegions lists the regions of every environment.
+func KnownRegions() []string {
+	known := map[string]bool{}
+	for _, envRegions := range regions {
+		for region := range envRegions {
+			known[region] = true
+		}
+	}
+	return slices.Sorted(maps.Keys(known))
+}
+
+// RegionalService points the SDK services of a resource or data source at the
+// region selected by its region attribute.
+type RegionalService struct {
+	config DataConfig
+	apply  func(DataConfig)
+}
+
+// NewRegionalService calls apply with the provider config, then again with a
+// region specific config on each Use.
+func NewRegionalService(config DataConfig, apply func(DataConfig)) RegionalService {
+	apply(config)
+	return RegionalService{config: config, apply: apply}
+}
+
+// Use selects region, or the provider region when it is null or unknown, and
+// sets region to the region in effect.
+func (s RegionalService) Use(region *types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	selected := s.config.Region
+	if !region.IsNull() && !region.IsUnknown() && region.ValueString() != "" {
+		selected = region.ValueString()
+	}
+
+	if s.apply != nil {
+		config, err := s.config.ForRegion(selected)
+		if err != nil {
+			diags.AddAttributeError(path.Root("region"), "Invalid region", err.Error())
+			return diags
+		}
+		s.apply(config)
+	}
+
+	*region = types.StringValue(selected)
+	return diags
+}
+
+// ResourceRegionAttribute is the optional region attribute of regional resources.
+// Changing it forces replacement, and resources created before it existed keep
+// the region stored in state.
+func ResourceRegionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Region where the resource is managed. Defaults to the provider region.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.String{
+			stringvalidator.OneOf(KnownRegions()...),
+		},
+	}
+}
+
+// DataSourceRegionAttribute is the optional region attribute of regional data sources.
+func DataSourceRegionAttribute() dsschema.StringAttribute {
+	return dsschema.StringAttribute{
+		Description: "Region to read from. Defaults to the provider region.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(KnownRegions()...),
+		},
+	}
+}
+
+// EphemeralRegionAttribute is the optional region attribute of regional
+// ephemeral resources.
+func EphemeralRegionAttribute() ephschema.StringAttribute {
+	return ephschema.StringAttribute{
+		Description: "Region to read from. Defaults to the provider region.",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(KnownRegions()...),
+		},
+	}
+}
+
+// ActionRegionAttribute is the optional region attribute of regional actions.
+func ActionRegionAttribute() actschema.StringAttribute {
+	return actschema.StringAttribute{
+		Description: "Region of the object to act on. Defaults to the provider region.",
+		Optional:    true,
+		Validators: []validator.String{
+			stringvalidator.OneOf(KnownRegions()...),
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package utils
+
+import (
+	"testing"
+
+	sdk "github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testDataConfig() DataConfig {
+	return DataConfig{
+		Env:           ENV_PROD,
+		Region:        "br-se1",
+		CoreConfig:    *sdk.NewMgcClient(sdk.WithBaseURL(sdk.BrSe1)),
+		RegionClients: NewRegionClients(ENV_PROD, "", sdk.WithAPIKey("key")),
+	}
+}
+
+func TestDataConfig_ForRegion(t *testing.T) {
+	cfg := testDataConfig()
+
+	same, err := cfg.ForRegion("br-se1")
+	require.NoError(t, err)
+	assert.Equal(t, sdk.BrSe1, same.CoreConfig.GetConfig().BaseURL)
+
+	other, err := cfg.ForRegion("br-ne1")
+	require.NoError(t, err)
+	assert.Equal(t, "br-ne1", other.Region)
+	assert.Equal(t, sdk.BrNe1, other.CoreConfig.GetConfig().BaseURL)
+	assert.Equal(t, "key", other.CoreConfig.GetConfig().APIKey)
+
+	first, _ := cfg.RegionClients.Get("br-ne1")
+	second, _ := cfg.RegionClients.Get("br-ne1")
+	assert.Same(t, first, second)
+
+	_, err = cfg.ForRegion("br-mc1")
+	assert.Error(t, err)
+}
+
+func TestRegionClients_Endpoint(t *testing.T) {
+	client, err := NewRegionClients(ENV_PROD, "http://127.0.0.1:8080").Get("br-mc1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://127.0.0.1:8080", client.GetConfig().BaseURL.String())
+}
+
+func TestRegionalService_Use(t *testing.T) {
+	var selected []string
+	s := NewRegionalService(testDataConfig(), func(cfg DataConfig) {
+		selected = append(selected, cfg.CoreConfig.GetConfig().BaseURL.String())
+	})
+
+	region := types.StringNull()
+	diags := s.Use(&region)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "br-se1", region.ValueString())
+
+	region = types.StringValue("br-ne1")
+	diags = s.Use(&region)
+	require.False(t, diags.HasError())
+	assert.Equal(t, "br-ne1", region.ValueString())
+
+	assert.Equal(t, []string{sdk.BrSe1.String(), sdk.BrSe1.String(), sdk.BrNe1.String()}, selected)
+
+	region = types.StringValue("br-mc1")
+	diags = s.Use(&region)
+	assert.True(t, diags.HasError())
+}
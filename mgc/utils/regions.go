@@ -2,6 +2,9 @@ package utils
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 
 	"github.com/MagaluCloud/mgc-sdk-go/client"
 	objSDK "github.com/MagaluCloud/mgc-sdk-go/objectstorage"
@@ -40,17 +43,28 @@ var s3Regions = map[string]map[string]objSDK.Endpoint{
 	},
 }
 
-func RegionToUrl(region string, env string) string {
-	if regions[env] == nil {
+// RegionToUrl returns the API URL of region in env. Empty values select the
+// prod environment and the br-se1 region; unknown combinations are rejected.
+func RegionToUrl(region string, env string) (string, error) {
+	if env == "" {
 		env = ENV_PROD
 	}
-
-	if regions[env][region] == "" {
+	if region == "" {
 		region = "br-se1"
 	}
 
-	return regions[env][region]
+	envRegions, ok := regions[env]
+	if !ok {
+		return "", fmt.Errorf("unknown environment %q, expected one of: %s", env, strings.Join(slices.Sorted(maps.Keys(regions)), ", "))
+	}
 
+	url, ok := envRegions[region]
+	if !ok {
+		return "", fmt.Errorf("region %q is not available in the %s environment, expected one of: %s",
+			region, env, strings.Join(slices.Sorted(maps.Keys(envRegions)), ", "))
+	}
+
+	return url, nil
 }
 
 func buildPreProdUrl(region string) string {
@@ -64,15 +78,26 @@ func buildQAUrl(region string) string {
 	return URL_DEV_QA + "/" + region
 }
 
+// RegionToS3Url returns the object storage endpoint of region in env, with the
+// same defaults as RegionToUrl. Unknown combinations are rejected.
 func RegionToS3Url(region string, env string) (objSDK.Endpoint, error) {
-	if env == "pre-prod" {
-		return "", fmt.Errorf("pre-prod is not supported for S3")
-	}
-	if s3Regions[env] == nil {
-		env = "prod"
+	if env == "" {
+		env = ENV_PROD
 	}
-	if s3Regions[env][region] == "" {
+	if region == "" {
 		region = "br-se1"
 	}
-	return s3Regions[env][region], nil
+
+	envRegions, ok := s3Regions[env]
+	if !ok {
+		return "", fmt.Errorf("object storage is not available in the %s environment", env)
+	}
+
+	endpoint, ok := envRegions[region]
+	if !ok {
+		return "", fmt.Errorf("object storage is not available in region %q of the %s environment, expected one of: %s",
+			region, env, strings.Join(slices.Sorted(maps.Keys(envRegions)), ", "))
+	}
+
+	return endpoint, nil
 }
@@ -12,6 +12,7 @@ func TestRegionToUrl(t *testing.T) {
 		inputRegion string
 		inputEnv    string
 		expectedUrl string
+		expectError bool
 	}
 
 	tests := []testArgs{
@@ -36,25 +37,35 @@ func TestRegionToUrl(t *testing.T) {
 			inputEnv:    "pre-prod",
 			expectedUrl: "https://api.pre-prod.jaxyendy.com/br-se1",
 		},
+		{
+			inputRegion: "br-mc1",
+			inputEnv:    "dev-qa",
+			expectedUrl: "https://api.dev-qa.jaxyendy.com/br-mc1",
+		},
+		{
+			inputRegion: "br-mc1",
+			inputEnv:    "prod",
+			expectError: true,
+		},
 		{
 			inputRegion: "invalid-region",
 			inputEnv:    "prod",
-			expectedUrl: client.BrSe1.String(),
+			expectError: true,
 		},
 		{
 			inputRegion: "invalid-region",
 			inputEnv:    "pre-prod",
-			expectedUrl: "https://api.pre-prod.jaxyendy.com/br-se1",
+			expectError: true,
 		},
 		{
 			inputRegion: "br-ne1",
 			inputEnv:    "invalid-env",
-			expectedUrl: client.BrNe1.String(),
+			expectError: true,
 		},
 		{
 			inputRegion: "invalid-region",
 			inputEnv:    "invalid-env",
-			expectedUrl: client.BrSe1.String(),
+			expectError: true,
 		},
 		{
 			inputRegion: "",
@@ -75,7 +86,16 @@ func TestRegionToUrl(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("Region:%s,Env:%s", tt.inputRegion, tt.inputEnv), func(t *testing.T) {
-			url := RegionToUrl(tt.inputRegion, tt.inputEnv)
+			url, err := RegionToUrl(tt.inputRegion, tt.inputEnv)
+			if tt.expectError {
+				if err == nil {
+					t.Errorf("Expected error, got URL %q", url)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Expected no error, got %q", err)
+			}
 			if url != tt.expectedUrl {
 				t.Errorf("Expected URL %q, got %q", tt.expectedUrl, url)
 			}
@@ -114,12 +134,17 @@ func TestRegionToS3Url(t *testing.T) {
 		{
 			inputRegion: "br-ne1",
 			inputEnv:    "invalid-env",
-			expectedUrl: "https://br-ne1.magaluobjects.com",
+			expectError: true,
 		},
 		{
 			inputRegion: "invalid-region",
 			inputEnv:    "invalid-env",
-			expectedUrl: "https://br-se1.magaluobjects.com",
+			expectError: true,
+		},
+		{
+			inputRegion: "br-mgl1",
+			inputEnv:    "prod",
+			expectError: true,
 		},
 		{
 			inputRegion: "",
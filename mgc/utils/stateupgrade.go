@@ -0,0 +1,184 @@
+package utils
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// StateMigration reshapes a prior state decoded from JSON, in place.
+type StateMigration func(state map[string]any)
+
+// UpgradeState returns a state upgrader from a prior schema version. It works
+// on the raw JSON state, so prior states of any shape can be upgraded without
+// keeping their schemas around. After applying migrations it drops the
+// attributes the current schema no longer has, which Terraform would reject,
+// and clears write-only attributes, which must not be kept in state.
+func UpgradeState(migrations ...StateMigration) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			upgraded, diags := migrateState(ctx, req.RawState, resp.State, migrations)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+			resp.DynamicValue = &tfprotov6.DynamicValue{JSON: upgraded}
+		},
+	}
+}
+
+// MoveState returns a state mover from the resource types in sources, of any
+// provider address and schema version, so moved blocks can target the
+// resource. The source state is reshaped like in UpgradeState. Other source
+// types are left to the next mover.
+func MoveState(sources []string, migrations ...StateMigration) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			if !slices.Contains(sources, req.SourceTypeName) {
+				return
+			}
+
+			moved, diags := migrateState(ctx, req.SourceRawState, resp.TargetState, migrations)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+
+			value := tfprotov6.DynamicValue{JSON: moved}
+			raw, err := value.Unmarshal(resp.TargetState.Schema.Type().TerraformType(ctx))
+			if err != nil {
+				resp.Diagnostics.AddError("Unable to move resource state", fmt.Sprintf("Moving from %s: %s", req.SourceTypeName, err))
+				return
+			}
+			resp.TargetState.Raw = raw
+		},
+	}
+}
+
+// migrateState returns rawState migrated to the schema of target, as JSON.
+func migrateState(ctx context.Context, rawState *tfprotov6.RawState, target tfsdk.State, migrations []StateMigration) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if rawState == nil || rawState.JSON == nil {
+		diags.AddError("Unable to upgrade resource state", "The prior state is not stored as JSON.")
+		return nil, diags
+	}
+
+	var state map[string]any
+	decoder := json.NewDecoder(bytes.NewReader(rawState.JSON))
+	decoder.UseNumber()
+	if err := decoder.Decode(&state); err != nil {
+		diags.AddError("Unable to upgrade resource state", err.Error())
+		return nil, diags
+	}
+
+	for _, migrate := range migrations {
+		migrate(state)
+	}
+
+	for name, attribute := range target.Schema.GetAttributes() {
+		if attribute.IsWriteOnly() {
+			delete(state, name)
+		}
+	}
+	pruneState(state, target.Schema.Type().TerraformType(ctx))
+
+	migrated, err := json.Marshal(state)
+	if err != nil {
+		diags.AddError("Unable to upgrade resource state", err.Error())
+		return nil, diags
+	}
+	return migrated, diags
+}
+
+// pruneState removes the object attributes value has and typ does not.
+func pruneState(value any, typ tftypes.Type) {
+	switch typ := typ.(type) {
+	case tftypes.Object:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+		for name, attribute := range object {
+			attributeType, ok := typ.AttributeTypes[name]
+			if !ok {
+				delete(object, name)
+				continue
+			}
+			pruneState(attribute, attributeType)
+		}
+	case tftypes.List:
+		pruneElements(value, typ.ElementType)
+	case tftypes.Set:
+		pruneElements(value, typ.ElementType)
+	case tftypes.Map:
+		if elements, ok := value.(map[string]any); ok {
+			for _, element := range elements {
+				pruneState(element, typ.ElementType)
+			}
+		}
+	}
+}
+
+func pruneElements(value any, typ tftypes.Type) {
+	if elements, ok := value.([]any); ok {
+		for _, element := range elements {
+			pruneState(element, typ)
+		}
+	}
+}
+
+// RenameAttribute moves the value of attribute from to attribute to, unless
+// to is already set.
+func RenameAttribute(from, to string) StateMigration {
+	return func(state map[string]any) {
+		value, ok := state[from]
+		if !ok {
+			return
+		}
+		delete(state, from)
+		if _, ok := state[to]; !ok {
+			state[to] = value
+		}
+	}
+}
+
+// FlattenAttribute replaces an object attribute by one of its attributes, e.g.
+// machine_type = { name = "BV1-1-40" } by machine_type = "BV1-1-40".
+func FlattenAttribute(name, nested string) StateMigration {
+	return func(state map[string]any) {
+		if object, ok := state[name].(map[string]any); ok {
+			state[name] = object[nested]
+		}
+	}
+}
+
+// LiftAttributes replaces an object attribute by its attributes, renamed as
+// in attributes, e.g. volume = { size = 10 } by volume_size = 10 with
+// attributes {"size": "volume_size"}. Attributes already set are kept.
+func LiftAttributes(name string, attributes map[string]string) StateMigration {
+	return func(state map[string]any) {
+		value, ok := state[name]
+		if !ok {
+			return
+		}
+		delete(state, name)
+
+		object, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+		for nested, to := range attributes {
+			if current, ok := state[to]; !ok || current == nil {
+				state[to] = object[nested]
+			}
+		}
+	}
+}
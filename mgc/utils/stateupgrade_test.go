@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testUpgradeSchema = schema.Schema{
+	Version: 1,
+	Attributes: map[string]schema.Attribute{
+		"id":           schema.StringAttribute{Computed: true},
+		"machine_type": schema.StringAttribute{Required: true},
+		"volume_size":  schema.Int64Attribute{Optional: true},
+		"password":     schema.StringAttribute{Optional: true, WriteOnly: true},
+		"interfaces": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{Computed: true},
+				},
+			},
+		},
+	},
+}
+
+func upgradeTestState(t *testing.T, upgrader resource.StateUpgrader, prior string) (tfsdk.State, *resource.UpgradeStateResponse) {
+	ctx := context.Background()
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: testUpgradeSchema}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(prior)}}, resp)
+	if resp.Diagnostics.HasError() {
+		return tfsdk.State{}, resp
+	}
+
+	require.NotNil(t, resp.DynamicValue)
+	raw, err := resp.DynamicValue.Unmarshal(testUpgradeSchema.Type().TerraformType(ctx))
+	require.NoError(t, err)
+	return tfsdk.State{Schema: testUpgradeSchema, Raw: raw}, resp
+}
+
+func TestUpgradeState(t *testing.T) {
+	ctx := context.Background()
+	upgrader := UpgradeState(
+		FlattenAttribute("machine_type", "name"),
+		LiftAttributes("volume", map[string]string{"size": "volume_size"}),
+	)
+
+	state, resp := upgradeTestState(t, upgrader, `{
+		"id": "vm",
+		"machine_type": {"name": "BV1-1-40", "id": "type"},
+		"volume": {"size": 20, "type": "nvme"},
+		"password": "secret",
+		"removed": true,
+		"interfaces": [{"id": "nic", "removed": "value"}]
+	}`)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var machineType, password types.String
+	var volumeSize types.Int64
+	var interfaceID types.String
+	state.GetAttribute(ctx, path.Root("machine_type"), &machineType)
+	state.GetAttribute(ctx, path.Root("password"), &password)
+	state.GetAttribute(ctx, path.Root("volume_size"), &volumeSize)
+	state.GetAttribute(ctx, path.Root("interfaces").AtListIndex(0).AtName("id"), &interfaceID)
+
+	assert.Equal(t, "BV1-1-40", machineType.ValueString())
+	assert.True(t, password.IsNull())
+	assert.Equal(t, int64(20), volumeSize.ValueInt64())
+	assert.Equal(t, "nic", interfaceID.ValueString())
+}
+
+func TestUpgradeState_CurrentShape(t *testing.T) {
+	ctx := context.Background()
+	upgrader := UpgradeState(FlattenAttribute("machine_type", "name"))
+
+	state, resp := upgradeTestState(t, upgrader, `{"id": "vm", "machine_type": "BV1-1-40", "volume_size": 10}`)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var machineType types.String
+	var volumeSize types.Int64
+	state.GetAttribute(ctx, path.Root("machine_type"), &machineType)
+	state.GetAttribute(ctx, path.Root("volume_size"), &volumeSize)
+	assert.Equal(t, "BV1-1-40", machineType.ValueString())
+	assert.Equal(t, int64(10), volumeSize.ValueInt64())
+}
+
+func TestUpgradeState_InvalidState(t *testing.T) {
+	_, resp := upgradeTestState(t, UpgradeState(), `not json`)
+	assert.True(t, resp.Diagnostics.HasError())
+
+	resp = &resource.UpgradeStateResponse{State: tfsdk.State{Schema: testUpgradeSchema}}
+	UpgradeState().StateUpgrader(context.Background(), resource.UpgradeStateRequest{}, resp)
+	assert.True(t, resp.Diagnostics.HasError())
+}
+
+func TestRenameAttribute(t *testing.T) {
+	state := map[string]any{"flavor": "small"}
+	RenameAttribute("flavor", "flavor_name")(state)
+	assert.Equal(t, map[string]any{"flavor_name": "small"}, state)
+
+	state = map[string]any{"flavor": "small", "flavor_name": "large"}
+	RenameAttribute("flavor", "flavor_name")(state)
+	assert.Equal(t, map[string]any{"flavor_name": "large"}, state)
+}
+
+func TestLiftAttributes(t *testing.T) {
+	state := map[string]any{
+		"auto_scale":   map[string]any{"min_replicas": 1, "max_replicas": 3},
+		"max_replicas": 5,
+	}
+	LiftAttributes("auto_scale", map[string]string{"min_replicas": "min_replicas", "max_replicas": "max_replicas"})(state)
+	assert.Equal(t, map[string]any{"min_replicas": 1, "max_replicas": 5}, state)
+
+	state = map[string]any{"auto_scale": nil}
+	LiftAttributes("auto_scale", map[string]string{"min_replicas": "min_replicas"})(state)
+	assert.Empty(t, state)
+}
+
+func TestMoveState(t *testing.T) {
+	ctx := context.Background()
+	mover := MoveState([]string{"mgc_legacy_instances"}, FlattenAttribute("machine_type", "name"))
+
+	newResponse := func() *resource.MoveStateResponse {
+		return &resource.MoveStateResponse{TargetState: tfsdk.State{
+			Schema: testUpgradeSchema,
+			Raw:    tftypes.NewValue(testUpgradeSchema.Type().TerraformType(ctx), nil),
+		}}
+	}
+	source := &tfprotov6.RawState{JSON: []byte(`{"id": "vm", "machine_type": {"name": "BV1-1-40"}, "password": "secret"}`)}
+
+	resp := newResponse()
+	mover.StateMover(ctx, resource.MoveStateRequest{SourceTypeName: "mgc_legacy_instances", SourceRawState: source}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var machineType, password types.String
+	resp.TargetState.GetAttribute(ctx, path.Root("machine_type"), &machineType)
+	resp.TargetState.GetAttribute(ctx, path.Root("password"), &password)
+	assert.Equal(t, "BV1-1-40", machineType.ValueString())
+	assert.True(t, password.IsNull())
+
+	resp = newResponse()
+	mover.StateMover(ctx, resource.MoveStateRequest{SourceTypeName: "mgc_other", SourceRawState: source}, resp)
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.True(t, resp.TargetState.Raw.IsNull(), "other source types are left to the next mover")
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// NullTimeouts returns a null timeouts value matching the block built from opts,
+// for models that are set in full before any configuration is available, such as on import.
+func NullTimeouts(opts timeouts.Opts) timeouts.Value {
+	attrTypes := map[string]attr.Type{}
+	if opts.Create {
+		attrTypes["create"] = types.StringType
+	}
+	if opts.Read {
+		attrTypes["read"] = types.StringType
+	}
+	if opts.Update {
+		attrTypes["update"] = types.StringType
+	}
+	if opts.Delete {
+		attrTypes["delete"] = types.StringType
+	}
+
+	return timeouts.Value{Object: types.ObjectNull(attrTypes)}
+}
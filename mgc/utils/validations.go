@@ -1,10 +1,13 @@
 package utils
 
 import (
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
 
 	clientSDK "github.com/MagaluCloud/mgc-sdk-go/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 )
 
 const (
@@ -63,6 +66,27 @@ func buildFromSDKError(err *clientSDK.HTTPError) (HttpErrorResponse, error) {
 	return e, nil
 }
 
+// IsNotFound reports whether err is an API response with status 404, meaning
+// the object was deleted outside Terraform. Read methods remove such objects
+// from state so the next plan creates them again.
+func IsNotFound(err error) bool {
+	var httpErr *clientSDK.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode == http.StatusNotFound
+	}
+
+	var retryErr *clientSDK.RetryError
+	if errors.As(err, &retryErr) {
+		return IsNotFound(retryErr.LastError)
+	}
+
+	return false
+}
+
+// ParseSDKError turns an SDK error into a diagnostic summary and detail.
+// API error bodies are decoded so the detail starts with the API message
+// and, for well-known failures such as exhausted quotas, a summary naming
+// the failure and a hint on what to do about it.
 func ParseSDKError(err error) (msg, detail string) {
 	if err == nil {
 		return simpleGenericError, "nil error provided"
@@ -74,7 +98,17 @@ func ParseSDKError(err error) (msg, detail string) {
 		if buildErr != nil {
 			return simpleGenericError, buildErr.Error()
 		}
-		return simpleHttpError, errorResponse.String()
+		apiErr, ok := parseAPIError(e.StatusCode, e.Body)
+		if !ok {
+			return simpleHttpError, errorResponse.String()
+		}
+		msg = simpleHttpError
+		if summary, _ := apiErr.classify(); summary != "" {
+			msg = summary
+		}
+		// The raw response stays last: the request and trace IDs are what
+		// support asks for.
+		return msg, apiErr.detail() + "\n\n" + errorResponse.String()
 
 	case *clientSDK.ValidationError:
 		if e == nil {
@@ -102,3 +136,27 @@ func ParseSDKError(err error) (msg, detail string) {
 		return simpleGenericError, err.Error()
 	}
 }
+
+// SDKErrorDiagnostic is ParseSDKError as a diagnostic, attached to the
+// attribute the API blamed when the error is about a single request field.
+func SDKErrorDiagnostic(err error) diag.Diagnostic {
+	msg, detail := ParseSDKError(err)
+
+	var (
+		httpErr       *clientSDK.HTTPError
+		validationErr *clientSDK.ValidationError
+	)
+	switch {
+	case errors.As(err, &httpErr) && httpErr != nil:
+		if apiErr, ok := parseAPIError(httpErr.StatusCode, httpErr.Body); ok {
+			if p, ok := apiErr.attributePath(); ok {
+				return diag.NewAttributeErrorDiagnostic(p, msg, detail)
+			}
+		}
+	case errors.As(err, &validationErr) && validationErr != nil:
+		if p, ok := attributePath(validationErr.Field); ok {
+			return diag.NewAttributeErrorDiagnostic(p, msg, detail)
+		}
+	}
+	return diag.NewErrorDiagnostic(msg, detail)
+}
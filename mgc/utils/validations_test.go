@@ -41,7 +41,7 @@ func TestParseSDKError_HTTPError(t *testing.T) {
 		t.Errorf("expected message %q, got %q", simpleHttpError, msg)
 	}
 
-	expectedDetail := "HTTP Error:\n  Status: 404 Not Found\n  Body: {\"error\":\"not found\"}\n  URL: http://example.com/test\n  Request ID: req-123\n  MGC Trace ID: trace-789"
+	expectedDetail := "not found\n\nHTTP Error:\n  Status: 404 Not Found\n  Body: {\"error\":\"not found\"}\n  URL: http://example.com/test\n  Request ID: req-123\n  MGC Trace ID: trace-789"
 	if detail != expectedDetail {
 		t.Errorf("expected detail %q, got %q", expectedDetail, detail)
 	}
@@ -200,3 +200,27 @@ func TestParseSDKError_GenericError(t *testing.T) {
 		t.Errorf("expected detail %q, got %q", "generic error", detail)
 	}
 }
+
+func TestIsNotFound(t *testing.T) {
+	notFound := &clientSDK.HTTPError{Status: "404 Not Found", StatusCode: http.StatusNotFound}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"not found", notFound, true},
+		{"wrapped not found", fmt.Errorf("failed to get instance: %w", notFound), true},
+		{"retry error", &clientSDK.RetryError{LastError: notFound, Retries: 3}, true},
+		{"other status", &clientSDK.HTTPError{Status: "403 Forbidden", StatusCode: http.StatusForbidden}, false},
+		{"plain error", fmt.Errorf("404 in the message is not enough"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.want {
+				t.Errorf("IsNotFound() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
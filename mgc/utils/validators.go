@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 )
@@ -38,3 +41,95 @@ func (v CidrValidator) Description(ctx context.Context) string {
 func (v CidrValidator) MarkdownDescription(ctx context.Context) string {
 	return "value must be a valid CIDR notation"
 }
+
+type DurationValidator struct{}
+
+func (v DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Value %q is not a valid duration such as \"30s\" or \"5m\": %s", value, err),
+		)
+		return
+	}
+
+	if d < 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Value %q must not be negative", value),
+		)
+	}
+}
+
+func (v DurationValidator) Description(ctx context.Context) string {
+	return "value must be a valid duration"
+}
+
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a valid duration"
+}
+
+type URLValidator struct{}
+
+func (v URLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if err := ValidateEndpointURL(value); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid URL",
+			fmt.Sprintf("Value %q is not a valid endpoint URL: %s", value, err),
+		)
+	}
+}
+
+func (v URLValidator) Description(ctx context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+func (v URLValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be an absolute http or https URL"
+}
+
+// ValidateEndpointURL checks that value is an absolute http(s) URL without query or fragment.
+func ValidateEndpointURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("scheme must be http or https")
+	}
+	if u.Host == "" {
+		return fmt.Errorf("host is missing")
+	}
+	if u.RawQuery != "" || u.Fragment != "" {
+		return fmt.Errorf("query and fragment are not allowed")
+	}
+	return nil
+}
+
+// ValidateHostEndpointURL validates an endpoint addressed by its host alone,
+// such as an S3 endpoint, which has no room for a path prefix.
+func ValidateHostEndpointURL(value string) error {
+	if err := ValidateEndpointURL(value); err != nil {
+		return err
+	}
+	if u, _ := url.Parse(value); strings.Trim(u.Path, "/") != "" {
+		return fmt.Errorf("path %q is not supported, the endpoint must be a scheme and host", u.Path)
+	}
+	return nil
+}
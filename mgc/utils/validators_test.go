@@ -190,3 +190,76 @@ func TestCIDRValidatorUnknownValue(t *testing.T) {
 	val.ValidateString(context.Background(), req, resp)
 	assert.Empty(t, resp.Diagnostics, "Expected no diagnostics for unknown value")
 }
+
+func TestDurationValidator(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         string
+		expectedValid bool
+	}{
+		{name: "Seconds", value: "30s", expectedValid: true},
+		{name: "Composite", value: "1h30m", expectedValid: true},
+		{name: "Zero", value: "0s", expectedValid: true},
+		{name: "Missing unit", value: "30", expectedValid: false},
+		{name: "Negative", value: "-5m", expectedValid: false},
+		{name: "Malformed", value: "five minutes", expectedValid: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue(tc.value),
+			}
+			resp := &validator.StringResponse{}
+
+			DurationValidator{}.ValidateString(context.Background(), req, resp)
+
+			if tc.expectedValid {
+				assert.Empty(t, resp.Diagnostics)
+			} else {
+				assert.NotEmpty(t, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestURLValidator(t *testing.T) {
+	testCases := []struct {
+		name          string
+		value         string
+		expectedValid bool
+	}{
+		{name: "HTTPS with path", value: "https://proxy.internal/br-se1", expectedValid: true},
+		{name: "HTTP with port", value: "http://127.0.0.1:8080", expectedValid: true},
+		{name: "Missing scheme", value: "api.magalu.cloud/br-se1", expectedValid: false},
+		{name: "Unsupported scheme", value: "ftp://api.magalu.cloud", expectedValid: false},
+		{name: "Missing host", value: "https:///br-se1", expectedValid: false},
+		{name: "With query", value: "https://api.magalu.cloud?region=br-se1", expectedValid: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("test"),
+				ConfigValue: types.StringValue(tc.value),
+			}
+			resp := &validator.StringResponse{}
+
+			URLValidator{}.ValidateString(context.Background(), req, resp)
+
+			if tc.expectedValid {
+				assert.Empty(t, resp.Diagnostics)
+			} else {
+				assert.NotEmpty(t, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestValidateHostEndpointURL(t *testing.T) {
+	assert.NoError(t, ValidateHostEndpointURL("http://127.0.0.1:9000"))
+	assert.NoError(t, ValidateHostEndpointURL("https://s3.example.com/"))
+	assert.ErrorContains(t, ValidateHostEndpointURL("https://proxy.internal/s3"), `path "/s3" is not supported`)
+	assert.Error(t, ValidateHostEndpointURL("s3.example.com"))
+}
@@ -0,0 +1,178 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	DefaultWaitMinInterval = 5 * time.Second
+	DefaultWaitMaxInterval = 30 * time.Second
+)
+
+// StateRefreshFunc fetches the current object and reports its state. Returning
+// an error stops the wait immediately.
+type StateRefreshFunc[T any] func(ctx context.Context) (T, string, error)
+
+// StateWaiter polls Refresh until the reported state is one of Target.
+//
+// States listed in Error, or matched by IsError, fail the wait. When Pending is
+// set, any state outside Target, Pending and Error is reported as unexpected;
+// when it is empty every other state is treated as pending.
+type StateWaiter[T any] struct {
+	// Description names the object in logs and errors, e.g. "virtual machine <id>".
+	Description string
+	Target      []string
+	Pending     []string
+	Error       []string
+	IsError     func(state string) bool
+	Refresh     StateRefreshFunc[T]
+
+	// Timeout bounds the whole wait. Zero means the wait is only bounded by ctx.
+	Timeout time.Duration
+	// Delay is waited once before the first refresh.
+	Delay time.Duration
+	// MinInterval and MaxInterval bound the exponential backoff between refreshes.
+	MinInterval time.Duration
+	MaxInterval time.Duration
+}
+
+type WaitTimeoutError struct {
+	Description string
+	LastState   string
+	Target      []string
+	Timeout     time.Duration
+}
+
+func (e *WaitTimeoutError) Error() string {
+	return fmt.Sprintf("timeout after %s waiting for %s to reach state %s (last state: %q)",
+		e.Timeout, e.Description, strings.Join(e.Target, ", "), e.LastState)
+}
+
+type UnexpectedStateError struct {
+	Description string
+	State       string
+	Expected    []string
+}
+
+func (e *UnexpectedStateError) Error() string {
+	return fmt.Sprintf("%s reached unexpected state %q, expected one of: %s",
+		e.Description, e.State, strings.Join(e.Expected, ", "))
+}
+
+type ErrorStateError struct {
+	Description string
+	State       string
+}
+
+func (e *ErrorStateError) Error() string {
+	return fmt.Sprintf("%s is in error state %q", e.Description, e.State)
+}
+
+// Wait blocks until a target state is reached, the timeout expires or ctx is
+// cancelled, returning the last refreshed object.
+func (w StateWaiter[T]) Wait(ctx context.Context) (result T, err error) {
+	lastState := ""
+	ctx, span := tracing.StartWait(ctx, w.Description, w.Target)
+	defer func() { span.End(lastState, err) }()
+
+	waitCtx := ctx
+	if w.Timeout > 0 {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, w.Timeout)
+		defer cancel()
+	}
+
+	minInterval, maxInterval := w.MinInterval, w.MaxInterval
+	if minInterval <= 0 {
+		minInterval = DefaultWaitMinInterval
+	}
+	if maxInterval < minInterval {
+		maxInterval = max(DefaultWaitMaxInterval, minInterval)
+	}
+
+	start := time.Now()
+	interval := minInterval
+	wait := w.Delay
+
+	for {
+		if err := w.sleep(ctx, waitCtx, wait, lastState); err != nil {
+			return result, err
+		}
+
+		var state string
+		result, state, err = w.Refresh(waitCtx)
+		if err != nil {
+			if waitCtx.Err() != nil {
+				return result, w.contextError(ctx, lastState)
+			}
+			return result, err
+		}
+		lastState = state
+		span.Refreshed(state, time.Since(start))
+
+		switch {
+		case slices.Contains(w.Target, state):
+			tflog.Debug(ctx, fmt.Sprintf("%s reached state %s", w.Description, state), map[string]any{
+				"elapsed": time.Since(start).Round(time.Second).String(),
+			})
+			return result, nil
+		case slices.Contains(w.Error, state) || (w.IsError != nil && w.IsError(state)):
+			return result, &ErrorStateError{Description: w.Description, State: state}
+		case len(w.Pending) > 0 && !slices.Contains(w.Pending, state):
+			return result, &UnexpectedStateError{
+				Description: w.Description,
+				State:       state,
+				Expected:    append(slices.Clone(w.Target), w.Pending...),
+			}
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("Still waiting for %s", w.Description), map[string]any{
+			"state":   state,
+			"target":  strings.Join(w.Target, ","),
+			"elapsed": time.Since(start).Round(time.Second).String(),
+		})
+
+		wait = interval/2 + rand.N(interval/2+1)
+		interval = min(interval*2, maxInterval)
+	}
+}
+
+func (w StateWaiter[T]) sleep(ctx, waitCtx context.Context, d time.Duration, lastState string) error {
+	if d <= 0 {
+		if waitCtx.Err() != nil {
+			return w.contextError(ctx, lastState)
+		}
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-waitCtx.Done():
+		return w.contextError(ctx, lastState)
+	case <-timer.C:
+		return nil
+	}
+}
+
+// contextError distinguishes the caller cancelling ctx from the wait timeout expiring.
+func (w StateWaiter[T]) contextError(ctx context.Context, lastState string) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("stopped waiting for %s: %w", w.Description, err)
+	}
+	return &WaitTimeoutError{
+		Description: w.Description,
+		LastState:   lastState,
+		Target:      w.Target,
+		Timeout:     w.Timeout,
+	}
+}
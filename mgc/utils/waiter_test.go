@@ -0,0 +1,134 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func sequenceRefresh(states ...string) (StateRefreshFunc[int], *int) {
+	calls := 0
+	return func(ctx context.Context) (int, string, error) {
+		state := states[min(calls, len(states)-1)]
+		calls++
+		return calls, state, nil
+	}, &calls
+}
+
+func testWaiter(refresh StateRefreshFunc[int]) StateWaiter[int] {
+	return StateWaiter[int]{
+		Description: "test object",
+		Target:      []string{"ready"},
+		Pending:     []string{"creating", "provisioning"},
+		Error:       []string{"failed"},
+		Refresh:     refresh,
+		Timeout:     time.Second,
+		MinInterval: time.Millisecond,
+		MaxInterval: 4 * time.Millisecond,
+	}
+}
+
+func TestStateWaiter_ReachesTarget(t *testing.T) {
+	t.Parallel()
+
+	refresh, calls := sequenceRefresh("creating", "provisioning", "ready")
+
+	result, err := testWaiter(refresh).Wait(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 3, result)
+	assert.Equal(t, 3, *calls)
+}
+
+func TestStateWaiter_ErrorState(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequenceRefresh("creating", "failed")
+
+	_, err := testWaiter(refresh).Wait(context.Background())
+	var stateErr *ErrorStateError
+	require.ErrorAs(t, err, &stateErr)
+	assert.Equal(t, "failed", stateErr.State)
+}
+
+func TestStateWaiter_IsError(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequenceRefresh("creating_error_quota")
+	w := testWaiter(refresh)
+	w.Pending = nil
+	w.IsError = func(state string) bool { return state == "creating_error_quota" }
+
+	_, err := w.Wait(context.Background())
+	var stateErr *ErrorStateError
+	assert.ErrorAs(t, err, &stateErr)
+}
+
+func TestStateWaiter_UnexpectedState(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequenceRefresh("creating", "deleting")
+
+	_, err := testWaiter(refresh).Wait(context.Background())
+	var unexpectedErr *UnexpectedStateError
+	require.ErrorAs(t, err, &unexpectedErr)
+	assert.Equal(t, "deleting", unexpectedErr.State)
+	assert.Equal(t, []string{"ready", "creating", "provisioning"}, unexpectedErr.Expected)
+}
+
+func TestStateWaiter_AnyStatePendingWithoutPendingSet(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequenceRefresh("creating", "something_new", "ready")
+	w := testWaiter(refresh)
+	w.Pending = nil
+
+	_, err := w.Wait(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestStateWaiter_Timeout(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequenceRefresh("creating")
+	w := testWaiter(refresh)
+	w.Timeout = 20 * time.Millisecond
+
+	_, err := w.Wait(context.Background())
+	var timeoutErr *WaitTimeoutError
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, "creating", timeoutErr.LastState)
+}
+
+func TestStateWaiter_ContextCancelled(t *testing.T) {
+	t.Parallel()
+
+	refresh, _ := sequenceRefresh("creating")
+	w := testWaiter(refresh)
+	w.Timeout = 0
+	w.MinInterval = time.Hour
+	w.MaxInterval = time.Hour
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start := time.Now()
+	_, err := w.Wait(ctx)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestStateWaiter_RefreshError(t *testing.T) {
+	t.Parallel()
+
+	refreshErr := errors.New("boom")
+	w := testWaiter(func(ctx context.Context) (int, string, error) {
+		return 0, "", refreshErr
+	})
+
+	_, err := w.Wait(context.Background())
+	assert.ErrorIs(t, err, refreshErr)
+}
@@ -0,0 +1,194 @@
+package virtualmachines_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/acctest"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/fakeapi"
+)
+
+func testAccVMInstanceConfig(srv *fakeapi.Server, name, machineType string) string {
+	return acctest.Config(srv, fmt.Sprintf(`
+resource "mgc_virtual_machine_instances" "test" {
+  name         = %q
+  machine_type = %q
+  image        = %q
+}
+`, name, machineType, fakeapi.Image))
+}
+
+func TestAccVirtualMachineInstance(t *testing.T) {
+	srv := acctest.NewServer(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_virtual_machine_instances"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVMInstanceConfig(srv, "acc-vm", fakeapi.MachineType),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("mgc_virtual_machine_instances.test", "id"),
+					resource.TestCheckResourceAttr("mgc_virtual_machine_instances.test", "machine_type", fakeapi.MachineType),
+					resource.TestCheckResourceAttr("mgc_virtual_machine_instances.test", "availability_zone", fakeapi.AvailabilityZone),
+					resource.TestCheckResourceAttrSet("mgc_virtual_machine_instances.test", "vpc_id"),
+					resource.TestCheckResourceAttrSet("mgc_virtual_machine_instances.test", "local_ipv4"),
+				),
+			},
+			{
+				ResourceName:            "mgc_virtual_machine_instances.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
+			{
+				Config: testAccVMInstanceConfig(srv, "acc-vm-renamed", fakeapi.MachineTypeLarge),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("mgc_virtual_machine_instances.test", "name", "acc-vm-renamed"),
+					resource.TestCheckResourceAttr("mgc_virtual_machine_instances.test", "machine_type", fakeapi.MachineTypeLarge),
+				),
+			},
+		},
+	})
+}
+
+// An instance failing after the create request is kept in state, tainted, so
+// the next apply replaces it instead of leaving it behind.
+func TestAccVirtualMachineInstance_ProvisioningFailure(t *testing.T) {
+	srv := acctest.NewServer(t)
+	srv.FailProvisioning("acc-vm-failed")
+
+	resource.ParallelTest(t, resource.TestCase{
+		ProtoV6ProviderFactories: acctest.ProviderFactories,
+		TerraformVersionChecks:   acctest.TerraformVersionChecks,
+		CheckDestroy:             acctest.CheckDestroy(srv, "mgc_virtual_machine_instances"),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVMInstanceConfig(srv, "acc-vm-failed", fakeapi.MachineType),
+				ExpectError: regexp.MustCompile(`creating_error_capacity`),
+			},
+			{
+				Config: testAccVMInstanceConfig(srv, "acc-vm", fakeapi.MachineType),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("mgc_virtual_machine_instances.test", plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("mgc_virtual_machine_instances.test", "name", "acc-vm"),
+			},
+		},
+	})
+}
+
+func createTestInstance(t *testing.T, instances computeSdk.InstanceService, name string) string {
+	t.Helper()
+	id, err := instances.Create(context.Background(), computeSdk.CreateRequest{
+		Name:        name,
+		MachineType: computeSdk.IDOrName{Name: ptr(fakeapi.MachineType)},
+		Image:       computeSdk.IDOrName{Name: ptr(fakeapi.Image)},
+	})
+	require.NoError(t, err)
+	return id
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func TestAccVirtualMachinePowerAction(t *testing.T) {
+	t.Parallel()
+	srv := acctest.NewServer(t)
+	instances := computeSdk.New(acctest.Client(srv)).Instances()
+	id := createTestInstance(t, instances, "acc-vm-power")
+
+	progress, err := acctest.InvokeAction(t, srv, "mgc_virtual_machine_power", map[string]any{
+		"instance_id": id,
+		"state":       "stopped",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		fmt.Sprintf("Waiting for instance %s to be stopped", id),
+		fmt.Sprintf("Instance %s is stopped", id),
+	}, progress)
+
+	instance, err := instances.Get(context.Background(), id, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "stopped", instance.State)
+
+	progress, err = acctest.InvokeAction(t, srv, "mgc_virtual_machine_power", map[string]any{
+		"instance_id": id,
+		"state":       "stopped",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{fmt.Sprintf("Instance %s is already stopped", id)}, progress)
+
+	_, err = acctest.InvokeAction(t, srv, "mgc_virtual_machine_power", map[string]any{
+		"instance_id": id,
+		"state":       "paused",
+	})
+	assert.ErrorContains(t, err, `value must be one of: ["running" "stopped" "suspended"]`)
+}
+
+func TestAccVirtualMachineRebootAction(t *testing.T) {
+	t.Parallel()
+	srv := acctest.NewServer(t)
+	instances := computeSdk.New(acctest.Client(srv)).Instances()
+	id := createTestInstance(t, instances, "acc-vm-reboot")
+
+	progress, err := acctest.InvokeAction(t, srv, "mgc_virtual_machine_reboot", map[string]any{"instance_id": id})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		fmt.Sprintf("Stopping instance %s", id),
+		fmt.Sprintf("Starting instance %s", id),
+		fmt.Sprintf("Instance %s is running again", id),
+	}, progress)
+
+	instance, err := instances.Get(context.Background(), id, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "running", instance.State)
+
+	require.NoError(t, instances.Stop(context.Background(), id))
+	_, err = acctest.InvokeAction(t, srv, "mgc_virtual_machine_reboot", map[string]any{"instance_id": id})
+	assert.ErrorContains(t, err, "Instance not running")
+}
+
+func TestAccVirtualMachineInstancesList(t *testing.T) {
+	t.Parallel()
+	srv := acctest.NewServer(t)
+	instances := computeSdk.New(acctest.Client(srv)).Instances()
+	id := createTestInstance(t, instances, "acc-vm-listed")
+	createTestInstance(t, instances, "acc-vm-other")
+
+	listed, err := acctest.ListResources(t, srv, "mgc_virtual_machine_instances", map[string]any{
+		"name_regex": "listed",
+	})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	// The listed state must be a valid configuration of the resource.
+	for name, expected := range map[string]string{
+		"id":                id,
+		"name":              "acc-vm-listed",
+		"machine_type":      fakeapi.MachineType,
+		"image":             fakeapi.Image,
+		"availability_zone": fakeapi.AvailabilityZone,
+		"region":            fakeapi.Region,
+	} {
+		assert.True(t, listed[0][name].Equal(tftypes.NewValue(tftypes.String, expected)), "%s is %s", name, listed[0][name])
+	}
+
+	listed, err = acctest.ListResources(t, srv, "mgc_virtual_machine_instances", map[string]any{
+		"status": "deleted",
+	})
+	require.NoError(t, err)
+	assert.Empty(t, listed)
+}
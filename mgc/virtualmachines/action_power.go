@@ -0,0 +1,155 @@
+package virtualmachines
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const virtualMachinePowerTypeName = "mgc_virtual_machine_power"
+
+const (
+	PowerStateRunning   = "running"
+	PowerStateStopped   = "stopped"
+	PowerStateSuspended = "suspended"
+)
+
+var _ action.ActionWithConfigure = &vmPowerAction{}
+
+func NewVirtualMachinePowerAction() action.Action {
+	return &vmPowerAction{}
+}
+
+// vmPowerAction starts, stops or suspends an instance and waits until it
+// reaches the requested power state.
+type vmPowerAction struct {
+	regional    utils.RegionalService
+	vmInstances computeSdk.InstanceService
+}
+
+type vmPowerActionModel struct {
+	Region     types.String `tfsdk:"region"`
+	InstanceID types.String `tfsdk:"instance_id"`
+	State      types.String `tfsdk:"state"`
+}
+
+func (a *vmPowerAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = virtualMachinePowerTypeName
+}
+
+func (a *vmPowerAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	a.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		a.vmInstances = computeSdk.New(&cfg.CoreConfig).Instances()
+	})
+}
+
+func (a *vmPowerAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Starts, stops or suspends a virtual machine instance and waits until it reaches the requested power state. " +
+			"Nothing is done when the instance is already in that state.",
+		Attributes: map[string]schema.Attribute{
+			"region": utils.ActionRegionAttribute(),
+			"instance_id": schema.StringAttribute{
+				Description: "ID of the virtual machine instance.",
+				Required:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Power state to put the instance in: running, stopped or suspended.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(PowerStateRunning, PowerStateStopped, PowerStateSuspended),
+				},
+			},
+		},
+	}
+}
+
+func (a *vmPowerAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tracing.Action(&ctx, virtualMachinePowerTypeName, &resp.Diagnostics)()
+
+	var data vmPowerActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(a.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID, state := data.InstanceID.ValueString(), data.State.ValueString()
+	instance, err := a.vmInstances.Get(ctx, instanceID, nil)
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	if instance.State == state {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Instance %s is already %s", instanceID, state)})
+		return
+	}
+
+	switch state {
+	case PowerStateRunning:
+		err = a.vmInstances.Start(ctx, instanceID)
+	case PowerStateStopped:
+		err = a.vmInstances.Stop(ctx, instanceID)
+	case PowerStateSuspended:
+		err = a.vmInstances.Suspend(ctx, instanceID)
+	}
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Waiting for instance %s to be %s", instanceID, state)})
+	if _, err := waitUntilInstancePowerState(ctx, a.vmInstances, instanceID, state, VmInstanceStatusTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Instance %s is %s", instanceID, state)})
+}
+
+// waitUntilInstancePowerState waits until no operation is running on the
+// instance and its power state is state.
+func waitUntilInstancePowerState(ctx context.Context, instances computeSdk.InstanceService, instanceID, state string, timeout time.Duration) (*computeSdk.Instance, error) {
+	return utils.StateWaiter[*computeSdk.Instance]{
+		Description: fmt.Sprintf("instance %s", instanceID),
+		Target:      []string{state},
+		IsError:     func(state string) bool { return InstanceStatus(state).IsError() },
+		Timeout:     timeout,
+		MinInterval: 10 * time.Second,
+		Refresh: func(ctx context.Context) (*computeSdk.Instance, string, error) {
+			instance, err := instances.Get(ctx, instanceID, nil)
+			if err != nil {
+				return nil, "", err
+			}
+			if InstanceStatus(instance.Status).IsError() && instance.Error != nil && instance.Error.Message != "" {
+				return nil, "", fmt.Errorf("%s", instance.Error.Message)
+			}
+			if instance.Status != StatusCompleted.String() {
+				return instance, instance.Status, nil
+			}
+			return instance, instance.State, nil
+		},
+	}.Wait(ctx)
+}
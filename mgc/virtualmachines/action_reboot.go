@@ -0,0 +1,117 @@
+package virtualmachines
+
+import (
+	"context"
+	"fmt"
+
+	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const virtualMachineRebootTypeName = "mgc_virtual_machine_reboot"
+
+var _ action.ActionWithConfigure = &vmRebootAction{}
+
+func NewVirtualMachineRebootAction() action.Action {
+	return &vmRebootAction{}
+}
+
+// vmRebootAction reboots a running instance. The compute API has no reboot
+// operation, so the instance is stopped and started again.
+type vmRebootAction struct {
+	regional    utils.RegionalService
+	vmInstances computeSdk.InstanceService
+}
+
+type vmRebootActionModel struct {
+	Region     types.String `tfsdk:"region"`
+	InstanceID types.String `tfsdk:"instance_id"`
+}
+
+func (a *vmRebootAction) Metadata(_ context.Context, _ action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = virtualMachineRebootTypeName
+}
+
+func (a *vmRebootAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	a.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		a.vmInstances = computeSdk.New(&cfg.CoreConfig).Instances()
+	})
+}
+
+func (a *vmRebootAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Reboots a running virtual machine instance by stopping it and starting it again, " +
+			"and waits until it is running.",
+		Attributes: map[string]schema.Attribute{
+			"region": utils.ActionRegionAttribute(),
+			"instance_id": schema.StringAttribute{
+				Description: "ID of the virtual machine instance.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (a *vmRebootAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	defer tracing.Action(&ctx, virtualMachineRebootTypeName, &resp.Diagnostics)()
+
+	var data vmRebootActionModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(a.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	instanceID := data.InstanceID.ValueString()
+	instance, err := a.vmInstances.Get(ctx, instanceID, nil)
+	if err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	if instance.State != PowerStateRunning {
+		resp.Diagnostics.AddAttributeError(path.Root("instance_id"), "Instance not running",
+			fmt.Sprintf("Instance %s is %s, only running instances can be rebooted. "+
+				"Use the mgc_virtual_machine_power action to start it.", instanceID, instance.State))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Stopping instance %s", instanceID)})
+	if err := a.vmInstances.Stop(ctx, instanceID); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	if _, err := waitUntilInstancePowerState(ctx, a.vmInstances, instanceID, PowerStateStopped, VmInstanceStatusTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Starting instance %s", instanceID)})
+	if err := a.vmInstances.Start(ctx, instanceID); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	if _, err := waitUntilInstancePowerState(ctx, a.vmInstances, instanceID, PowerStateRunning, VmInstanceStatusTimeout); err != nil {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
+	}
+	resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Instance %s is running again", instanceID)})
+}
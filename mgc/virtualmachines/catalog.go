@@ -0,0 +1,33 @@
+package virtualmachines
+
+import (
+	"context"
+
+	vmSDK "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+// catalogMachineTypes lists machine types through the provider catalog cache.
+type catalogMachineTypes struct {
+	vmSDK.InstanceTypeService
+	catalog utils.Catalog
+}
+
+func (s catalogMachineTypes) ListAll(ctx context.Context, opts vmSDK.InstanceTypeFilterOptions) ([]vmSDK.InstanceType, error) {
+	return utils.FromCatalog(ctx, s.catalog, utils.CatalogKey("compute/machine-types", opts), func(ctx context.Context) ([]vmSDK.InstanceType, error) {
+		return s.InstanceTypeService.ListAll(ctx, opts)
+	})
+}
+
+// catalogImages lists the public images through the provider catalog cache.
+// Custom images are managed by the user and are not cached.
+type catalogImages struct {
+	vmSDK.ImageService
+	catalog utils.Catalog
+}
+
+func (s catalogImages) ListAll(ctx context.Context, opts vmSDK.ImageFilterOptions) ([]vmSDK.Image, error) {
+	return utils.FromCatalog(ctx, s.catalog, utils.CatalogKey("compute/images", opts), func(ctx context.Context) ([]vmSDK.Image, error) {
+		return s.ImageService.ListAll(ctx, opts)
+	})
+}
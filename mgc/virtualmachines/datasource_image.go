@@ -7,13 +7,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	vmSDK "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const virtualMachineImagesTypeName = "mgc_virtual_machine_images"
+
 var _ datasource.DataSource = &DataSourceVmImages{}
 
 type DataSourceVmImages struct {
+	regional       utils.RegionalService
 	vmImageService vmSDK.ImageService
 }
 
@@ -29,14 +33,15 @@ type ImageModel struct {
 
 type ImagesModel struct {
 	Images []ImageModel `tfsdk:"images"`
+	Region types.String `tfsdk:"region"`
 }
 
 func NewDataSourceVMIMages() datasource.DataSource {
 	return &DataSourceVmImages{}
 }
 
-func (r *DataSourceVmImages) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_virtual_machine_images"
+func (r *DataSourceVmImages) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = virtualMachineImagesTypeName
 }
 
 func (r *DataSourceVmImages) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -49,12 +54,15 @@ func (r *DataSourceVmImages) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	r.vmImageService = vmSDK.New(&dataConfig.CoreConfig).Images()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.vmImageService = catalogImages{vmSDK.New(&cfg.CoreConfig).Images(), cfg.Catalog()}
+	})
 }
 
 func (r *DataSourceVmImages) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"images": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of available VM Images.",
@@ -98,15 +106,22 @@ func (r *DataSourceVmImages) Schema(_ context.Context, req datasource.SchemaRequ
 }
 
 func (r *DataSourceVmImages) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, virtualMachineImagesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data ImagesModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	sdkOutput, err := r.vmImageService.ListAll(ctx, vmSDK.ImageFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
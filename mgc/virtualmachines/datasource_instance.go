@@ -8,13 +8,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	vmSDK "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const virtualMachineInstanceTypeName = "mgc_virtual_machine_instance"
+
 var _ datasource.DataSource = &DataSourceVmInstance{}
 
 type DataSourceVmInstance struct {
+	regional   utils.RegionalService
 	vmInstance vmSDK.InstanceService
 }
 
@@ -22,8 +26,8 @@ func NewDataSourceVmInstance() datasource.DataSource {
 	return &DataSourceVmInstance{}
 }
 
-func (r *DataSourceVmInstance) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_virtual_machine_instance"
+func (r *DataSourceVmInstance) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = virtualMachineInstanceTypeName
 }
 
 func (r *DataSourceVmInstance) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -36,12 +40,15 @@ func (r *DataSourceVmInstance) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	r.vmInstance = vmSDK.New(&dataConfig.CoreConfig).Instances()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.vmInstance = vmSDK.New(&cfg.CoreConfig).Instances()
+	})
 }
 
 func (r *DataSourceVmInstance) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Required:    true,
 				Description: "ID of machine-type.",
@@ -207,18 +214,26 @@ type VMInstanceModel struct {
 	ErrorSlug        types.String            `tfsdk:"error_slug"`
 	Labels           []types.String          `tfsdk:"labels"`
 	Interfaces       []NetworkInterfaceModel `tfsdk:"interfaces"`
+	Region           types.String            `tfsdk:"region"`
 }
 
 func (r *DataSourceVmInstance) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, virtualMachineInstanceTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data VMInstanceModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	instance, err := r.vmInstance.Get(ctx, data.ID.ValueString(), []vmSDK.InstanceExpand{vmSDK.InstanceNetworkExpand, vmSDK.InstanceImageExpand, vmSDK.InstanceMachineTypeExpand})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
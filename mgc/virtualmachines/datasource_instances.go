@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	vmSDK "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -14,6 +15,7 @@ import (
 var _ datasource.DataSource = &DataSourceVmInstances{}
 
 type DataSourceVmInstances struct {
+	regional   utils.RegionalService
 	vmInstance vmSDK.InstanceService
 }
 
@@ -30,14 +32,15 @@ type VMInstancesItemModel struct {
 
 type VMInstancesModel struct {
 	Instances []VMInstancesItemModel `tfsdk:"instances"`
+	Region    types.String           `tfsdk:"region"`
 }
 
 func NewDataSourceVmInstances() datasource.DataSource {
 	return &DataSourceVmInstances{}
 }
 
-func (r *DataSourceVmInstances) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_virtual_machine_instances"
+func (r *DataSourceVmInstances) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = virtualMachineInstancesTypeName
 }
 
 func (r *DataSourceVmInstances) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -50,12 +53,15 @@ func (r *DataSourceVmInstances) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	r.vmInstance = vmSDK.New(&dataConfig.CoreConfig).Instances()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.vmInstance = vmSDK.New(&cfg.CoreConfig).Instances()
+	})
 }
 
 func (r *DataSourceVmInstances) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"instances": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of available VM instances.",
@@ -102,15 +108,22 @@ func (r *DataSourceVmInstances) Schema(_ context.Context, req datasource.SchemaR
 }
 
 func (r *DataSourceVmInstances) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, virtualMachineInstancesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data VMInstancesModel
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	instances, err := r.vmInstance.ListAll(ctx, vmSDK.InstanceFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -7,14 +7,18 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 
 	vmSDK "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const virtualMachineTypesTypeName = "mgc_virtual_machine_types"
+
 var _ datasource.DataSource = &DataSourceVmMachineType{}
 
 type DataSourceVmMachineType struct {
-	vmType vmSDK.InstanceTypeService
+	regional utils.RegionalService
+	vmType   vmSDK.InstanceTypeService
 }
 
 type MachineTypeModel struct {
@@ -29,14 +33,15 @@ type MachineTypeModel struct {
 
 type MachineTypesModel struct {
 	MachineTypes []MachineTypeModel `tfsdk:"machine_types"`
+	Region       types.String       `tfsdk:"region"`
 }
 
 func NewDataSourceVmMachineType() datasource.DataSource {
 	return &DataSourceVmMachineType{}
 }
 
-func (r *DataSourceVmMachineType) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_virtual_machine_types"
+func (r *DataSourceVmMachineType) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = virtualMachineTypesTypeName
 }
 
 func (r *DataSourceVmMachineType) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -49,12 +54,15 @@ func (r *DataSourceVmMachineType) Configure(ctx context.Context, req datasource.
 		return
 	}
 
-	r.vmType = vmSDK.New(&dataConfig.CoreConfig).InstanceTypes()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.vmType = catalogMachineTypes{vmSDK.New(&cfg.CoreConfig).InstanceTypes(), cfg.Catalog()}
+	})
 }
 
 func (r *DataSourceVmMachineType) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"machine_types": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of available VM machine-types.",
@@ -100,13 +108,23 @@ func (r *DataSourceVmMachineType) Schema(_ context.Context, req datasource.Schem
 const typeActive string = "active"
 
 func (r *DataSourceVmMachineType) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, virtualMachineTypesTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data MachineTypesModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	sdkOutput, err := r.vmType.ListAll(ctx, vmSDK.InstanceTypeFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
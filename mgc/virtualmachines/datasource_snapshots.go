@@ -5,6 +5,7 @@ import (
 	"time"
 
 	vmSDK "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -14,11 +15,12 @@ import (
 var _ datasource.DataSource = &DataSourceVmSnapshots{}
 
 type DataSourceVmSnapshots struct {
+	regional   utils.RegionalService
 	vmSnapshot vmSDK.SnapshotService
 }
 
-func (r *DataSourceVmSnapshots) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_virtual_machine_snapshots"
+func (r *DataSourceVmSnapshots) Metadata(_ context.Context, _ datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = virtualMachineSnapshotsTypeName
 }
 
 func NewDataSourceVmSnapshots() datasource.DataSource {
@@ -40,12 +42,14 @@ type vmSnapshotsModel struct {
 
 type vmSnapshotsListModel struct {
 	Snapshots []vmSnapshotsModel `tfsdk:"snapshots"`
+	Region    types.String       `tfsdk:"region"`
 }
 
 func (r *DataSourceVmSnapshots) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "This data source provides a list of virtual machine snapshots.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.DataSourceRegionAttribute(),
 			"snapshots": schema.ListNestedAttribute{
 				Computed:    true,
 				Description: "List of available VM machine-types.",
@@ -108,17 +112,29 @@ func (r *DataSourceVmSnapshots) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	r.vmSnapshot = vmSDK.New(&dataConfig.CoreConfig).Snapshots()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.vmSnapshot = vmSDK.New(&cfg.CoreConfig).Snapshots()
+	})
 }
 
 func (r *DataSourceVmSnapshots) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	defer tracing.DataSource(&ctx, virtualMachineSnapshotsTypeName, &resp.State, &resp.Diagnostics)()
+
 	var data vmSnapshotsListModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	snapList, err := r.vmSnapshot.ListAll(ctx, vmSDK.SnapshotFilterOptions{})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -0,0 +1,100 @@
+package virtualmachines
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
+)
+
+var (
+	_ list.ListResource              = &vmInstancesList{}
+	_ list.ListResourceWithConfigure = &vmInstancesList{}
+)
+
+type vmInstancesList struct {
+	regional   utils.RegionalService
+	vmInstance computeSdk.InstanceService
+}
+
+type vmInstancesListModel struct {
+	utils.ListConfig
+	Status           types.String `tfsdk:"status"`
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+}
+
+func NewVirtualMachineInstancesList() list.ListResource {
+	return &vmInstancesList{}
+}
+
+func (r *vmInstancesList) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = virtualMachineInstancesTypeName
+}
+
+func (r *vmInstancesList) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	dataConfig, ok := req.ProviderData.(utils.DataConfig)
+	if !ok {
+		resp.Diagnostics.AddError("Failed to get provider data", "Failed to get provider data")
+		return
+	}
+
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.vmInstance = computeSdk.New(&cfg.CoreConfig).Instances()
+	})
+}
+
+func (r *vmInstancesList) ListResourceConfigSchema(_ context.Context, _ list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists virtual machine instances.",
+		Attributes: map[string]listschema.Attribute{
+			"region":     utils.ListRegionAttribute(),
+			"name_regex": utils.ListNameRegexAttribute(),
+			"status": listschema.StringAttribute{
+				Description: "Only list instances with this status, e.g. `completed`.",
+				Optional:    true,
+			},
+			"availability_zone": listschema.StringAttribute{
+				Description: "Only list instances in this availability zone, e.g. `br-se1-a`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *vmInstancesList) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	utils.List(ctx, req, stream, utils.Lister[vmInstancesListModel, computeSdk.Instance]{
+		TypeName: virtualMachineInstancesTypeName,
+		Identity: vmInstanceIdentity,
+		Use:      r.regional.Use,
+		Fetch: func(ctx context.Context, _ vmInstancesListModel) ([]computeSdk.Instance, error) {
+			return r.vmInstance.ListAll(ctx, computeSdk.InstanceFilterOptions{Expand: imageExpands})
+		},
+		Describe: func(config vmInstancesListModel, instance computeSdk.Instance) (string, map[string]string, bool) {
+			if (!config.Status.IsNull() && instance.Status != config.Status.ValueString()) ||
+				(!config.AvailabilityZone.IsNull() && (instance.AvailabilityZone == nil || *instance.AvailabilityZone != config.AvailabilityZone.ValueString())) {
+				return "", nil, false
+			}
+			var name string
+			if instance.Name != nil {
+				name = *instance.Name
+			}
+			return name, map[string]string{"id": instance.ID}, true
+		},
+		Resource: func(ctx context.Context, config vmInstancesListModel, instance computeSdk.Instance) (any, diag.Diagnostics) {
+			data := (&vmInstances{}).toTerraformModel(ctx, &instance)
+			data.Timeouts = utils.NullTimeouts(vmInstanceTimeoutsOpts)
+			data.Region = config.Region
+			return data, nil
+		},
+	})
+}
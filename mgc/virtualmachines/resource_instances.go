@@ -3,11 +3,11 @@ package virtualmachines
 import (
 	"context"
 	"fmt"
-	"net/http"
 	"regexp"
 	"slices"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -20,13 +20,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 
-	clientSDK "github.com/MagaluCloud/mgc-sdk-go/client"
-
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+const virtualMachineInstancesTypeName = "mgc_virtual_machine_instances"
+
 const (
 	VmInstanceStatusTimeout = 60 * time.Minute
 )
@@ -102,17 +103,22 @@ func (s InstanceStatus) IsError() bool {
 	return slices.Contains(errorStatus, s)
 }
 
+var vmInstanceIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the virtual machine instance."),
+)
+
 func NewVirtualMachineInstancesResource() resource.Resource {
 	return &vmInstances{}
 }
 
 type vmInstances struct {
+	regional    utils.RegionalService
 	vmInstances computeSdk.InstanceService
 	vmSnapshots computeSdk.SnapshotService
 }
 
-func (r *vmInstances) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_virtual_machine_instances"
+func (r *vmInstances) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = virtualMachineInstancesTypeName
 }
 
 func (r *vmInstances) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -126,28 +132,32 @@ func (r *vmInstances) Configure(ctx context.Context, req resource.ConfigureReque
 		return
 	}
 
-	r.vmInstances = computeSdk.New(&dataConfig.CoreConfig).Instances()
-	r.vmSnapshots = computeSdk.New(&dataConfig.CoreConfig).Snapshots()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.vmInstances = computeSdk.New(&cfg.CoreConfig).Instances()
+		r.vmSnapshots = computeSdk.New(&cfg.CoreConfig).Snapshots()
+	})
 }
 
 type vmInstancesResourceModel struct {
-	ID                     types.String `tfsdk:"id"`
-	Name                   types.String `tfsdk:"name"`
-	CreatedAt              types.String `tfsdk:"created_at"`
-	SshKeyName             types.String `tfsdk:"ssh_key_name"`
-	VpcID                  types.String `tfsdk:"vpc_id"`
-	MachineType            types.String `tfsdk:"machine_type"`
-	Image                  types.String `tfsdk:"image"`
-	UserData               types.String `tfsdk:"user_data"`
-	AvailabilityZone       types.String `tfsdk:"availability_zone"`
-	NetworkInterfaces      types.List   `tfsdk:"network_interfaces"`
-	NetworkInterfaceId     types.String `tfsdk:"network_interface_id"`
-	AllocatePublicIpv4     types.Bool   `tfsdk:"allocate_public_ipv4"`
-	CreationSecurityGroups types.List   `tfsdk:"creation_security_groups"`
-	LocalIPv4              types.String `tfsdk:"local_ipv4"`
-	IPv6                   types.String `tfsdk:"ipv6"`
-	IPv4                   types.String `tfsdk:"ipv4"`
-	SnapshotID             types.String `tfsdk:"snapshot_id"`
+	ID                     types.String   `tfsdk:"id"`
+	Name                   types.String   `tfsdk:"name"`
+	CreatedAt              types.String   `tfsdk:"created_at"`
+	SshKeyName             types.String   `tfsdk:"ssh_key_name"`
+	VpcID                  types.String   `tfsdk:"vpc_id"`
+	MachineType            types.String   `tfsdk:"machine_type"`
+	Image                  types.String   `tfsdk:"image"`
+	UserData               types.String   `tfsdk:"user_data"`
+	AvailabilityZone       types.String   `tfsdk:"availability_zone"`
+	NetworkInterfaces      types.List     `tfsdk:"network_interfaces"`
+	NetworkInterfaceId     types.String   `tfsdk:"network_interface_id"`
+	AllocatePublicIpv4     types.Bool     `tfsdk:"allocate_public_ipv4"`
+	CreationSecurityGroups types.List     `tfsdk:"creation_security_groups"`
+	LocalIPv4              types.String   `tfsdk:"local_ipv4"`
+	IPv6                   types.String   `tfsdk:"ipv6"`
+	IPv4                   types.String   `tfsdk:"ipv4"`
+	SnapshotID             types.String   `tfsdk:"snapshot_id"`
+	Region                 types.String   `tfsdk:"region"`
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
 }
 
 type VmInstancesNetworkInterfaceModel struct {
@@ -159,12 +169,32 @@ type VmInstancesNetworkInterfaceModel struct {
 	Primary   types.Bool   `tfsdk:"primary"`
 }
 
-func (r *vmInstances) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+var vmInstanceTimeoutsOpts = timeouts.Opts{Create: true, Update: true, Delete: true}
+
+// vmInstanceSchemaVersion 1 flattened the machine_type and image objects of
+// older releases and stopped keeping write-only attributes in state.
+const vmInstanceSchemaVersion = 1
+
+var vmInstanceLegacyMigrations = []utils.StateMigration{
+	utils.FlattenAttribute("machine_type", "name"),
+	utils.FlattenAttribute("image", "name"),
+}
+
+// vmInstanceMoveSources are the type names of VM instances in older provider
+// generations, or under another provider address.
+var vmInstanceMoveSources = []string{
+	virtualMachineInstancesTypeName,
+	"mgc_virtual-machine_instances",
+}
+
+func (r *vmInstances) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	description := "Manages virtual machine instances in Magalu Cloud."
 	resp.Schema = schema.Schema{
+		Version:             vmInstanceSchemaVersion,
 		Description:         description,
 		MarkdownDescription: description,
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description: "The unique identifier of the virtual machine instance.",
 				Computed:    true,
@@ -350,32 +380,63 @@ This attribute can only be used when "network_interface_id" is not set.`,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, vmInstanceTimeoutsOpts),
+		},
 	}
 }
 
 func (r *vmInstances) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, virtualMachineInstancesTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer vmInstanceIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := vmInstancesResourceModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	getResult, err := r.vmInstances.Get(ctx, data.ID.ValueString(), imageExpands)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 	convertedData := r.toTerraformModel(ctx, getResult)
+	convertedData.Timeouts = data.Timeouts
+	convertedData.Region = data.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &convertedData)...)
 }
 
 func (r *vmInstances) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, virtualMachineInstancesTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer vmInstanceIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	state := vmInstancesResourceModel{}
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&state.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := state.Timeouts.Create(ctx, VmInstanceStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if state.AllocatePublicIpv4.ValueBoolPointer() == nil {
 		state.AllocatePublicIpv4 = types.BoolValue(false)
 	}
@@ -427,7 +488,7 @@ func (r *vmInstances) Create(ctx context.Context, req resource.CreateRequest, re
 			Network:          &createNetwork,
 		})
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	} else {
@@ -453,22 +514,28 @@ func (r *vmInstances) Create(ctx context.Context, req resource.CreateRequest, re
 
 		createdID, err = r.vmInstances.Create(ctx, createParams)
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
 
-	getResponse, err := r.waitUntilInstanceStatusMatches(ctx, createdID, StatusCompleted)
+	getResponse, err := r.waitUntilInstanceStatusMatches(ctx, createdID, StatusCompleted, createTimeout)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		vmInstanceIdentity.SetCreated(ctx, &resp.State, map[string]string{"region": state.Region.ValueString(), "id": createdID}, &resp.Diagnostics)
 		return
 	}
 
 	convertedResult := r.toTerraformModel(ctx, getResponse)
+	convertedResult.Timeouts = state.Timeouts
+	convertedResult.Region = state.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &convertedResult)...)
 }
 
 func (r *vmInstances) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, virtualMachineInstancesTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer vmInstanceIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	plan := vmInstancesResourceModel{}
 	state := &vmInstancesResourceModel{}
 	req.State.Get(ctx, state)
@@ -477,10 +544,21 @@ func (r *vmInstances) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, VmInstanceStatusTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if state.Name.ValueString() != plan.Name.ValueString() {
 		err := r.vmInstances.Rename(ctx, plan.ID.ValueString(), plan.Name.ValueString())
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
@@ -492,25 +570,39 @@ func (r *vmInstances) Update(ctx context.Context, req resource.UpdateRequest, re
 			},
 		})
 		if err != nil {
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
+			resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 			return
 		}
 	}
 
-	getResult, err := r.waitUntilInstanceStatusMatches(ctx, plan.ID.ValueString(), StatusCompleted)
+	getResult, err := r.waitUntilInstanceStatusMatches(ctx, plan.ID.ValueString(), StatusCompleted, updateTimeout)
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading VM", err.Error())
 		return
 	}
 
 	convertedResult := r.toTerraformModel(ctx, getResult)
+	convertedResult.Timeouts = plan.Timeouts
+	convertedResult.Region = plan.Region
 	resp.Diagnostics.Append(resp.State.Set(ctx, &convertedResult)...)
 }
 
 func (r *vmInstances) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, virtualMachineInstancesTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data vmInstancesResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, VmInstanceStatusTimeout)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -518,27 +610,35 @@ func (r *vmInstances) Delete(ctx context.Context, req resource.DeleteRequest, re
 	//false = not remove public ip
 	err := r.vmInstances.Delete(ctx, data.ID.ValueString(), false)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
-	_, err = r.waitUntilInstanceStatusMatches(ctx, data.ID.ValueString(), StatusDeleted)
-	if err != nil {
-		switch e := err.(type) {
-		case *clientSDK.HTTPError:
-			if e.StatusCode == http.StatusNotFound {
-				return
-			}
-		default:
-			resp.Diagnostics.AddError(utils.ParseSDKError(err))
-			return
-		}
+	_, err = r.waitUntilInstanceStatusMatches(ctx, data.ID.ValueString(), StatusDeleted, deleteTimeout)
+	if err != nil && !utils.IsNotFound(err) {
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
+		return
 	}
 }
 
+func (r *vmInstances) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: utils.UpgradeState(vmInstanceLegacyMigrations...),
+	}
+}
+
+func (r *vmInstances) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		utils.MoveState(vmInstanceMoveSources, vmInstanceLegacyMigrations...),
+	}
+}
+
+func (r *vmInstances) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = vmInstanceIdentity.Schema()
+}
+
 func (r *vmInstances) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	model := vmInstancesResourceModel{
-		ID:                     types.StringValue(req.ID),
 		Name:                   types.StringUnknown(),
 		CreatedAt:              types.StringUnknown(),
 		SshKeyName:             types.StringUnknown(),
@@ -555,8 +655,14 @@ func (r *vmInstances) ImportState(ctx context.Context, req resource.ImportStateR
 		IPv6:                   types.StringUnknown(),
 		IPv4:                   types.StringUnknown(),
 		SnapshotID:             types.StringUnknown(),
+		Timeouts:               utils.NullTimeouts(vmInstanceTimeoutsOpts),
 	}
 	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	vmInstanceIdentity.ImportState(ctx, req, resp)
 }
 
 func (r *vmInstances) toTerraformModel(ctx context.Context, server *computeSdk.Instance) *vmInstancesResourceModel {
@@ -611,31 +717,24 @@ func (r *vmInstances) toTerraformModel(ctx context.Context, server *computeSdk.I
 	return &data
 }
 
-func (r *vmInstances) waitUntilInstanceStatusMatches(ctx context.Context, instanceID string, status InstanceStatus) (*computeSdk.Instance, error) {
-	timeoutCtx, cancel := context.WithTimeout(ctx, VmInstanceStatusTimeout)
-	defer cancel()
-
-	for {
-		select {
-		case <-timeoutCtx.Done():
-			return nil, fmt.Errorf("timeout waiting for instance %s to reach status %s", instanceID, status)
-		case <-time.After(10 * time.Second):
+func (r *vmInstances) waitUntilInstanceStatusMatches(ctx context.Context, instanceID string, status InstanceStatus, timeout time.Duration) (*computeSdk.Instance, error) {
+	return utils.StateWaiter[*computeSdk.Instance]{
+		Description: fmt.Sprintf("instance %s", instanceID),
+		Target:      []string{status.String()},
+		IsError:     func(state string) bool { return InstanceStatus(state).IsError() },
+		Timeout:     timeout,
+		MinInterval: 10 * time.Second,
+		Refresh: func(ctx context.Context) (*computeSdk.Instance, string, error) {
 			instance, err := r.vmInstances.Get(ctx, instanceID, imageExpands)
 			if err != nil {
-				return nil, err
+				return nil, "", err
 			}
-			currentStatus := InstanceStatus(instance.Status)
-			if currentStatus == status {
-				return instance, nil
+			if InstanceStatus(instance.Status).IsError() && instance.Error != nil && instance.Error.Message != "" {
+				return nil, "", fmt.Errorf("%s", instance.Error.Message)
 			}
-			if currentStatus.IsError() {
-				if instance.Error != nil && instance.Error.Message != "" {
-					return nil, fmt.Errorf("%s", instance.Error.Message)
-				}
-				return nil, fmt.Errorf("instance %s is in error state: %s", instanceID, currentStatus)
-			}
-		}
-	}
+			return instance, instance.Status, nil
+		},
+	}.Wait(ctx)
 }
 
 func (r *vmInstances) toTerraformNetworkInterfacesList(ctx context.Context, interfaces []VmInstancesNetworkInterfaceModel) types.List {
@@ -9,6 +9,9 @@ import (
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -338,3 +341,63 @@ func TestVirtualMachineInstancesResource_StatusConstants(t *testing.T) {
 		assert.NotEmpty(t, string(s))
 	}
 }
+
+func TestVirtualMachineInstancesResource_UpgradeState(t *testing.T) {
+	ctx := context.Background()
+	r := &vmInstances{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	require.Equal(t, int64(vmInstanceSchemaVersion), schemaResp.Schema.Version)
+
+	upgrader, ok := r.UpgradeState(ctx)[0]
+	require.True(t, ok)
+
+	prior := `{
+		"id": "vm-id",
+		"name": "web",
+		"name_is_prefix": false,
+		"machine_type": {"name": "BV1-1-40"},
+		"image": {"name": "cloud-ubuntu-24.04 LTS"},
+		"network": {"associate_public_ip": false},
+		"allocate_public_ipv4": true
+	}`
+	resp := &resource.UpgradeStateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	upgrader.StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: []byte(prior)}}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	raw, err := resp.DynamicValue.Unmarshal(schemaResp.Schema.Type().TerraformType(ctx))
+	require.NoError(t, err)
+	var data vmInstancesResourceModel
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: raw}
+	require.False(t, state.Get(ctx, &data).HasError())
+
+	assert.Equal(t, "vm-id", data.ID.ValueString())
+	assert.Equal(t, "BV1-1-40", data.MachineType.ValueString())
+	assert.Equal(t, "cloud-ubuntu-24.04 LTS", data.Image.ValueString())
+	assert.True(t, data.AllocatePublicIpv4.IsNull())
+}
+
+func TestVirtualMachineInstancesResource_MoveState(t *testing.T) {
+	ctx := context.Background()
+	r := &vmInstances{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	movers := r.MoveState(ctx)
+	require.Len(t, movers, 1)
+
+	resp := &resource.MoveStateResponse{TargetState: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	movers[0].StateMover(ctx, resource.MoveStateRequest{
+		SourceTypeName: "mgc_virtual-machine_instances",
+		SourceRawState: &tfprotov6.RawState{JSON: []byte(`{"id": "vm-id", "name": "web", "machine_type": {"name": "BV1-1-40"}}`)},
+	}, resp)
+	require.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	var data vmInstancesResourceModel
+	require.False(t, resp.TargetState.Get(ctx, &data).HasError())
+	assert.Equal(t, "vm-id", data.ID.ValueString())
+	assert.Equal(t, "BV1-1-40", data.MachineType.ValueString())
+}
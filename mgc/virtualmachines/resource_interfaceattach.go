@@ -10,24 +10,34 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const virtualMachineInterfaceAttachTypeName = "mgc_virtual_machine_interface_attach"
+
+var vmInterfaceAttachIdentity = utils.RegionalIdentity(
+	utils.IdentityAttribute{Name: "instance_id", Description: "ID of the VM instance."},
+	utils.IdentityAttribute{Name: "interface_id", Description: "ID of the network interface."},
+)
+
 func NewVirtualMachineInterfaceAttachResource() resource.Resource {
 	return &vmInterfaceAttach{}
 }
 
 type vmInterfaceAttach struct {
+	regional   utils.RegionalService
 	vmInstance computeSdk.InstanceService
 }
 
 type vmInterfaceAttachResourceModel struct {
 	InterfaceID types.String `tfsdk:"interface_id"`
 	InstanceID  types.String `tfsdk:"instance_id"`
+	Region      types.String `tfsdk:"region"`
 }
 
-func (r *vmInterfaceAttach) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_virtual_machine_interface_attach"
+func (r *vmInterfaceAttach) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = virtualMachineInterfaceAttachTypeName
 }
 
 func (r *vmInterfaceAttach) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -41,13 +51,16 @@ func (r *vmInterfaceAttach) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	r.vmInstance = computeSdk.New(&dataConfig.CoreConfig).Instances()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.vmInstance = computeSdk.New(&cfg.CoreConfig).Instances()
+	})
 }
 
 func (r *vmInterfaceAttach) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Attaches a network interface to a VM instance.",
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"interface_id": schema.StringAttribute{
 				Description: "ID of the network interface to attach.",
 				Required:    true,
@@ -67,12 +80,20 @@ func (r *vmInterfaceAttach) Schema(_ context.Context, _ resource.SchemaRequest,
 }
 
 func (r *vmInterfaceAttach) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, virtualMachineInterfaceAttachTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer vmInterfaceAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data vmInterfaceAttachResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.vmInstance.AttachNetworkInterface(ctx, computeSdk.NICRequest{
 		Instance: computeSdk.IDOrName{
 			ID: data.InstanceID.ValueStringPointer(),
@@ -84,7 +105,7 @@ func (r *vmInterfaceAttach) Create(ctx context.Context, req resource.CreateReque
 		},
 	})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -92,20 +113,32 @@ func (r *vmInterfaceAttach) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *vmInterfaceAttach) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, virtualMachineInterfaceAttachTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer vmInterfaceAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	var data vmInterfaceAttachResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	getInstance, err := r.vmInstance.Get(ctx, data.InstanceID.ValueString(), []computeSdk.InstanceExpand{computeSdk.InstanceNetworkExpand})
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	if getInstance.Network == nil || getInstance.Network.Interfaces == nil || len(*getInstance.Network.Interfaces) == 0 {
-		resp.Diagnostics.AddError("No network interfaces found for VM instance", "")
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
@@ -119,24 +152,42 @@ func (r *vmInterfaceAttach) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if !hasInterfaceId {
-		resp.Diagnostics.AddError("Network interface not found on VM instance", "")
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
+func (r *vmInterfaceAttach) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = vmInterfaceAttachIdentity.Schema()
+}
+
+func (r *vmInterfaceAttach) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vmInterfaceAttachIdentity.ImportState(ctx, req, resp)
+}
+
 func (r *vmInterfaceAttach) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, virtualMachineInterfaceAttachTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer vmInterfaceAttachIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	resp.Diagnostics.AddError("Update not supported", "Update is not supported for this resource")
 }
 
 func (r *vmInterfaceAttach) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, virtualMachineInterfaceAttachTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data vmInterfaceAttachResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.vmInstance.DetachNetworkInterface(ctx, computeSdk.NICRequest{
 		Instance: computeSdk.IDOrName{
 			ID: data.InstanceID.ValueStringPointer(),
@@ -149,6 +200,6 @@ func (r *vmInterfaceAttach) Delete(ctx context.Context, req resource.DeleteReque
 	})
 
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 	}
 }
@@ -12,24 +12,41 @@ import (
 	computeSdk "github.com/MagaluCloud/mgc-sdk-go/compute"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"github.com/MagaluCloud/terraform-provider-mgc/mgc/internal/tracing"
 	"github.com/MagaluCloud/terraform-provider-mgc/mgc/utils"
 )
 
+const virtualMachineSnapshotsTypeName = "mgc_virtual_machine_snapshots"
+
 var (
-	_ resource.Resource              = &vmSnapshots{}
-	_ resource.ResourceWithConfigure = &vmSnapshots{}
+	_ resource.Resource                = &vmSnapshots{}
+	_ resource.ResourceWithConfigure   = &vmSnapshots{}
+	_ resource.ResourceWithImportState = &vmSnapshots{}
+	_ resource.ResourceWithIdentity    = &vmSnapshots{}
+)
+
+var vmSnapshotIdentity = utils.RegionalIdentity(
+	utils.IDAttribute("ID of the snapshot."),
 )
 
+// vmSnapshotMoveSources are the type names of VM snapshots in older provider
+// generations, or under another provider address.
+var vmSnapshotMoveSources = []string{
+	virtualMachineSnapshotsTypeName,
+	"mgc_virtual-machine_snapshots",
+}
+
 func NewVirtualMachineSnapshotsResource() resource.Resource {
 	return &vmSnapshots{}
 }
 
 type vmSnapshots struct {
+	regional    utils.RegionalService
 	vmSnapshots computeSdk.SnapshotService
 }
 
-func (r *vmSnapshots) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
-	resp.TypeName = req.ProviderTypeName + "_virtual_machine_snapshots"
+func (r *vmSnapshots) Metadata(_ context.Context, _ resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = virtualMachineSnapshotsTypeName
 }
 
 func (r *vmSnapshots) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -43,7 +60,9 @@ func (r *vmSnapshots) Configure(ctx context.Context, req resource.ConfigureReque
 		return
 	}
 
-	r.vmSnapshots = computeSdk.New(&dataConfig.CoreConfig).Snapshots()
+	r.regional = utils.NewRegionalService(dataConfig, func(cfg utils.DataConfig) {
+		r.vmSnapshots = computeSdk.New(&cfg.CoreConfig).Snapshots()
+	})
 }
 
 type vmSnapshotsResourceModel struct {
@@ -52,6 +71,7 @@ type vmSnapshotsResourceModel struct {
 	VirtualMachineID types.String `tfsdk:"virtual_machine_id"`
 	UpdatedAt        types.String `tfsdk:"updated_at"`
 	CreatedAt        types.String `tfsdk:"created_at"`
+	Region           types.String `tfsdk:"region"`
 }
 
 func (r *vmSnapshots) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -60,6 +80,7 @@ func (r *vmSnapshots) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 		Description:         description,
 		MarkdownDescription: description,
 		Attributes: map[string]schema.Attribute{
+			"region": utils.ResourceRegionAttribute(),
 			"id": schema.StringAttribute{
 				Description:   "The ID of the snapshot.",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
@@ -98,22 +119,49 @@ func (r *vmSnapshots) getVmSnapshot(ctx context.Context, id string) (*computeSdk
 }
 
 func (r *vmSnapshots) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	defer tracing.Resource(&ctx, virtualMachineSnapshotsTypeName, tracing.OperationRead, &resp.State, &resp.Diagnostics)()
+	defer vmSnapshotIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 	data := &vmSnapshotsResourceModel{}
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	getResult, err := r.getVmSnapshot(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		if utils.IsNotFound(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 	data.ID = types.StringValue(getResult.ID)
 	data.Name = types.StringValue(getResult.Name)
+	if getResult.Instance != nil {
+		data.VirtualMachineID = types.StringValue(getResult.Instance.ID)
+	}
+	// Imported snapshots have no timestamps yet, the ones set on create are kept.
+	if data.CreatedAt.IsNull() {
+		data.CreatedAt = types.StringValue(getResult.CreatedAt.Format(time.RFC850))
+	}
+	if data.UpdatedAt.IsNull() && getResult.UpdatedAt != nil {
+		data.UpdatedAt = types.StringValue(getResult.UpdatedAt.Format(time.RFC850))
+	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *vmSnapshots) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	defer tracing.Resource(&ctx, virtualMachineSnapshotsTypeName, tracing.OperationCreate, &resp.State, &resp.Diagnostics)()
+	defer vmSnapshotIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
 
 	plan := &vmSnapshotsResourceModel{}
 	diags := req.Plan.Get(ctx, &plan)
@@ -122,6 +170,11 @@ func (r *vmSnapshots) Create(ctx context.Context, req resource.CreateRequest, re
 		return
 	}
 
+	resp.Diagnostics.Append(r.regional.Use(&plan.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createParams := computeSdk.CreateSnapshotRequest{
 		Name: plan.Name.ValueString(),
 		Instance: computeSdk.IDOrName{
@@ -131,7 +184,7 @@ func (r *vmSnapshots) Create(ctx context.Context, req resource.CreateRequest, re
 
 	result, err := r.vmSnapshots.Create(ctx, createParams)
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
@@ -148,17 +201,47 @@ func (r *vmSnapshots) Create(ctx context.Context, req resource.CreateRequest, re
 }
 
 func (r *vmSnapshots) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	defer tracing.Resource(&ctx, virtualMachineSnapshotsTypeName, tracing.OperationUpdate, &resp.State, &resp.Diagnostics)()
+	defer vmSnapshotIdentity.Set(ctx, &resp.State, resp.Identity, &resp.Diagnostics)
+
 }
 
 func (r *vmSnapshots) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	defer tracing.Resource(&ctx, virtualMachineSnapshotsTypeName, tracing.OperationDelete, &req.State, &resp.Diagnostics)()
+
 	var data vmSnapshotsResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.regional.Use(&data.Region)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	err := r.vmSnapshots.Delete(ctx, data.ID.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError(utils.ParseSDKError(err))
+		resp.Diagnostics.Append(utils.SDKErrorDiagnostic(err))
 		return
 	}
 
 }
+
+func (r *vmSnapshots) MoveState(_ context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		utils.MoveState(vmSnapshotMoveSources,
+			utils.FlattenAttribute("virtual_machine", "id"),
+			utils.RenameAttribute("virtual_machine", "virtual_machine_id"),
+		),
+	}
+}
+
+func (r *vmSnapshots) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = vmSnapshotIdentity.Schema()
+}
+
+func (r *vmSnapshots) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	vmSnapshotIdentity.ImportState(ctx, req, resp)
+}
@@ -1,7 +1,9 @@
 package virtualmachines
 
 import (
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
@@ -22,3 +24,16 @@ func GetResources() []func() resource.Resource {
 		NewVirtualMachineSnapshotsResource,
 	}
 }
+
+func GetListResources() []func() list.ListResource {
+	return []func() list.ListResource{
+		NewVirtualMachineInstancesList,
+	}
+}
+
+func GetActions() []func() action.Action {
+	return []func() action.Action{
+		NewVirtualMachinePowerAction,
+		NewVirtualMachineRebootAction,
+	}
+}